@@ -1634,7 +1634,6 @@ type Response struct {
 	// Sum of all possible messages.
 	//
 	// Types that are valid to be assigned to Value:
-	//
 	//	*Response_Exception
 	//	*Response_Echo
 	//	*Response_Flush
@@ -3153,6 +3152,9 @@ type ExecTxResult struct {
 	GasUsed   int64   `protobuf:"varint,6,opt,name=gas_used,proto3" json:"gas_used,omitempty"`
 	Events    []Event `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
 	Codespace string  `protobuf:"bytes,8,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// Keys, e.g. the addresses of the signers of the transaction, the
+	// transaction is indexed by, to be queried with the account_txs RPC.
+	IndexKeys []string `protobuf:"bytes,9,rep,name=index_keys,json=indexKeys,proto3" json:"index_keys,omitempty"`
 }
 
 func (m *ExecTxResult) Reset()         { *m = ExecTxResult{} }
@@ -3244,6 +3246,13 @@ func (m *ExecTxResult) GetCodespace() string {
 	return ""
 }
 
+func (m *ExecTxResult) GetIndexKeys() []string {
+	if m != nil {
+		return m.IndexKeys
+	}
+	return nil
+}
+
 // TxResult contains results of executing the transaction.
 //
 // One usage is indexing transaction results.
@@ -3775,208 +3784,209 @@ func init() {
 func init() { proto.RegisterFile("cometbft/abci/v1/types.proto", fileDescriptor_95dd8f7b670b96e3) }
 
 var fileDescriptor_95dd8f7b670b96e3 = []byte{
-	// 3214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x6c, 0x1b, 0xd7,
-	0xb9, 0xf6, 0xf0, 0x21, 0x91, 0x3f, 0x49, 0x69, 0x74, 0x24, 0xd9, 0xb4, 0xe2, 0x48, 0xf2, 0x38,
-	0x8e, 0x1d, 0x3b, 0x91, 0xae, 0x9d, 0xdc, 0x3c, 0x6e, 0x6e, 0x12, 0x50, 0x34, 0x15, 0x49, 0x96,
-	0x45, 0x66, 0x48, 0xeb, 0xc6, 0xc6, 0x6d, 0x27, 0x43, 0xf2, 0x90, 0x9c, 0x98, 0xe4, 0x4c, 0x66,
-	0x0e, 0x15, 0xaa, 0x5d, 0xb5, 0x68, 0x8a, 0x22, 0xab, 0x6c, 0x0a, 0x14, 0x45, 0x0b, 0x14, 0x28,
-	0xba, 0x2a, 0xd0, 0x45, 0x57, 0xdd, 0x74, 0x5b, 0x64, 0xd5, 0x66, 0xd9, 0x55, 0x5a, 0x24, 0xbb,
-	0xee, 0x03, 0x74, 0x59, 0x9c, 0xc7, 0xbc, 0xc8, 0x19, 0x49, 0x76, 0xd2, 0x45, 0xd1, 0xee, 0x78,
-	0xce, 0xf9, 0xfe, 0x7f, 0xce, 0xe3, 0x3f, 0xff, 0xe3, 0x3b, 0x84, 0x4b, 0x2d, 0x73, 0x80, 0x49,
-	0xb3, 0x43, 0x36, 0xf5, 0x66, 0xcb, 0xd8, 0x3c, 0xba, 0xb5, 0x49, 0x8e, 0x2d, 0xec, 0x6c, 0x58,
-	0xb6, 0x49, 0x4c, 0x24, 0xbb, 0xa3, 0x1b, 0x74, 0x74, 0xe3, 0xe8, 0xd6, 0xca, 0xaa, 0x87, 0x6f,
-	0xd9, 0xc7, 0x16, 0x31, 0xa9, 0x84, 0x65, 0x9b, 0x66, 0x87, 0x4b, 0x04, 0xc6, 0x99, 0x1e, 0x36,
-	0xac, 0xdb, 0xfa, 0x40, 0x68, 0x5c, 0xb9, 0x3c, 0x3d, 0x7e, 0xa4, 0xf7, 0x8d, 0xb6, 0x4e, 0x4c,
-	0x5b, 0x40, 0x96, 0xba, 0x66, 0xd7, 0x64, 0x3f, 0x37, 0xe9, 0x2f, 0xd1, 0xbb, 0xd6, 0x35, 0xcd,
-	0x6e, 0x1f, 0x6f, 0xb2, 0x56, 0x73, 0xd4, 0xd9, 0x24, 0xc6, 0x00, 0x3b, 0x44, 0x1f, 0x58, 0xee,
-	0x97, 0x27, 0x01, 0xed, 0x91, 0xad, 0x13, 0xc3, 0x1c, 0xf2, 0x71, 0xe5, 0x4f, 0x59, 0x98, 0x55,
-	0xf1, 0x07, 0x23, 0xec, 0x10, 0xf4, 0x22, 0xa4, 0x70, 0xab, 0x67, 0x16, 0xa5, 0x75, 0xe9, 0x7a,
-	0xee, 0xf6, 0xd3, 0x1b, 0x93, 0xcb, 0xdc, 0xa8, 0xb4, 0x7a, 0xa6, 0x00, 0xef, 0x9c, 0x53, 0x19,
-	0x18, 0xbd, 0x0c, 0xe9, 0x4e, 0x7f, 0xe4, 0xf4, 0x8a, 0x09, 0x26, 0xb5, 0x3a, 0x2d, 0xb5, 0x4d,
-	0x87, 0x7d, 0x31, 0x0e, 0xa7, 0x1f, 0x33, 0x86, 0x1d, 0xb3, 0x98, 0x8c, 0xfb, 0xd8, 0xee, 0xb0,
-	0x13, 0xfc, 0x18, 0x05, 0xa3, 0x32, 0x80, 0x31, 0x34, 0x88, 0xd6, 0xea, 0xe9, 0xc6, 0xb0, 0x98,
-	0x66, 0xa2, 0x4a, 0x94, 0xa8, 0x41, 0xca, 0x14, 0xe2, 0xcb, 0x67, 0x0d, 0xb7, 0x8f, 0xce, 0xf8,
-	0x83, 0x11, 0xb6, 0x8f, 0x8b, 0x33, 0x71, 0x33, 0x7e, 0x87, 0x0e, 0x07, 0x66, 0xcc, 0xe0, 0xe8,
-	0x0d, 0xc8, 0xb4, 0x7a, 0xb8, 0xf5, 0x48, 0x23, 0xe3, 0x62, 0x86, 0x89, 0xae, 0x4f, 0x8b, 0x96,
-	0x29, 0xa2, 0x31, 0xf6, 0x85, 0x67, 0x5b, 0xbc, 0x07, 0xbd, 0x06, 0x33, 0x2d, 0x73, 0x30, 0x30,
-	0x48, 0x31, 0xc7, 0x84, 0xd7, 0x22, 0x84, 0xd9, 0xb8, 0x2f, 0x2b, 0x04, 0x50, 0x15, 0xe6, 0xfa,
-	0x86, 0x43, 0x34, 0x67, 0xa8, 0x5b, 0x4e, 0xcf, 0x24, 0x4e, 0x31, 0xcf, 0x54, 0x3c, 0x3b, 0xad,
-	0x62, 0xdf, 0x70, 0x48, 0xdd, 0x85, 0xf9, 0x9a, 0x0a, 0xfd, 0x60, 0x3f, 0x55, 0x68, 0x76, 0x3a,
-	0xd8, 0xf6, 0x34, 0x16, 0x0b, 0x71, 0x0a, 0xab, 0x14, 0xe7, 0x4a, 0x06, 0x14, 0x9a, 0xc1, 0x7e,
-	0xf4, 0xff, 0xb0, 0xd8, 0x37, 0xf5, 0xb6, 0xa7, 0x4f, 0x6b, 0xf5, 0x46, 0xc3, 0x47, 0xc5, 0x39,
-	0xa6, 0xf5, 0x46, 0xc4, 0x34, 0x4d, 0xbd, 0xed, 0x0a, 0x97, 0x29, 0xd4, 0xd7, 0xbc, 0xd0, 0x9f,
-	0x1c, 0x43, 0x1a, 0x2c, 0xe9, 0x96, 0xd5, 0x3f, 0x9e, 0x54, 0x3f, 0xcf, 0xd4, 0xdf, 0x9c, 0x56,
-	0x5f, 0xa2, 0xe8, 0x18, 0xfd, 0x48, 0x9f, 0x1a, 0x44, 0xf7, 0x41, 0xb6, 0x6c, 0x6c, 0xe9, 0x36,
-	0xd6, 0x2c, 0xdb, 0xb4, 0x4c, 0x47, 0xef, 0x17, 0x65, 0xa6, 0xfc, 0xfa, 0xb4, 0xf2, 0x1a, 0x47,
-	0xd6, 0x04, 0xd0, 0xd7, 0x3c, 0x6f, 0x85, 0x47, 0xb8, 0x5a, 0xb3, 0x85, 0x1d, 0xc7, 0x57, 0xbb,
-	0x10, 0xaf, 0x96, 0x21, 0x23, 0xd5, 0x86, 0x46, 0xd0, 0x36, 0xe4, 0xf0, 0x98, 0xe0, 0x61, 0x5b,
-	0x3b, 0x32, 0x09, 0x2e, 0x22, 0xa6, 0xf1, 0x4a, 0xc4, 0x75, 0x65, 0xa0, 0x43, 0x93, 0x60, 0x5f,
-	0x19, 0x60, 0xaf, 0x13, 0x35, 0x61, 0xf9, 0x08, 0xdb, 0x46, 0xe7, 0x98, 0xe9, 0xd1, 0xd8, 0x88,
-	0x63, 0x98, 0xc3, 0xe2, 0x22, 0xd3, 0xf8, 0xfc, 0xb4, 0xc6, 0x43, 0x06, 0xa7, 0xc2, 0x15, 0x17,
-	0xec, 0xab, 0x5e, 0x3c, 0x9a, 0x1e, 0xa5, 0x96, 0xd6, 0x31, 0x86, 0x7a, 0xdf, 0xf8, 0x0e, 0xd6,
-	0x9a, 0x7d, 0xb3, 0xf5, 0xa8, 0xb8, 0x14, 0x67, 0x69, 0xdb, 0x02, 0xb7, 0x45, 0x61, 0x01, 0x4b,
-	0xeb, 0x04, 0xfb, 0xb7, 0x66, 0x21, 0x7d, 0xa4, 0xf7, 0x47, 0x78, 0x2f, 0x95, 0x49, 0xc9, 0xe9,
-	0xbd, 0x54, 0x66, 0x56, 0xce, 0xec, 0xa5, 0x32, 0x59, 0x19, 0xf6, 0x52, 0x19, 0x90, 0x73, 0xca,
-	0x35, 0xc8, 0x05, 0xfc, 0x14, 0x2a, 0xc2, 0xec, 0x00, 0x3b, 0x8e, 0xde, 0xc5, 0xcc, 0xaf, 0x65,
-	0x55, 0xb7, 0xa9, 0xcc, 0x41, 0x3e, 0xe8, 0x9a, 0x94, 0x4f, 0x24, 0xc8, 0x05, 0x9c, 0x0e, 0x95,
-	0x3c, 0xc2, 0x36, 0xdb, 0x10, 0x21, 0x29, 0x9a, 0xe8, 0x0a, 0x14, 0xd8, 0x5a, 0x34, 0x77, 0x9c,
-	0xfa, 0xbe, 0x94, 0x9a, 0x67, 0x9d, 0x87, 0x02, 0xb4, 0x06, 0x39, 0xeb, 0xb6, 0xe5, 0x41, 0x92,
-	0x0c, 0x02, 0xd6, 0x6d, 0xcb, 0x05, 0x5c, 0x86, 0x3c, 0x5d, 0xba, 0x87, 0x48, 0xb1, 0x8f, 0xe4,
-	0x68, 0x9f, 0x80, 0x28, 0x7f, 0x4c, 0x80, 0x3c, 0xe9, 0xcc, 0xd0, 0xab, 0x90, 0xa2, 0x5e, 0x5e,
-	0xb8, 0xe9, 0x95, 0x0d, 0xee, 0xe1, 0x37, 0x5c, 0x0f, 0xbf, 0xd1, 0x70, 0x43, 0xc0, 0x56, 0xe6,
-	0xd3, 0xcf, 0xd7, 0xce, 0x7d, 0xf2, 0x97, 0x35, 0x49, 0x65, 0x12, 0xe8, 0x22, 0xf5, 0x60, 0xba,
-	0x31, 0xd4, 0x8c, 0x36, 0x9b, 0x72, 0x96, 0x7a, 0x27, 0xdd, 0x18, 0xee, 0xb6, 0xd1, 0x3d, 0x90,
-	0x5b, 0xe6, 0xd0, 0xc1, 0x43, 0x67, 0xe4, 0x68, 0x3c, 0x36, 0x09, 0xd7, 0x1c, 0xf0, 0xaf, 0x3c,
-	0x08, 0x32, 0x47, 0x25, 0xa0, 0x35, 0x86, 0x54, 0xe7, 0x5b, 0xe1, 0x0e, 0xf4, 0x36, 0x80, 0x17,
-	0xc0, 0x9c, 0x62, 0x6a, 0x3d, 0x79, 0x3d, 0x77, 0xfb, 0x72, 0x84, 0x3d, 0xb9, 0x98, 0xfb, 0x56,
-	0x5b, 0x27, 0x78, 0x2b, 0x45, 0x27, 0xac, 0x06, 0x44, 0xd1, 0xb3, 0x30, 0xaf, 0x5b, 0x96, 0xe6,
-	0x10, 0x9d, 0x60, 0xad, 0x79, 0x4c, 0xb0, 0xc3, 0xdc, 0x7e, 0x5e, 0x2d, 0xe8, 0x96, 0x55, 0xa7,
-	0xbd, 0x5b, 0xb4, 0x13, 0x5d, 0x85, 0x39, 0xea, 0xe1, 0x0d, 0xbd, 0xaf, 0xf5, 0xb0, 0xd1, 0xed,
-	0x11, 0xe6, 0xdd, 0x93, 0x6a, 0x41, 0xf4, 0xee, 0xb0, 0x4e, 0xa5, 0x0d, 0xf9, 0xa0, 0x73, 0x47,
-	0x08, 0x52, 0x6d, 0x9d, 0xe8, 0x6c, 0x2f, 0xf3, 0x2a, 0xfb, 0x4d, 0xfb, 0x2c, 0x9d, 0xf4, 0xc4,
-	0x0e, 0xb1, 0xdf, 0xe8, 0x3c, 0xcc, 0x08, 0xb5, 0x49, 0xa6, 0x56, 0xb4, 0xd0, 0x12, 0xa4, 0x2d,
-	0xdb, 0x3c, 0xc2, 0xec, 0xf0, 0x32, 0x2a, 0x6f, 0x28, 0x0f, 0x60, 0x2e, 0x1c, 0x07, 0xd0, 0x1c,
-	0x24, 0xc8, 0x58, 0x7c, 0x25, 0x41, 0xc6, 0xe8, 0x16, 0xa4, 0xe8, 0x66, 0x32, 0x6d, 0x73, 0x51,
-	0xd1, 0x4f, 0xc8, 0x37, 0x8e, 0x2d, 0xac, 0x32, 0xe8, 0x5e, 0x2a, 0x93, 0x90, 0x93, 0xca, 0x3c,
-	0x14, 0x42, 0x51, 0x42, 0x39, 0x0f, 0x4b, 0x51, 0x3e, 0x5f, 0x31, 0x60, 0x29, 0xca, 0x75, 0xa3,
-	0x97, 0x21, 0xe3, 0x39, 0x7d, 0xd7, 0x82, 0xa6, 0xbe, 0xee, 0x09, 0x79, 0x58, 0x6a, 0x3b, 0xf4,
-	0x20, 0x7a, 0xba, 0x08, 0xf5, 0x79, 0x75, 0x56, 0xb7, 0xac, 0x1d, 0xdd, 0xe9, 0x29, 0xef, 0x41,
-	0x31, 0xce, 0x9f, 0x07, 0x36, 0x4e, 0x62, 0x17, 0xc0, 0xdd, 0xb8, 0xf3, 0x30, 0xd3, 0x31, 0xed,
-	0x81, 0x4e, 0x98, 0xb2, 0x82, 0x2a, 0x5a, 0x74, 0x43, 0xb9, 0x6f, 0x4f, 0xb2, 0x6e, 0xde, 0x50,
-	0x34, 0xb8, 0x18, 0xeb, 0xd2, 0xa9, 0x88, 0x31, 0x6c, 0x63, 0xbe, 0xbd, 0x05, 0x95, 0x37, 0x7c,
-	0x45, 0x7c, 0xb2, 0xbc, 0x41, 0x3f, 0xeb, 0xe0, 0x61, 0x1b, 0xdb, 0x4c, 0x7f, 0x56, 0x15, 0x2d,
-	0xe5, 0xa7, 0x49, 0x38, 0x1f, 0xed, 0xd7, 0xd1, 0x3a, 0xe4, 0x07, 0xfa, 0x58, 0x23, 0x63, 0x61,
-	0x7e, 0x12, 0x33, 0x00, 0x18, 0xe8, 0xe3, 0xc6, 0x98, 0xdb, 0x9e, 0x0c, 0x49, 0x32, 0x76, 0x8a,
-	0x89, 0xf5, 0xe4, 0xf5, 0xbc, 0x4a, 0x7f, 0xa2, 0x43, 0x58, 0xe8, 0x9b, 0x2d, 0xbd, 0xaf, 0xf5,
-	0x75, 0x87, 0x68, 0x22, 0xec, 0xf3, 0xeb, 0xf4, 0x4c, 0x9c, 0x9f, 0xc6, 0x6d, 0x7e, 0xb0, 0xd4,
-	0x05, 0x89, 0x8b, 0x30, 0xcf, 0x94, 0xec, 0xeb, 0x0e, 0xe1, 0x43, 0xa8, 0x02, 0xb9, 0x81, 0xe1,
-	0x34, 0x71, 0x4f, 0x3f, 0x32, 0x4c, 0x5b, 0xdc, 0xab, 0x08, 0xeb, 0xb9, 0xe7, 0x83, 0x84, 0xaa,
-	0xa0, 0x5c, 0xe0, 0x50, 0xd2, 0x21, 0x6b, 0x76, 0x3d, 0xcb, 0xcc, 0x63, 0x7b, 0x96, 0xff, 0x82,
-	0xa5, 0x21, 0x1e, 0x13, 0xcd, 0xbf, 0xb9, 0xdc, 0x52, 0x66, 0xd9, 0xe6, 0x23, 0x3a, 0xe6, 0xdd,
-	0x75, 0x87, 0x1a, 0x0d, 0x7a, 0x8e, 0xc5, 0x46, 0xcb, 0x74, 0xb0, 0xad, 0xe9, 0xed, 0xb6, 0x8d,
-	0x1d, 0x87, 0x65, 0x55, 0x79, 0x16, 0xef, 0x58, 0x7f, 0x89, 0x77, 0x2b, 0x1f, 0xb3, 0xc3, 0x89,
-	0x8a, 0x8e, 0xee, 0xd6, 0x4b, 0xfe, 0xd6, 0x37, 0x60, 0x49, 0xc8, 0xb7, 0x43, 0xbb, 0xcf, 0xd3,
-	0xd3, 0x4b, 0x71, 0x49, 0x57, 0x60, 0xd7, 0x91, 0x2b, 0x1f, 0xbf, 0xf1, 0xc9, 0x27, 0xdc, 0x78,
-	0x04, 0x29, 0xb6, 0x2d, 0x29, 0xee, 0x6e, 0xe8, 0xef, 0x7f, 0xb5, 0xc3, 0xf8, 0x28, 0x09, 0x0b,
-	0x53, 0x89, 0x85, 0xb7, 0x30, 0x29, 0x72, 0x61, 0x89, 0xc8, 0x85, 0x25, 0x1f, 0x7b, 0x61, 0xe2,
-	0xb4, 0x53, 0xa7, 0x9f, 0x76, 0xfa, 0x9b, 0x3c, 0xed, 0x99, 0x27, 0x3c, 0xed, 0x7f, 0xea, 0x39,
-	0xfc, 0x4c, 0x82, 0x95, 0xf8, 0x74, 0x2c, 0xf2, 0x40, 0x6e, 0xc2, 0x82, 0x37, 0x15, 0x4f, 0x3d,
-	0x77, 0x8f, 0xb2, 0x37, 0x20, 0xf4, 0xc7, 0x46, 0xbc, 0xab, 0x30, 0x37, 0x91, 0x2d, 0x72, 0x63,
-	0x2e, 0x1c, 0x05, 0xa7, 0xa1, 0xfc, 0x36, 0x09, 0x4b, 0x51, 0x09, 0x5d, 0xc4, 0x8d, 0x55, 0x61,
-	0xb1, 0x8d, 0x5b, 0x46, 0xfb, 0x89, 0x2f, 0xec, 0x82, 0x10, 0xff, 0xcf, 0x7d, 0x9d, 0xb6, 0x13,
-	0x74, 0x03, 0x16, 0x9c, 0xe3, 0x61, 0xcb, 0x18, 0x76, 0x35, 0x62, 0xba, 0xb9, 0x51, 0x96, 0xcd,
-	0x7c, 0x5e, 0x0c, 0x34, 0x4c, 0x91, 0x1d, 0xfd, 0x0a, 0x20, 0xa3, 0x62, 0xc7, 0xa2, 0xc9, 0x1c,
-	0x2a, 0x43, 0x16, 0x8f, 0x5b, 0xd8, 0x22, 0x6e, 0x02, 0x1c, 0x53, 0x63, 0x08, 0x88, 0x2b, 0x47,
-	0x6b, 0x6d, 0x4f, 0x0e, 0xbd, 0x24, 0x28, 0x85, 0x58, 0x72, 0x80, 0xa7, 0xea, 0x9e, 0x28, 0xe7,
-	0x14, 0x5e, 0x71, 0x39, 0x85, 0x64, 0x5c, 0xa5, 0x2c, 0x12, 0x77, 0x4f, 0x4e, 0x90, 0x0a, 0x2f,
-	0x09, 0x52, 0x21, 0x15, 0xf7, 0x39, 0x9e, 0xdf, 0xfb, 0x9f, 0x63, 0xac, 0xc2, 0x9d, 0x10, 0xab,
-	0x30, 0x13, 0xb7, 0xd4, 0x40, 0x22, 0xee, 0x2f, 0xd5, 0xa7, 0x15, 0x5e, 0x71, 0x69, 0x85, 0xd9,
-	0xb8, 0x49, 0x8b, 0xcc, 0xd3, 0x9f, 0x34, 0xe7, 0x15, 0xde, 0x0c, 0xf0, 0x0a, 0x59, 0x26, 0x7b,
-	0xf9, 0x04, 0x5e, 0xc1, 0x93, 0xf6, 0x88, 0x85, 0xff, 0xf1, 0x88, 0x85, 0x7c, 0x2c, 0x2b, 0x21,
-	0x52, 0x46, 0x4f, 0xd8, 0x65, 0x16, 0x6a, 0x53, 0xcc, 0x02, 0x27, 0x02, 0xae, 0x9d, 0xca, 0x2c,
-	0x78, 0xaa, 0x26, 0xa8, 0x85, 0xda, 0x14, 0xb5, 0x30, 0x17, 0xa7, 0x71, 0x22, 0x3f, 0xf5, 0x35,
-	0x86, 0xb9, 0x85, 0x6f, 0x45, 0x73, 0x0b, 0xb1, 0xc5, 0x7f, 0x44, 0x2e, 0xea, 0xa9, 0x8e, 0x20,
-	0x17, 0xde, 0x8b, 0x21, 0x17, 0xe4, 0xb8, 0x22, 0x38, 0x2a, 0x13, 0xf5, 0x3e, 0x10, 0xc5, 0x2e,
-	0x1c, 0x46, 0xb0, 0x0b, 0x9c, 0x06, 0x78, 0xee, 0x0c, 0xec, 0x82, 0xa7, 0x7a, 0x8a, 0x5e, 0x38,
-	0x8c, 0xa0, 0x17, 0x50, 0xbc, 0xde, 0x89, 0x04, 0x2a, 0xa8, 0x37, 0xcc, 0x2f, 0xbc, 0x1d, 0xe6,
-	0x17, 0x16, 0x4f, 0xce, 0x5b, 0x79, 0x1a, 0xe0, 0x69, 0x0b, 0x12, 0x0c, 0xad, 0x38, 0x82, 0x81,
-	0x73, 0x00, 0x2f, 0x9c, 0x91, 0x60, 0xf0, 0x74, 0x47, 0x32, 0x0c, 0xb5, 0x29, 0x86, 0x61, 0x39,
-	0xce, 0xe0, 0x26, 0x02, 0x92, 0x6f, 0x70, 0xb1, 0x14, 0x43, 0x5a, 0x9e, 0xd9, 0x4b, 0x65, 0x32,
-	0x72, 0x96, 0x93, 0x0b, 0x7b, 0xa9, 0x4c, 0x4e, 0xce, 0x2b, 0xcf, 0xd1, 0x14, 0x68, 0xc2, 0xef,
-	0xd1, 0x82, 0x03, 0xdb, 0xb6, 0x69, 0x0b, 0xb2, 0x80, 0x37, 0x94, 0xeb, 0x90, 0x0f, 0xba, 0xb8,
-	0x13, 0xe8, 0x88, 0x79, 0x28, 0x84, 0xbc, 0x9a, 0xf2, 0x3b, 0x09, 0xf2, 0x41, 0x7f, 0x15, 0x2a,
-	0x56, 0xb3, 0xa2, 0x58, 0x0d, 0x90, 0x14, 0x89, 0x30, 0x49, 0xb1, 0x06, 0x39, 0x5a, 0xb0, 0x4d,
-	0xf0, 0x0f, 0xba, 0xe5, 0xf1, 0x0f, 0x37, 0x60, 0x81, 0xc5, 0x5b, 0x4e, 0x65, 0x88, 0xc8, 0x90,
-	0xe2, 0x91, 0x81, 0x0e, 0xb0, 0xcd, 0xe0, 0x91, 0x01, 0xbd, 0x00, 0x8b, 0x01, 0xac, 0x57, 0x08,
-	0xf2, 0x52, 0x5c, 0xf6, 0xd0, 0x25, 0x51, 0x11, 0xfe, 0x41, 0x82, 0x85, 0x29, 0x77, 0x19, 0xc9,
-	0x31, 0x48, 0xdf, 0x14, 0xc7, 0x90, 0x78, 0x72, 0x8e, 0x21, 0x58, 0xda, 0x26, 0xc3, 0xa5, 0xed,
-	0xdf, 0x25, 0x28, 0x84, 0xdc, 0x36, 0x3d, 0x84, 0x96, 0xd9, 0xc6, 0xa2, 0xd8, 0x64, 0xbf, 0x69,
-	0x4e, 0xd3, 0x37, 0xbb, 0xa2, 0xa4, 0xa4, 0x3f, 0x29, 0xca, 0x0b, 0x44, 0x59, 0x11, 0x66, 0xbc,
-	0x3a, 0x95, 0xe7, 0x0d, 0xa2, 0x4e, 0x95, 0x21, 0xf9, 0x08, 0x73, 0x2e, 0x3a, 0xaf, 0xd2, 0x9f,
-	0x14, 0xc7, 0xcc, 0x4f, 0xc4, 0x7f, 0xde, 0x40, 0xaf, 0x41, 0x96, 0xbd, 0x28, 0x68, 0xa6, 0xe5,
-	0x08, 0xfa, 0x39, 0x90, 0x1b, 0xf1, 0x67, 0x07, 0x71, 0xcf, 0xcd, 0x4e, 0xd5, 0x72, 0xd4, 0x8c,
-	0x25, 0x7e, 0x05, 0x32, 0x96, 0x6c, 0x28, 0x63, 0xb9, 0x04, 0x59, 0x3a, 0x7d, 0xc7, 0xd2, 0x5b,
-	0xb8, 0x08, 0x6c, 0xa6, 0x7e, 0x87, 0xf2, 0xeb, 0x04, 0xcc, 0x4f, 0x44, 0x9d, 0xc8, 0xc5, 0xbb,
-	0x56, 0x99, 0x08, 0x50, 0x28, 0x67, 0xdb, 0x90, 0x55, 0x80, 0xae, 0xee, 0x68, 0x1f, 0xea, 0x43,
-	0x82, 0xdb, 0x62, 0x57, 0x02, 0x3d, 0x68, 0x05, 0x32, 0xb4, 0x35, 0x72, 0x70, 0x5b, 0xb0, 0x39,
-	0x5e, 0x1b, 0xed, 0xc2, 0x0c, 0x3e, 0xc2, 0x43, 0xe2, 0x14, 0x67, 0xd9, 0xc1, 0x5f, 0x88, 0x70,
-	0x4f, 0x74, 0x7c, 0xab, 0x48, 0x8f, 0xfb, 0x6f, 0x9f, 0xaf, 0xc9, 0x1c, 0xfe, 0xbc, 0x39, 0x30,
-	0x08, 0x1e, 0x58, 0xe4, 0x58, 0x15, 0x0a, 0xc2, 0xdb, 0x90, 0x99, 0xd8, 0x06, 0x46, 0x2d, 0xe6,
-	0x5d, 0x9e, 0x80, 0x6e, 0xaa, 0x61, 0xda, 0x06, 0x39, 0x56, 0x0b, 0x03, 0x3c, 0xb0, 0x4c, 0xb3,
-	0xaf, 0xf1, 0x7b, 0x5e, 0x82, 0xb9, 0x70, 0x90, 0x45, 0x57, 0xa0, 0x60, 0x63, 0xa2, 0x1b, 0x43,
-	0x2d, 0x94, 0x47, 0xe7, 0x79, 0x27, 0xbf, 0x57, 0x7b, 0xa9, 0x8c, 0x24, 0x27, 0x04, 0xb5, 0xf3,
-	0x0e, 0x2c, 0x47, 0xc6, 0x58, 0xf4, 0x2a, 0x64, 0xfd, 0xf8, 0x2c, 0xb1, 0xe5, 0x9e, 0xc4, 0xd9,
-	0xf8, 0x60, 0xe5, 0x10, 0x96, 0x23, 0x83, 0x2c, 0x7a, 0x03, 0x66, 0x6c, 0xec, 0x8c, 0xfa, 0x9c,
-	0x96, 0x99, 0xbb, 0x7d, 0xf5, 0xf4, 0xe8, 0x3c, 0xea, 0x13, 0x55, 0x08, 0x29, 0xb7, 0xe0, 0x62,
-	0x6c, 0x94, 0xf5, 0x99, 0x17, 0x29, 0xc0, 0xbc, 0x28, 0xbf, 0x91, 0x60, 0x25, 0x3e, 0x72, 0xa2,
-	0xad, 0x89, 0x09, 0xdd, 0x38, 0x63, 0xdc, 0x0d, 0xcc, 0x8a, 0x96, 0x26, 0x36, 0xee, 0x60, 0xd2,
-	0xea, 0xf1, 0x10, 0xce, 0x9d, 0x42, 0x41, 0x2d, 0x88, 0x5e, 0x26, 0xe3, 0x70, 0xd8, 0xfb, 0xb8,
-	0x45, 0x34, 0x7e, 0xa8, 0x0e, 0x2b, 0x0f, 0xb2, 0x14, 0x46, 0x7b, 0xeb, 0xbc, 0x53, 0xb9, 0x09,
-	0x17, 0x62, 0x62, 0xf1, 0x74, 0x0d, 0xa3, 0x3c, 0xa4, 0xe0, 0xc8, 0x00, 0x8b, 0xde, 0x82, 0x19,
-	0x87, 0xe8, 0x64, 0xe4, 0x88, 0x95, 0x5d, 0x3b, 0x35, 0x36, 0xd7, 0x19, 0x5c, 0x15, 0x62, 0xca,
-	0xeb, 0x80, 0xa6, 0x23, 0x6d, 0x44, 0x1d, 0x26, 0x45, 0xd5, 0x61, 0x4d, 0x78, 0xea, 0x84, 0x98,
-	0x8a, 0xca, 0x13, 0x93, 0xbb, 0x79, 0xa6, 0x90, 0x3c, 0x31, 0xc1, 0xdf, 0x27, 0x61, 0x39, 0x32,
-	0xb4, 0x06, 0x6e, 0xa9, 0xf4, 0x75, 0x6f, 0xe9, 0x1b, 0x00, 0x64, 0xac, 0xf1, 0x93, 0x76, 0xbd,
-	0x7d, 0x54, 0x3d, 0x31, 0xc6, 0x2d, 0xe6, 0xb0, 0xa8, 0x61, 0x64, 0x89, 0xf8, 0xe5, 0xa0, 0x46,
-	0xb0, 0xf6, 0x1d, 0xb1, 0x48, 0xe0, 0x88, 0xb2, 0xf0, 0xcc, 0x31, 0xc3, 0x2f, 0x92, 0x79, 0xb7,
-	0x83, 0x1e, 0xc2, 0x85, 0x89, 0x88, 0xe6, 0xe9, 0x4e, 0x9d, 0x39, 0xb0, 0x2d, 0x87, 0x03, 0x9b,
-	0xab, 0x3b, 0x18, 0x95, 0xd2, 0xa1, 0xa8, 0x44, 0x03, 0x29, 0x2b, 0x18, 0x79, 0x34, 0x6e, 0xe3,
-	0xbe, 0xee, 0x3e, 0x66, 0x5e, 0x9c, 0x2a, 0x3b, 0xef, 0x88, 0xf7, 0x5e, 0x5e, 0x75, 0xfe, 0x84,
-	0x56, 0x9d, 0x73, 0x54, 0x98, 0x1d, 0xd4, 0x1d, 0x2a, 0xaa, 0x3c, 0x04, 0xf0, 0x6b, 0x6a, 0x7a,
-	0x7d, 0x6d, 0x73, 0x34, 0x6c, 0x33, 0x8b, 0x48, 0xab, 0xbc, 0x81, 0x5e, 0x86, 0x34, 0x35, 0x2c,
-	0x77, 0xe7, 0x23, 0xfc, 0x0f, 0xb5, 0x90, 0x40, 0x51, 0xce, 0xe1, 0xca, 0xfb, 0xae, 0xf1, 0x06,
-	0xe9, 0xcd, 0x98, 0x6f, 0xbc, 0x19, 0xfe, 0x86, 0x12, 0xcf, 0x94, 0x46, 0x7f, 0xeb, 0xbb, 0x90,
-	0x66, 0xd6, 0x44, 0x83, 0x0d, 0x63, 0xd7, 0x45, 0xa2, 0x44, 0x7f, 0xa3, 0x6f, 0x03, 0xe8, 0x84,
-	0xd8, 0x46, 0x73, 0xe4, 0x7f, 0x61, 0x3d, 0xc6, 0x1c, 0x4b, 0x2e, 0x70, 0xeb, 0x92, 0xb0, 0xcb,
-	0x25, 0x5f, 0x36, 0x60, 0x9b, 0x01, 0x8d, 0xca, 0x01, 0xcc, 0x85, 0x65, 0xdd, 0xc8, 0xce, 0x27,
-	0x11, 0x8e, 0xec, 0x3c, 0x55, 0x13, 0x91, 0xdd, 0xcb, 0x0b, 0x92, 0xfc, 0x0d, 0x81, 0x35, 0x94,
-	0xef, 0x25, 0x20, 0x1f, 0x34, 0xe6, 0x7f, 0xc3, 0xd8, 0xab, 0xfc, 0x50, 0x82, 0x8c, 0xb7, 0xfe,
-	0xf0, 0x4b, 0x42, 0xe8, 0x09, 0x86, 0x6f, 0x5f, 0x22, 0x48, 0xff, 0xf3, 0x07, 0x97, 0xa4, 0xf7,
-	0xe0, 0xf2, 0xbf, 0x5e, 0x7c, 0x89, 0xe5, 0x06, 0x82, 0xbb, 0x2d, 0x0c, 0xcb, 0x8d, 0x77, 0xaf,
-	0x43, 0xd6, 0x73, 0x09, 0x34, 0xe5, 0x76, 0x39, 0x17, 0x49, 0xdc, 0x4b, 0xc1, 0xb5, 0x2c, 0x41,
-	0xda, 0x32, 0x3f, 0x14, 0x8f, 0x0b, 0x49, 0x95, 0x37, 0x14, 0x07, 0xe6, 0x27, 0xfc, 0x89, 0x0f,
-	0x4c, 0x04, 0x80, 0x48, 0x81, 0x82, 0x35, 0x6a, 0x6a, 0x8f, 0xf0, 0xb1, 0x78, 0x6a, 0xe0, 0xd3,
-	0xcf, 0x59, 0xa3, 0xe6, 0x5d, 0x7c, 0xcc, 0xdf, 0x1a, 0xd6, 0x21, 0xef, 0x62, 0x98, 0x89, 0xf3,
-	0x33, 0x05, 0x0e, 0x69, 0xf0, 0x77, 0x22, 0x49, 0x4e, 0x28, 0x3f, 0x96, 0x20, 0xe3, 0xde, 0x12,
-	0xf4, 0x16, 0x64, 0x3d, 0xd7, 0x25, 0x32, 0xee, 0xa7, 0x4e, 0x70, 0x7a, 0x62, 0xf1, 0xbe, 0x0c,
-	0xda, 0x72, 0x1f, 0x3c, 0x8d, 0xb6, 0xd6, 0xe9, 0xeb, 0x5d, 0xf1, 0x6e, 0xb5, 0x1a, 0xe1, 0xdd,
-	0x98, 0x5f, 0xd9, 0xbd, 0xb3, 0xdd, 0xd7, 0xbb, 0x6a, 0x8e, 0x09, 0xed, 0xb6, 0x69, 0x43, 0x24,
-	0x39, 0x5f, 0x49, 0x20, 0x4f, 0xde, 0xe2, 0xaf, 0x3f, 0xbf, 0xe9, 0x60, 0x98, 0x8c, 0x08, 0x86,
-	0x68, 0x13, 0x16, 0x3d, 0x84, 0xe6, 0x18, 0xdd, 0xa1, 0x4e, 0x46, 0x36, 0x16, 0xec, 0x1e, 0xf2,
-	0x86, 0xea, 0xee, 0xc8, 0xf4, 0xba, 0xd3, 0x4f, 0xba, 0xee, 0x8f, 0x12, 0x90, 0x0b, 0x90, 0x8d,
-	0xe8, 0xbf, 0x03, 0x2e, 0x6a, 0x2e, 0x2a, 0x04, 0x05, 0xc0, 0xfe, 0x23, 0x60, 0x78, 0xa7, 0x12,
-	0x4f, 0xb0, 0x53, 0x71, 0xb4, 0xae, 0xcb, 0x5e, 0xa6, 0x1e, 0x9b, 0xbd, 0x7c, 0x1e, 0x10, 0x31,
-	0x89, 0xde, 0xa7, 0x35, 0xbe, 0x31, 0xec, 0x6a, 0xdc, 0xb0, 0xb9, 0x47, 0x91, 0xd9, 0xc8, 0x21,
-	0x1b, 0xa8, 0xb1, 0xcb, 0xf0, 0x7d, 0x09, 0x32, 0x1e, 0xb3, 0xf3, 0xb8, 0x8f, 0x83, 0xe7, 0x61,
-	0x46, 0x24, 0x76, 0xfc, 0x75, 0x50, 0xb4, 0x22, 0x69, 0xda, 0x15, 0xc8, 0x0c, 0x30, 0xd1, 0x99,
-	0x7b, 0xe4, 0xe1, 0xd3, 0x6b, 0xdf, 0x68, 0x42, 0x2e, 0xf0, 0xbe, 0x8a, 0x2e, 0xc2, 0x72, 0x79,
-	0xa7, 0x52, 0xbe, 0xab, 0x35, 0xde, 0xd5, 0x1a, 0x0f, 0x6a, 0x15, 0xed, 0xfe, 0xc1, 0xdd, 0x83,
-	0xea, 0xff, 0x1d, 0xc8, 0xe7, 0xa6, 0x87, 0xd4, 0x0a, 0x6b, 0xcb, 0x12, 0xba, 0x00, 0x8b, 0xe1,
-	0x21, 0x3e, 0x90, 0x58, 0x49, 0xfd, 0xe8, 0x97, 0xab, 0xe7, 0x6e, 0x7c, 0x25, 0xc1, 0x62, 0x44,
-	0x0a, 0x8d, 0x2e, 0xc3, 0xd3, 0xd5, 0xed, 0xed, 0x8a, 0xaa, 0xd5, 0x0f, 0x4a, 0xb5, 0xfa, 0x4e,
-	0xb5, 0xa1, 0xa9, 0x95, 0xfa, 0xfd, 0xfd, 0x46, 0xe0, 0xa3, 0xeb, 0x70, 0x29, 0x1a, 0x52, 0x2a,
-	0x97, 0x2b, 0xb5, 0x86, 0x2c, 0xa1, 0x35, 0x78, 0x2a, 0x06, 0xb1, 0x55, 0x55, 0x1b, 0x72, 0x22,
-	0x5e, 0x85, 0x5a, 0xd9, 0xab, 0x94, 0x1b, 0x72, 0x12, 0x5d, 0x83, 0x2b, 0x27, 0x21, 0xb4, 0xed,
-	0xaa, 0x7a, 0xaf, 0xd4, 0x90, 0x53, 0xa7, 0x02, 0xeb, 0x95, 0x83, 0x3b, 0x15, 0x55, 0x4e, 0x8b,
-	0x75, 0xff, 0x22, 0x01, 0xc5, 0xb8, 0x4c, 0x9d, 0xea, 0x2a, 0xd5, 0x6a, 0xfb, 0x0f, 0x7c, 0x5d,
-	0xe5, 0x9d, 0xfb, 0x07, 0x77, 0xa7, 0xb7, 0xe0, 0x59, 0x50, 0x4e, 0x02, 0x7a, 0x1b, 0x71, 0x15,
-	0x2e, 0x9f, 0x88, 0x13, 0xdb, 0x71, 0x0a, 0x4c, 0xad, 0x34, 0xd4, 0x07, 0x72, 0x12, 0x6d, 0xc0,
-	0x8d, 0x53, 0x61, 0xde, 0x98, 0x9c, 0x42, 0x9b, 0x70, 0xf3, 0x64, 0x3c, 0xdf, 0x20, 0x57, 0xc0,
-	0xdd, 0xa2, 0x8f, 0x25, 0x58, 0x8e, 0x4c, 0xf9, 0xd1, 0x15, 0x58, 0xab, 0xa9, 0xd5, 0x72, 0xa5,
-	0x5e, 0xd7, 0x6a, 0x6a, 0xb5, 0x56, 0xad, 0x97, 0xf6, 0xb5, 0x7a, 0xa3, 0xd4, 0xb8, 0x5f, 0x0f,
-	0xec, 0x8d, 0x02, 0xab, 0x71, 0x20, 0x6f, 0x5f, 0x4e, 0xc0, 0x08, 0x0b, 0x70, 0xed, 0xf4, 0xe7,
-	0x12, 0x5c, 0x8c, 0x4d, 0xf1, 0xd1, 0x75, 0x78, 0xe6, 0xb0, 0xa2, 0xee, 0x6e, 0x3f, 0xd0, 0x0e,
-	0xab, 0x8d, 0x8a, 0x56, 0x79, 0xb7, 0x51, 0x39, 0xa8, 0xef, 0x56, 0x0f, 0xa6, 0x67, 0x75, 0x0d,
-	0xae, 0x9c, 0x88, 0xf4, 0xa6, 0x76, 0x1a, 0x70, 0x62, 0x7e, 0x3f, 0x90, 0x60, 0x7e, 0xc2, 0x17,
-	0xa2, 0x4b, 0x50, 0xbc, 0xb7, 0x5b, 0xdf, 0xaa, 0xec, 0x94, 0x0e, 0x77, 0xab, 0xea, 0xe4, 0x9d,
-	0xbd, 0x02, 0x6b, 0x53, 0xa3, 0x77, 0xee, 0xd7, 0xf6, 0x77, 0xcb, 0xa5, 0x46, 0x85, 0x7d, 0x54,
-	0x96, 0xe8, 0xc2, 0xa6, 0x40, 0xfb, 0xbb, 0x6f, 0xef, 0x34, 0xb4, 0xf2, 0xfe, 0x6e, 0xe5, 0xa0,
-	0xa1, 0x95, 0x1a, 0x8d, 0x92, 0x7f, 0x9d, 0xb7, 0xee, 0x7e, 0xfa, 0xc5, 0xaa, 0xf4, 0xd9, 0x17,
-	0xab, 0xd2, 0x5f, 0xbf, 0x58, 0x95, 0x3e, 0xf9, 0x72, 0xf5, 0xdc, 0x67, 0x5f, 0xae, 0x9e, 0xfb,
-	0xf3, 0x97, 0xab, 0xe7, 0x1e, 0xde, 0xea, 0x1a, 0xa4, 0x37, 0x6a, 0x52, 0x2f, 0xbc, 0xe9, 0xff,
-	0x0d, 0xd4, 0xfb, 0xff, 0xa8, 0x65, 0x6c, 0x4e, 0xfe, 0x99, 0xb4, 0x39, 0xc3, 0xdc, 0xea, 0x8b,
-	0xff, 0x08, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x5c, 0x57, 0x37, 0x67, 0x2a, 0x00, 0x00,
+	// 3229 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xd9, 0xf6, 0x92, 0x94, 0x44, 0xbe, 0x24, 0xa5, 0xd5, 0x48, 0xb2, 0xd7, 0x8a, 0x23, 0xc9, 0xeb,
+	0x38, 0x76, 0xec, 0x44, 0xfa, 0xec, 0x7c, 0x5f, 0x7e, 0xbe, 0x34, 0x09, 0x28, 0x9a, 0x8a, 0x24,
+	0xcb, 0x12, 0xb3, 0xa4, 0xd4, 0xd8, 0x68, 0xbb, 0x59, 0x91, 0x43, 0x71, 0x63, 0x92, 0xbb, 0xd9,
+	0x1d, 0x2a, 0x54, 0x7b, 0x2b, 0x9a, 0xa2, 0xc8, 0x29, 0x97, 0x02, 0x45, 0xd1, 0x02, 0x05, 0x8a,
+	0x9e, 0x0a, 0xf4, 0xd0, 0x53, 0x51, 0xa0, 0xd7, 0x22, 0xa7, 0x36, 0xc7, 0x9e, 0xd2, 0x22, 0xb9,
+	0xf5, 0x1e, 0xa0, 0xc7, 0x62, 0x7e, 0xf6, 0x8f, 0xbb, 0x2b, 0xd9, 0x4e, 0x7a, 0x28, 0xda, 0x1b,
+	0x67, 0xe6, 0x79, 0xdf, 0x99, 0x7d, 0x67, 0xe6, 0xfd, 0x79, 0x86, 0x70, 0xa9, 0x65, 0xf5, 0x31,
+	0x39, 0xec, 0x90, 0x35, 0xe3, 0xb0, 0x65, 0xae, 0x1d, 0xdf, 0x5a, 0x23, 0x27, 0x36, 0x76, 0x57,
+	0x6d, 0xc7, 0x22, 0x16, 0x92, 0xbd, 0xd1, 0x55, 0x3a, 0xba, 0x7a, 0x7c, 0x6b, 0x71, 0xc9, 0xc7,
+	0xb7, 0x9c, 0x13, 0x9b, 0x58, 0x54, 0xc2, 0x76, 0x2c, 0xab, 0xc3, 0x25, 0x42, 0xe3, 0x4c, 0x0f,
+	0x1b, 0x36, 0x1c, 0xa3, 0x2f, 0x34, 0x2e, 0x5e, 0x8e, 0x8f, 0x1f, 0x1b, 0x3d, 0xb3, 0x6d, 0x10,
+	0xcb, 0x11, 0x90, 0xf9, 0x23, 0xeb, 0xc8, 0x62, 0x3f, 0xd7, 0xe8, 0x2f, 0xd1, 0xbb, 0x7c, 0x64,
+	0x59, 0x47, 0x3d, 0xbc, 0xc6, 0x5a, 0x87, 0xc3, 0xce, 0x1a, 0x31, 0xfb, 0xd8, 0x25, 0x46, 0xdf,
+	0xf6, 0x66, 0x1e, 0x07, 0xb4, 0x87, 0x8e, 0x41, 0x4c, 0x6b, 0xc0, 0xc7, 0xd5, 0x3f, 0x17, 0x60,
+	0x4a, 0xc3, 0xef, 0x0f, 0xb1, 0x4b, 0xd0, 0x8b, 0x90, 0xc3, 0xad, 0xae, 0xa5, 0x48, 0x2b, 0xd2,
+	0xf5, 0xe2, 0xed, 0xa7, 0x57, 0xc7, 0x3f, 0x73, 0xb5, 0xd6, 0xea, 0x5a, 0x02, 0xbc, 0x79, 0x4e,
+	0x63, 0x60, 0xf4, 0x12, 0x4c, 0x74, 0x7a, 0x43, 0xb7, 0xab, 0x64, 0x98, 0xd4, 0x52, 0x5c, 0x6a,
+	0x83, 0x0e, 0x07, 0x62, 0x1c, 0x4e, 0x27, 0x33, 0x07, 0x1d, 0x4b, 0xc9, 0xa6, 0x4d, 0xb6, 0x35,
+	0xe8, 0x84, 0x27, 0xa3, 0x60, 0x54, 0x05, 0x30, 0x07, 0x26, 0xd1, 0x5b, 0x5d, 0xc3, 0x1c, 0x28,
+	0x13, 0x4c, 0x54, 0x4d, 0x12, 0x35, 0x49, 0x95, 0x42, 0x02, 0xf9, 0x82, 0xe9, 0xf5, 0xd1, 0x15,
+	0xbf, 0x3f, 0xc4, 0xce, 0x89, 0x32, 0x99, 0xb6, 0xe2, 0xb7, 0xe9, 0x70, 0x68, 0xc5, 0x0c, 0x8e,
+	0x5e, 0x87, 0x7c, 0xab, 0x8b, 0x5b, 0x0f, 0x75, 0x32, 0x52, 0xf2, 0x4c, 0x74, 0x25, 0x2e, 0x5a,
+	0xa5, 0x88, 0xe6, 0x28, 0x10, 0x9e, 0x6a, 0xf1, 0x1e, 0xf4, 0x2a, 0x4c, 0xb6, 0xac, 0x7e, 0xdf,
+	0x24, 0x4a, 0x91, 0x09, 0x2f, 0x27, 0x08, 0xb3, 0xf1, 0x40, 0x56, 0x08, 0xa0, 0x3d, 0x98, 0xee,
+	0x99, 0x2e, 0xd1, 0xdd, 0x81, 0x61, 0xbb, 0x5d, 0x8b, 0xb8, 0x4a, 0x89, 0xa9, 0x78, 0x36, 0xae,
+	0x62, 0xc7, 0x74, 0x49, 0xc3, 0x83, 0x05, 0x9a, 0xca, 0xbd, 0x70, 0x3f, 0x55, 0x68, 0x75, 0x3a,
+	0xd8, 0xf1, 0x35, 0x2a, 0xe5, 0x34, 0x85, 0x7b, 0x14, 0xe7, 0x49, 0x86, 0x14, 0x5a, 0xe1, 0x7e,
+	0xf4, 0x2d, 0x98, 0xeb, 0x59, 0x46, 0xdb, 0xd7, 0xa7, 0xb7, 0xba, 0xc3, 0xc1, 0x43, 0x65, 0x9a,
+	0x69, 0xbd, 0x91, 0xb0, 0x4c, 0xcb, 0x68, 0x7b, 0xc2, 0x55, 0x0a, 0x0d, 0x34, 0xcf, 0xf6, 0xc6,
+	0xc7, 0x90, 0x0e, 0xf3, 0x86, 0x6d, 0xf7, 0x4e, 0xc6, 0xd5, 0xcf, 0x30, 0xf5, 0x37, 0xe3, 0xea,
+	0x2b, 0x14, 0x9d, 0xa2, 0x1f, 0x19, 0xb1, 0x41, 0xb4, 0x0f, 0xb2, 0xed, 0x60, 0xdb, 0x70, 0xb0,
+	0x6e, 0x3b, 0x96, 0x6d, 0xb9, 0x46, 0x4f, 0x91, 0x99, 0xf2, 0xeb, 0x71, 0xe5, 0x75, 0x8e, 0xac,
+	0x0b, 0x60, 0xa0, 0x79, 0xc6, 0x8e, 0x8e, 0x70, 0xb5, 0x56, 0x0b, 0xbb, 0x6e, 0xa0, 0x76, 0x36,
+	0x5d, 0x2d, 0x43, 0x26, 0xaa, 0x8d, 0x8c, 0xa0, 0x0d, 0x28, 0xe2, 0x11, 0xc1, 0x83, 0xb6, 0x7e,
+	0x6c, 0x11, 0xac, 0x20, 0xa6, 0xf1, 0x4a, 0xc2, 0x75, 0x65, 0xa0, 0x03, 0x8b, 0xe0, 0x40, 0x19,
+	0x60, 0xbf, 0x13, 0x1d, 0xc2, 0xc2, 0x31, 0x76, 0xcc, 0xce, 0x09, 0xd3, 0xa3, 0xb3, 0x11, 0xd7,
+	0xb4, 0x06, 0xca, 0x1c, 0xd3, 0xf8, 0x7c, 0x5c, 0xe3, 0x01, 0x83, 0x53, 0xe1, 0x9a, 0x07, 0x0e,
+	0x54, 0xcf, 0x1d, 0xc7, 0x47, 0xe9, 0x49, 0xeb, 0x98, 0x03, 0xa3, 0x67, 0x7e, 0x17, 0xeb, 0x87,
+	0x3d, 0xab, 0xf5, 0x50, 0x99, 0x4f, 0x3b, 0x69, 0x1b, 0x02, 0xb7, 0x4e, 0x61, 0xa1, 0x93, 0xd6,
+	0x09, 0xf7, 0xaf, 0x4f, 0xc1, 0xc4, 0xb1, 0xd1, 0x1b, 0xe2, 0xed, 0x5c, 0x3e, 0x27, 0x4f, 0x6c,
+	0xe7, 0xf2, 0x53, 0x72, 0x7e, 0x3b, 0x97, 0x2f, 0xc8, 0xb0, 0x9d, 0xcb, 0x83, 0x5c, 0x54, 0xaf,
+	0x41, 0x31, 0xe4, 0xa7, 0x90, 0x02, 0x53, 0x7d, 0xec, 0xba, 0xc6, 0x11, 0x66, 0x7e, 0xad, 0xa0,
+	0x79, 0x4d, 0x75, 0x1a, 0x4a, 0x61, 0xd7, 0xa4, 0x7e, 0x2c, 0x41, 0x31, 0xe4, 0x74, 0xa8, 0xe4,
+	0x31, 0x76, 0x98, 0x41, 0x84, 0xa4, 0x68, 0xa2, 0x2b, 0x50, 0x66, 0xdf, 0xa2, 0x7b, 0xe3, 0xd4,
+	0xf7, 0xe5, 0xb4, 0x12, 0xeb, 0x3c, 0x10, 0xa0, 0x65, 0x28, 0xda, 0xb7, 0x6d, 0x1f, 0x92, 0x65,
+	0x10, 0xb0, 0x6f, 0xdb, 0x1e, 0xe0, 0x32, 0x94, 0xe8, 0xa7, 0xfb, 0x88, 0x1c, 0x9b, 0xa4, 0x48,
+	0xfb, 0x04, 0x44, 0xfd, 0x53, 0x06, 0xe4, 0x71, 0x67, 0x86, 0x5e, 0x81, 0x1c, 0xf5, 0xf2, 0xc2,
+	0x4d, 0x2f, 0xae, 0x72, 0x0f, 0xbf, 0xea, 0x79, 0xf8, 0xd5, 0xa6, 0x17, 0x02, 0xd6, 0xf3, 0x9f,
+	0x7c, 0xb6, 0x7c, 0xee, 0xe3, 0xbf, 0x2e, 0x4b, 0x1a, 0x93, 0x40, 0x17, 0xa9, 0x07, 0x33, 0xcc,
+	0x81, 0x6e, 0xb6, 0xd9, 0x92, 0x0b, 0xd4, 0x3b, 0x19, 0xe6, 0x60, 0xab, 0x8d, 0xee, 0x81, 0xdc,
+	0xb2, 0x06, 0x2e, 0x1e, 0xb8, 0x43, 0x57, 0xe7, 0xb1, 0x49, 0xc9, 0x8e, 0xfb, 0x57, 0x1e, 0x04,
+	0x99, 0xa3, 0x12, 0xd0, 0x3a, 0x43, 0x6a, 0x33, 0xad, 0x68, 0x07, 0x7a, 0x0b, 0xc0, 0x0f, 0x60,
+	0xae, 0x92, 0x5b, 0xc9, 0x5e, 0x2f, 0xde, 0xbe, 0x9c, 0x70, 0x9e, 0x3c, 0xcc, 0xbe, 0xdd, 0x36,
+	0x08, 0x5e, 0xcf, 0xd1, 0x05, 0x6b, 0x21, 0x51, 0xf4, 0x2c, 0xcc, 0x18, 0xb6, 0xad, 0xbb, 0xc4,
+	0x20, 0x58, 0x3f, 0x3c, 0x21, 0xd8, 0x65, 0x6e, 0xbf, 0xa4, 0x95, 0x0d, 0xdb, 0x6e, 0xd0, 0xde,
+	0x75, 0xda, 0x89, 0xae, 0xc2, 0x34, 0xf5, 0xf0, 0xa6, 0xd1, 0xd3, 0xbb, 0xd8, 0x3c, 0xea, 0x12,
+	0xe6, 0xdd, 0xb3, 0x5a, 0x59, 0xf4, 0x6e, 0xb2, 0x4e, 0xb5, 0x0d, 0xa5, 0xb0, 0x73, 0x47, 0x08,
+	0x72, 0x6d, 0x83, 0x18, 0xcc, 0x96, 0x25, 0x8d, 0xfd, 0xa6, 0x7d, 0xb6, 0x41, 0xba, 0xc2, 0x42,
+	0xec, 0x37, 0x3a, 0x0f, 0x93, 0x42, 0x6d, 0x96, 0xa9, 0x15, 0x2d, 0x34, 0x0f, 0x13, 0xb6, 0x63,
+	0x1d, 0x63, 0xb6, 0x79, 0x79, 0x8d, 0x37, 0xd4, 0xfb, 0x30, 0x1d, 0x8d, 0x03, 0x68, 0x1a, 0x32,
+	0x64, 0x24, 0x66, 0xc9, 0x90, 0x11, 0xba, 0x05, 0x39, 0x6a, 0x4c, 0xa6, 0x6d, 0x3a, 0x29, 0xfa,
+	0x09, 0xf9, 0xe6, 0x89, 0x8d, 0x35, 0x06, 0xdd, 0xce, 0xe5, 0x33, 0x72, 0x56, 0x9d, 0x81, 0x72,
+	0x24, 0x4a, 0xa8, 0xe7, 0x61, 0x3e, 0xc9, 0xe7, 0xab, 0x26, 0xcc, 0x27, 0xb9, 0x6e, 0xf4, 0x12,
+	0xe4, 0x7d, 0xa7, 0xef, 0x9d, 0xa0, 0xd8, 0xec, 0xbe, 0x90, 0x8f, 0xa5, 0x67, 0x87, 0x6e, 0x44,
+	0xd7, 0x10, 0xa1, 0xbe, 0xa4, 0x4d, 0x19, 0xb6, 0xbd, 0x69, 0xb8, 0x5d, 0xf5, 0x5d, 0x50, 0xd2,
+	0xfc, 0x79, 0xc8, 0x70, 0x12, 0xbb, 0x00, 0x9e, 0xe1, 0xce, 0xc3, 0x64, 0xc7, 0x72, 0xfa, 0x06,
+	0x61, 0xca, 0xca, 0x9a, 0x68, 0x51, 0x83, 0x72, 0xdf, 0x9e, 0x65, 0xdd, 0xbc, 0xa1, 0xea, 0x70,
+	0x31, 0xd5, 0xa5, 0x53, 0x11, 0x73, 0xd0, 0xc6, 0xdc, 0xbc, 0x65, 0x8d, 0x37, 0x02, 0x45, 0x7c,
+	0xb1, 0xbc, 0x41, 0xa7, 0x75, 0xf1, 0xa0, 0x8d, 0x1d, 0xa6, 0xbf, 0xa0, 0x89, 0x96, 0xfa, 0xd3,
+	0x2c, 0x9c, 0x4f, 0xf6, 0xeb, 0x68, 0x05, 0x4a, 0x7d, 0x63, 0xa4, 0x93, 0x91, 0x38, 0x7e, 0x12,
+	0x3b, 0x00, 0xd0, 0x37, 0x46, 0xcd, 0x11, 0x3f, 0x7b, 0x32, 0x64, 0xc9, 0xc8, 0x55, 0x32, 0x2b,
+	0xd9, 0xeb, 0x25, 0x8d, 0xfe, 0x44, 0x07, 0x30, 0xdb, 0xb3, 0x5a, 0x46, 0x4f, 0xef, 0x19, 0x2e,
+	0xd1, 0x45, 0xd8, 0xe7, 0xd7, 0xe9, 0x99, 0x34, 0x3f, 0x8d, 0xdb, 0x7c, 0x63, 0xa9, 0x0b, 0x12,
+	0x17, 0x61, 0x86, 0x29, 0xd9, 0x31, 0x5c, 0xc2, 0x87, 0x50, 0x0d, 0x8a, 0x7d, 0xd3, 0x3d, 0xc4,
+	0x5d, 0xe3, 0xd8, 0xb4, 0x1c, 0x71, 0xaf, 0x12, 0x4e, 0xcf, 0xbd, 0x00, 0x24, 0x54, 0x85, 0xe5,
+	0x42, 0x9b, 0x32, 0x11, 0x39, 0xcd, 0x9e, 0x67, 0x99, 0x7c, 0x6c, 0xcf, 0xf2, 0x3f, 0x30, 0x3f,
+	0xc0, 0x23, 0xa2, 0x07, 0x37, 0x97, 0x9f, 0x94, 0x29, 0x66, 0x7c, 0x44, 0xc7, 0xfc, 0xbb, 0xee,
+	0xd2, 0x43, 0x83, 0x9e, 0x63, 0xb1, 0xd1, 0xb6, 0x5c, 0xec, 0xe8, 0x46, 0xbb, 0xed, 0x60, 0xd7,
+	0x65, 0x59, 0x55, 0x49, 0x9b, 0xf1, 0xfa, 0x2b, 0xbc, 0x5b, 0xfd, 0x88, 0x6d, 0x4e, 0x52, 0x74,
+	0xf4, 0x4c, 0x2f, 0x05, 0xa6, 0x6f, 0xc2, 0xbc, 0x90, 0x6f, 0x47, 0xac, 0xcf, 0xd3, 0xd3, 0x4b,
+	0x69, 0x49, 0x57, 0xc8, 0xea, 0xc8, 0x93, 0x4f, 0x37, 0x7c, 0xf6, 0x09, 0x0d, 0x8f, 0x20, 0xc7,
+	0xcc, 0x92, 0xe3, 0xee, 0x86, 0xfe, 0xfe, 0x77, 0xdb, 0x8c, 0x0f, 0xb3, 0x30, 0x1b, 0x4b, 0x2c,
+	0xfc, 0x0f, 0x93, 0x12, 0x3f, 0x2c, 0x93, 0xf8, 0x61, 0xd9, 0xc7, 0xfe, 0x30, 0xb1, 0xdb, 0xb9,
+	0xb3, 0x77, 0x7b, 0xe2, 0xeb, 0xdc, 0xed, 0xc9, 0x27, 0xdc, 0xed, 0x7f, 0xe9, 0x3e, 0xfc, 0x4c,
+	0x82, 0xc5, 0xf4, 0x74, 0x2c, 0x71, 0x43, 0x6e, 0xc2, 0xac, 0xbf, 0x14, 0x5f, 0x3d, 0x77, 0x8f,
+	0xb2, 0x3f, 0x20, 0xf4, 0xa7, 0x46, 0xbc, 0xab, 0x30, 0x3d, 0x96, 0x2d, 0xf2, 0xc3, 0x5c, 0x3e,
+	0x0e, 0x2f, 0x43, 0xfd, 0x6d, 0x16, 0xe6, 0x93, 0x12, 0xba, 0x84, 0x1b, 0xab, 0xc1, 0x5c, 0x1b,
+	0xb7, 0xcc, 0xf6, 0x13, 0x5f, 0xd8, 0x59, 0x21, 0xfe, 0xdf, 0xfb, 0x1a, 0x3f, 0x27, 0xe8, 0x06,
+	0xcc, 0xba, 0x27, 0x83, 0x96, 0x39, 0x38, 0xd2, 0x89, 0xe5, 0xe5, 0x46, 0x05, 0xb6, 0xf2, 0x19,
+	0x31, 0xd0, 0xb4, 0x44, 0x76, 0xf4, 0x2b, 0x80, 0xbc, 0x86, 0x5d, 0xdb, 0x1a, 0xb8, 0x18, 0x55,
+	0xa1, 0x80, 0x47, 0x2d, 0x6c, 0x13, 0x2f, 0x01, 0x4e, 0xa9, 0x31, 0x04, 0xc4, 0x93, 0xa3, 0xb5,
+	0xb6, 0x2f, 0x87, 0xfe, 0x57, 0x50, 0x0a, 0xa9, 0xe4, 0x00, 0x4f, 0xd5, 0x7d, 0x51, 0x86, 0x46,
+	0x2f, 0x7b, 0x9c, 0x42, 0x36, 0xad, 0x52, 0x16, 0x89, 0xbb, 0x2f, 0xc7, 0xf1, 0x74, 0x3a, 0x46,
+	0x2a, 0xe4, 0xd2, 0xa6, 0xe3, 0xf9, 0x7d, 0x30, 0x1d, 0x45, 0xa3, 0x3b, 0x11, 0x56, 0x61, 0x32,
+	0xed, 0x53, 0x43, 0x89, 0x78, 0xf0, 0xa9, 0x01, 0xad, 0xf0, 0xb2, 0x47, 0x2b, 0x4c, 0xa5, 0x2d,
+	0x5a, 0x64, 0x9e, 0xc1, 0xa2, 0x19, 0x1e, 0xbd, 0x11, 0xe2, 0x15, 0x0a, 0x2b, 0x52, 0x72, 0xa6,
+	0xec, 0xe7, 0x93, 0xbe, 0xb4, 0x4f, 0x2c, 0xfc, 0xbf, 0x4f, 0x2c, 0x94, 0x52, 0x59, 0x09, 0x91,
+	0x32, 0xfa, 0xc2, 0x42, 0x02, 0xd5, 0x63, 0xcc, 0x02, 0x27, 0x02, 0xae, 0x9d, 0xc9, 0x2c, 0xf8,
+	0xaa, 0xc6, 0xa8, 0x85, 0x7a, 0x8c, 0x5a, 0x98, 0x4e, 0xd3, 0x38, 0x96, 0x9f, 0x06, 0x1a, 0xa3,
+	0xdc, 0xc2, 0xb7, 0x93, 0xb9, 0x85, 0xd4, 0xe2, 0x3f, 0x21, 0x17, 0xf5, 0x55, 0x27, 0x90, 0x0b,
+	0xef, 0xa6, 0x90, 0x0b, 0x72, 0x5a, 0x11, 0x9c, 0x94, 0x89, 0xfa, 0x13, 0x24, 0xb1, 0x0b, 0x07,
+	0x09, 0xec, 0x02, 0xa7, 0x01, 0x9e, 0x7b, 0x04, 0x76, 0xc1, 0x57, 0x1d, 0xa3, 0x17, 0x0e, 0x12,
+	0xe8, 0x05, 0x94, 0xae, 0x77, 0x2c, 0x81, 0x0a, 0xeb, 0x8d, 0x0c, 0xa1, 0xb7, 0xa2, 0xfc, 0xc2,
+	0xdc, 0xe9, 0x79, 0x2b, 0x4f, 0x03, 0x7c, 0x6d, 0x61, 0x82, 0xa1, 0x95, 0x46, 0x30, 0x70, 0x0e,
+	0xe0, 0x85, 0x47, 0x24, 0x18, 0x7c, 0xdd, 0x89, 0x0c, 0x43, 0x3d, 0xc6, 0x30, 0x2c, 0xa4, 0x1d,
+	0xb8, 0xb1, 0x80, 0x14, 0x1c, 0xb8, 0x54, 0x8a, 0x61, 0x42, 0x9e, 0xdc, 0xce, 0xe5, 0xf3, 0x72,
+	0x81, 0x93, 0x0b, 0xdb, 0xb9, 0x7c, 0x51, 0x2e, 0xa9, 0xcf, 0xd1, 0x14, 0x68, 0xcc, 0xef, 0xd1,
+	0x82, 0x03, 0x3b, 0x8e, 0xe5, 0x08, 0xb2, 0x80, 0x37, 0xd4, 0xeb, 0x50, 0x0a, 0xbb, 0xb8, 0x53,
+	0xe8, 0x88, 0x19, 0x28, 0x47, 0xbc, 0x9a, 0xfa, 0x3b, 0x09, 0x4a, 0x61, 0x7f, 0x15, 0x29, 0x56,
+	0x0b, 0xa2, 0x58, 0x0d, 0x91, 0x14, 0x99, 0x28, 0x49, 0xb1, 0x0c, 0x45, 0x5a, 0xb0, 0x8d, 0xf1,
+	0x0f, 0x86, 0xed, 0xf3, 0x0f, 0x37, 0x60, 0x96, 0xc5, 0x5b, 0x4e, 0x65, 0x88, 0xc8, 0x90, 0xe3,
+	0x91, 0x81, 0x0e, 0x30, 0x63, 0xf0, 0xc8, 0x80, 0x5e, 0x80, 0xb9, 0x10, 0xd6, 0x2f, 0x04, 0x79,
+	0x29, 0x2e, 0xfb, 0xe8, 0x8a, 0xa8, 0x08, 0xff, 0x28, 0xc1, 0x6c, 0xcc, 0x5d, 0x26, 0x72, 0x0c,
+	0xd2, 0xd7, 0xc5, 0x31, 0x64, 0x9e, 0x9c, 0x63, 0x08, 0x97, 0xb6, 0xd9, 0x68, 0x69, 0xfb, 0x0f,
+	0x09, 0xca, 0x11, 0xb7, 0x4d, 0x37, 0xa1, 0x65, 0xb5, 0xb1, 0x28, 0x36, 0xd9, 0x6f, 0x9a, 0xd3,
+	0xf4, 0xac, 0x23, 0x51, 0x52, 0xd2, 0x9f, 0x14, 0xe5, 0x07, 0xa2, 0x82, 0x08, 0x33, 0x7e, 0x9d,
+	0xca, 0xf3, 0x06, 0xde, 0xa0, 0xb2, 0x0f, 0x31, 0xe7, 0xa2, 0x4b, 0x1a, 0xfd, 0x89, 0xe6, 0xc5,
+	0xf1, 0x13, 0xf1, 0x9f, 0x37, 0xd0, 0xab, 0x50, 0x60, 0x2f, 0x0a, 0xba, 0x65, 0xbb, 0x4a, 0x7e,
+	0x3c, 0x37, 0xe2, 0xcf, 0x0e, 0xe2, 0x9e, 0x5b, 0x9d, 0x3d, 0xdb, 0xd5, 0xf2, 0xb6, 0xf8, 0x15,
+	0xca, 0x58, 0x0a, 0x91, 0x8c, 0xe5, 0x12, 0x14, 0xe8, 0xf2, 0x5d, 0xdb, 0x68, 0x61, 0x05, 0xd8,
+	0x4a, 0x83, 0x0e, 0xf5, 0xd7, 0x19, 0x98, 0x19, 0x8b, 0x3a, 0x89, 0x1f, 0xef, 0x9d, 0xca, 0x4c,
+	0x88, 0x42, 0x79, 0x34, 0x83, 0x2c, 0x01, 0x1c, 0x19, 0xae, 0xfe, 0x81, 0x31, 0x20, 0xb8, 0x2d,
+	0xac, 0x12, 0xea, 0x41, 0x8b, 0x90, 0xa7, 0xad, 0xa1, 0x8b, 0xdb, 0x82, 0xcd, 0xf1, 0xdb, 0x68,
+	0x0b, 0x26, 0xf1, 0x31, 0x1e, 0x10, 0x57, 0x99, 0x62, 0x1b, 0x7f, 0x21, 0xc1, 0x3d, 0xd1, 0xf1,
+	0x75, 0x85, 0x6e, 0xf7, 0xdf, 0x3f, 0x5b, 0x96, 0x39, 0xfc, 0x79, 0xab, 0x6f, 0x12, 0xdc, 0xb7,
+	0xc9, 0x89, 0x26, 0x14, 0x44, 0xcd, 0x90, 0x1f, 0x33, 0x03, 0xa3, 0x16, 0x4b, 0x1e, 0x4f, 0x40,
+	0x8d, 0x6a, 0x5a, 0x8e, 0x49, 0x4e, 0xb4, 0x72, 0x1f, 0xf7, 0x6d, 0xcb, 0xea, 0xe9, 0xfc, 0x9e,
+	0x57, 0x60, 0x3a, 0x1a, 0x64, 0x29, 0x49, 0xe8, 0x60, 0x42, 0xd9, 0xb6, 0x48, 0x1e, 0x5d, 0xe2,
+	0x9d, 0xfc, 0x5e, 0x6d, 0xe7, 0xf2, 0x92, 0x9c, 0x11, 0xd4, 0xce, 0xdb, 0xb0, 0x90, 0x18, 0x63,
+	0xd1, 0x2b, 0x50, 0x08, 0xe2, 0xb3, 0xb4, 0x92, 0x3d, 0x83, 0xb3, 0x09, 0xc0, 0xea, 0x01, 0x2c,
+	0x24, 0x06, 0x59, 0xf4, 0x3a, 0x4c, 0x3a, 0xd8, 0x1d, 0xf6, 0x38, 0x2d, 0x33, 0x7d, 0xfb, 0xea,
+	0xd9, 0xd1, 0x79, 0xd8, 0x23, 0x9a, 0x10, 0x52, 0x6f, 0xc1, 0xc5, 0xd4, 0x28, 0x1b, 0x30, 0x2f,
+	0x52, 0x88, 0x79, 0x51, 0x7f, 0x23, 0xc1, 0x62, 0x7a, 0xe4, 0x44, 0xeb, 0x63, 0x0b, 0xba, 0xf1,
+	0x88, 0x71, 0x37, 0xb4, 0x2a, 0x5a, 0x9a, 0x38, 0xb8, 0x83, 0x49, 0xab, 0xcb, 0x43, 0x38, 0x77,
+	0x0a, 0x65, 0xad, 0x2c, 0x7a, 0x99, 0x8c, 0xcb, 0x61, 0xef, 0xe1, 0x16, 0xd1, 0xf9, 0xa6, 0xba,
+	0xac, 0x3c, 0x28, 0x68, 0x65, 0xde, 0xdb, 0xe0, 0x9d, 0xea, 0x4d, 0xb8, 0x90, 0x12, 0x8b, 0xe3,
+	0x35, 0x8c, 0xfa, 0x80, 0x82, 0x13, 0x03, 0x2c, 0x7a, 0x13, 0x26, 0x5d, 0x62, 0x90, 0xa1, 0x2b,
+	0xbe, 0xec, 0xda, 0x99, 0xb1, 0xb9, 0xc1, 0xe0, 0x9a, 0x10, 0x53, 0x5f, 0x03, 0x14, 0x8f, 0xb4,
+	0x09, 0x75, 0x98, 0x94, 0x54, 0x87, 0x1d, 0xc2, 0x53, 0xa7, 0xc4, 0x54, 0x54, 0x1d, 0x5b, 0xdc,
+	0xcd, 0x47, 0x0a, 0xc9, 0x63, 0x0b, 0xfc, 0x43, 0x16, 0x16, 0x12, 0x43, 0x6b, 0xe8, 0x96, 0x4a,
+	0x5f, 0xf5, 0x96, 0xbe, 0x0e, 0x40, 0x46, 0x3a, 0xdf, 0x69, 0xcf, 0xdb, 0x27, 0xd5, 0x13, 0x23,
+	0xdc, 0x6a, 0x8e, 0xc4, 0xc1, 0x28, 0x10, 0xf1, 0x8b, 0x12, 0x05, 0xa1, 0xda, 0x77, 0xc8, 0x22,
+	0x81, 0xab, 0x64, 0x1f, 0x2f, 0x66, 0xc8, 0xc7, 0xd1, 0x6e, 0x17, 0x3d, 0x80, 0x0b, 0x63, 0x11,
+	0xcd, 0xd7, 0x9d, 0x7b, 0xe4, 0xc0, 0xb6, 0x10, 0x0d, 0x6c, 0x9e, 0xee, 0x70, 0x54, 0x9a, 0x88,
+	0x44, 0x25, 0x1a, 0x48, 0x59, 0xc1, 0xc8, 0xa3, 0x71, 0x1b, 0xf7, 0x0c, 0xef, 0x31, 0xf3, 0x62,
+	0xac, 0xec, 0xbc, 0x23, 0xde, 0x7b, 0x79, 0xd5, 0xf9, 0x13, 0x5a, 0x75, 0x4e, 0x53, 0x61, 0xb6,
+	0x51, 0x77, 0xa8, 0xa8, 0xfa, 0x00, 0x20, 0xa8, 0xa9, 0xe9, 0xf5, 0x75, 0xac, 0xe1, 0xa0, 0xcd,
+	0x4e, 0xc4, 0x84, 0xc6, 0x1b, 0xf4, 0xd1, 0x94, 0x1e, 0x2c, 0xcf, 0xf2, 0x09, 0xfe, 0x87, 0x9e,
+	0x90, 0x50, 0x51, 0xce, 0xe1, 0xea, 0x7b, 0x80, 0xe2, 0xf4, 0x66, 0xca, 0x1c, 0x6f, 0x44, 0xe7,
+	0x50, 0xd3, 0x99, 0xd2, 0xe4, 0xb9, 0xbe, 0x07, 0x13, 0xec, 0x34, 0xd1, 0x60, 0xc3, 0xd8, 0x75,
+	0x91, 0x28, 0xd1, 0xdf, 0xe8, 0x3b, 0x00, 0x06, 0x21, 0x8e, 0x79, 0x38, 0x0c, 0x66, 0x58, 0x49,
+	0x39, 0x8e, 0x15, 0x0f, 0xb8, 0x7e, 0x49, 0x9c, 0xcb, 0xf9, 0x40, 0x36, 0x74, 0x36, 0x43, 0x1a,
+	0xd5, 0x5d, 0x98, 0x8e, 0xca, 0x7a, 0x91, 0x9d, 0x2f, 0x22, 0x1a, 0xd9, 0x79, 0xaa, 0xc6, 0x1b,
+	0x41, 0x5e, 0x90, 0xe5, 0x6f, 0x08, 0xac, 0xa1, 0xfe, 0x3e, 0x03, 0xa5, 0xf0, 0x61, 0xfe, 0x0f,
+	0x8c, 0xbd, 0xe8, 0x65, 0x5a, 0x98, 0xb7, 0xf1, 0x48, 0x7f, 0x88, 0x4f, 0x5c, 0xa5, 0x40, 0xbd,
+	0xf4, 0xba, 0x42, 0x77, 0x23, 0xe8, 0x0d, 0xe9, 0x2c, 0xb0, 0xde, 0xbb, 0xf8, 0xc4, 0x55, 0x7f,
+	0x28, 0x41, 0xde, 0x37, 0x5c, 0xf4, 0x09, 0x22, 0xf2, 0x76, 0xc3, 0xed, 0x9e, 0x09, 0xbf, 0x1b,
+	0xf0, 0x97, 0x9a, 0xac, 0xff, 0x52, 0xf3, 0x0d, 0x3f, 0x30, 0xa5, 0x92, 0x0a, 0xe1, 0x6d, 0x12,
+	0x27, 0xd2, 0x0b, 0x94, 0xaf, 0x41, 0xc1, 0xf7, 0x25, 0x34, 0x57, 0xf7, 0xc8, 0x1a, 0x49, 0x5c,
+	0x68, 0xde, 0xa4, 0x4b, 0xb1, 0xad, 0x0f, 0xc4, 0xab, 0x44, 0x56, 0xe3, 0x0d, 0xd5, 0x85, 0x99,
+	0x31, 0x47, 0x14, 0x00, 0x33, 0x21, 0x20, 0x52, 0xa1, 0x6c, 0x0f, 0x0f, 0xa9, 0x3d, 0xc4, 0x1b,
+	0x05, 0x5f, 0x7e, 0xd1, 0x1e, 0x1e, 0xde, 0xc5, 0x27, 0xfc, 0x91, 0x62, 0x05, 0x4a, 0x1e, 0x86,
+	0xdd, 0x0d, 0x7e, 0x18, 0x80, 0x43, 0x9a, 0xfc, 0x81, 0x49, 0x92, 0x33, 0xea, 0x8f, 0x25, 0xc8,
+	0x7b, 0xd7, 0x0b, 0xbd, 0x09, 0x05, 0xdf, 0xe7, 0x89, 0x54, 0xfd, 0xa9, 0x53, 0xbc, 0xa5, 0xf8,
+	0xf8, 0x40, 0x06, 0xad, 0x7b, 0x2f, 0xa5, 0x66, 0x5b, 0xef, 0xf4, 0x8c, 0x23, 0xf1, 0xe0, 0xb5,
+	0x94, 0xe0, 0x16, 0x99, 0x43, 0xda, 0xba, 0xb3, 0xd1, 0x33, 0x8e, 0xb4, 0x22, 0x13, 0xda, 0x6a,
+	0xd3, 0x86, 0xc8, 0x8e, 0xbe, 0x94, 0x40, 0x1e, 0xbf, 0xfe, 0x5f, 0x7d, 0x7d, 0xf1, 0x28, 0x9a,
+	0x4d, 0x88, 0xa2, 0x68, 0x0d, 0xe6, 0x7c, 0x84, 0xee, 0x9a, 0x47, 0x03, 0x83, 0x0c, 0x1d, 0x2c,
+	0x68, 0x41, 0xe4, 0x0f, 0x35, 0xbc, 0x91, 0xf8, 0x77, 0x4f, 0x3c, 0xe9, 0x77, 0x7f, 0x98, 0x81,
+	0x62, 0x88, 0xa5, 0x44, 0xff, 0x17, 0xf2, 0x6d, 0xd3, 0x49, 0xb1, 0x2b, 0x04, 0x0e, 0x5e, 0x0f,
+	0xa3, 0x96, 0xca, 0x3c, 0x81, 0xa5, 0xd2, 0xf8, 0x60, 0x8f, 0xf6, 0xcc, 0x3d, 0x36, 0xed, 0xf9,
+	0x3c, 0x20, 0x62, 0x11, 0xa3, 0x47, 0xc9, 0x01, 0x4a, 0x4f, 0xf2, 0x83, 0xcd, 0x5d, 0x91, 0xcc,
+	0x46, 0x0e, 0xd8, 0x40, 0x9d, 0x5d, 0x86, 0xef, 0x4b, 0x90, 0xf7, 0x29, 0xa1, 0xc7, 0x7d, 0x55,
+	0x3c, 0x0f, 0x93, 0x22, 0x23, 0xe4, 0xcf, 0x8a, 0xa2, 0x95, 0xc8, 0xef, 0x2e, 0x42, 0xbe, 0x8f,
+	0x89, 0xc1, 0xfc, 0x2a, 0x8f, 0xbb, 0x7e, 0xfb, 0xc6, 0x21, 0x14, 0x43, 0x0f, 0xb3, 0xe8, 0x22,
+	0x2c, 0x54, 0x37, 0x6b, 0xd5, 0xbb, 0x7a, 0xf3, 0x1d, 0xbd, 0x79, 0xbf, 0x5e, 0xd3, 0xf7, 0x77,
+	0xef, 0xee, 0xee, 0x7d, 0x73, 0x57, 0x3e, 0x17, 0x1f, 0xd2, 0x6a, 0xac, 0x2d, 0x4b, 0xe8, 0x02,
+	0xcc, 0x45, 0x87, 0xf8, 0x40, 0x66, 0x31, 0xf7, 0xa3, 0x5f, 0x2e, 0x9d, 0xbb, 0xf1, 0xa5, 0x04,
+	0x73, 0x09, 0xb9, 0x37, 0xba, 0x0c, 0x4f, 0xef, 0x6d, 0x6c, 0xd4, 0x34, 0xbd, 0xb1, 0x5b, 0xa9,
+	0x37, 0x36, 0xf7, 0x9a, 0xba, 0x56, 0x6b, 0xec, 0xef, 0x34, 0x43, 0x93, 0xae, 0xc0, 0xa5, 0x64,
+	0x48, 0xa5, 0x5a, 0xad, 0xd5, 0x9b, 0xb2, 0x84, 0x96, 0xe1, 0xa9, 0x14, 0xc4, 0xfa, 0x9e, 0xd6,
+	0x94, 0x33, 0xe9, 0x2a, 0xb4, 0xda, 0x76, 0xad, 0xda, 0x94, 0xb3, 0xe8, 0x1a, 0x5c, 0x39, 0x0d,
+	0xa1, 0x6f, 0xec, 0x69, 0xf7, 0x2a, 0x4d, 0x39, 0x77, 0x26, 0xb0, 0x51, 0xdb, 0xbd, 0x53, 0xd3,
+	0xe4, 0x09, 0xf1, 0xdd, 0xbf, 0xc8, 0x80, 0x92, 0x96, 0xe2, 0x53, 0x5d, 0x95, 0x7a, 0x7d, 0xe7,
+	0x7e, 0xa0, 0xab, 0xba, 0xb9, 0xbf, 0x7b, 0x37, 0x6e, 0x82, 0x67, 0x41, 0x3d, 0x0d, 0xe8, 0x1b,
+	0xe2, 0x2a, 0x5c, 0x3e, 0x15, 0x27, 0xcc, 0x71, 0x06, 0x4c, 0xab, 0x35, 0xb5, 0xfb, 0x72, 0x16,
+	0xad, 0xc2, 0x8d, 0x33, 0x61, 0xfe, 0x98, 0x9c, 0x43, 0x6b, 0x70, 0xf3, 0x74, 0x3c, 0x37, 0x90,
+	0x27, 0xe0, 0x99, 0xe8, 0x23, 0x09, 0x16, 0x12, 0x6b, 0x05, 0x74, 0x05, 0x96, 0xeb, 0xda, 0x5e,
+	0xb5, 0xd6, 0x68, 0xe8, 0x75, 0x6d, 0xaf, 0xbe, 0xd7, 0xa8, 0xec, 0xe8, 0x8d, 0x66, 0xa5, 0xb9,
+	0xdf, 0x08, 0xd9, 0x46, 0x85, 0xa5, 0x34, 0x90, 0x6f, 0x97, 0x53, 0x30, 0xe2, 0x04, 0x78, 0xe7,
+	0xf4, 0xe7, 0x12, 0x5c, 0x4c, 0xad, 0x0d, 0xd0, 0x75, 0x78, 0xe6, 0xa0, 0xa6, 0x6d, 0x6d, 0xdc,
+	0xd7, 0x0f, 0xf6, 0x9a, 0x35, 0xbd, 0xf6, 0x4e, 0xb3, 0xb6, 0xdb, 0xd8, 0xda, 0xdb, 0x8d, 0xaf,
+	0xea, 0x1a, 0x5c, 0x39, 0x15, 0xe9, 0x2f, 0xed, 0x2c, 0xe0, 0xd8, 0xfa, 0x7e, 0x20, 0xc1, 0xcc,
+	0x98, 0x2f, 0x44, 0x97, 0x40, 0xb9, 0xb7, 0xd5, 0x58, 0xaf, 0x6d, 0x56, 0x0e, 0xb6, 0xf6, 0xb4,
+	0xf1, 0x3b, 0x7b, 0x05, 0x96, 0x63, 0xa3, 0x77, 0xf6, 0xeb, 0x3b, 0x5b, 0xd5, 0x4a, 0xb3, 0xc6,
+	0x26, 0x95, 0x25, 0xfa, 0x61, 0x31, 0xd0, 0xce, 0xd6, 0x5b, 0x9b, 0x4d, 0xbd, 0xba, 0xb3, 0x55,
+	0xdb, 0x6d, 0xea, 0x95, 0x66, 0xb3, 0x12, 0x5c, 0xe7, 0xf5, 0xbb, 0x9f, 0x7c, 0xbe, 0x24, 0x7d,
+	0xfa, 0xf9, 0x92, 0xf4, 0xb7, 0xcf, 0x97, 0xa4, 0x8f, 0xbf, 0x58, 0x3a, 0xf7, 0xe9, 0x17, 0x4b,
+	0xe7, 0xfe, 0xf2, 0xc5, 0xd2, 0xb9, 0x07, 0xb7, 0x8e, 0x4c, 0xd2, 0x1d, 0x1e, 0x52, 0x2f, 0xbc,
+	0x16, 0xfc, 0x7f, 0xd4, 0xfb, 0x61, 0xd8, 0xe6, 0xda, 0xf8, 0xbf, 0x50, 0x0f, 0x27, 0x99, 0x5b,
+	0x7d, 0xf1, 0x9f, 0x03, 0x00, 0x88, 0x09, 0xce, 0xf8, 0xa0, 0x2a, 0x00, 0x00,
 }
 
 func (m *Request) Marshal() (dAtA []byte, err error) {
@@ -6542,6 +6552,15 @@ func (m *ExecTxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IndexKeys) > 0 {
+		for iNdEx := len(m.IndexKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IndexKeys[iNdEx])
+			copy(dAtA[i:], m.IndexKeys[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.IndexKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Codespace) > 0 {
 		i -= len(m.Codespace)
 		copy(dAtA[i:], m.Codespace)
@@ -8178,6 +8197,12 @@ func (m *ExecTxResult) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if len(m.IndexKeys) > 0 {
+		for _, s := range m.IndexKeys {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -15092,6 +15117,38 @@ func (m *ExecTxResult) Unmarshal(dAtA []byte) error {
 			}
 			m.Codespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexKeys = append(m.IndexKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,130 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/services/abci_query/v1/abci_query_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("cometbft/services/abci_query/v1/abci_query_service.proto", fileDescriptor_61e143266e5fa380)
+}
+
+var fileDescriptor_61e143266e5fa380 = []byte{
+	// 184 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0x48, 0xce, 0xcf, 0x4d,
+	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0x4f, 0x4c,
+	0x4a, 0xce, 0x8c, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2f, 0x33, 0x44, 0xe2, 0xc5, 0x43, 0x55,
+	0xe8, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0xc9, 0xc3, 0x74, 0xea, 0xc1, 0x74, 0xea, 0x21, 0xd4,
+	0xea, 0x95, 0x19, 0x4a, 0x19, 0x10, 0x6f, 0x34, 0xc4, 0x48, 0xa3, 0x2a, 0x2e, 0x01, 0x47, 0x27,
+	0x67, 0xcf, 0x40, 0x90, 0x50, 0x30, 0x44, 0x8f, 0x50, 0x1a, 0x17, 0x2b, 0x98, 0x2f, 0xa4, 0xab,
+	0x47, 0xc0, 0x42, 0x3d, 0xb0, 0xba, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x29, 0x3d, 0x62,
+	0x95, 0x17, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x3a, 0x45, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
+	0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3,
+	0xb1, 0x1c, 0x43, 0x94, 0x43, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x12, 0xc8, 0x3c, 0x7d, 0xb8, 0x97,
+	0xe0, 0x8c, 0xc4, 0x82, 0x4c, 0x7d, 0x02, 0x1e, 0x4d, 0x62, 0x03, 0x7b, 0xcf, 0x18, 0x30, 0x00,
+	0x25, 0x8c, 0xe0, 0x0a, 0x6d, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ABCIQueryServiceClient is the client API for ABCIQueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ABCIQueryServiceClient interface {
+	// Query queries the application state. When served by a light client, the
+	// proof is always requested and verified against the trusted headers.
+	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+}
+
+type aBCIQueryServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewABCIQueryServiceClient(cc grpc1.ClientConn) ABCIQueryServiceClient {
+	return &aBCIQueryServiceClient{cc}
+}
+
+func (c *aBCIQueryServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error) {
+	out := new(QueryResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.abci_query.v1.ABCIQueryService/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ABCIQueryServiceServer is the server API for ABCIQueryService service.
+type ABCIQueryServiceServer interface {
+	// Query queries the application state. When served by a light client, the
+	// proof is always requested and verified against the trusted headers.
+	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+}
+
+// UnimplementedABCIQueryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedABCIQueryServiceServer struct {
+}
+
+func (*UnimplementedABCIQueryServiceServer) Query(ctx context.Context, req *QueryRequest) (*QueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+
+func RegisterABCIQueryServiceServer(s grpc1.Server, srv ABCIQueryServiceServer) {
+	s.RegisterService(&_ABCIQueryService_serviceDesc, srv)
+}
+
+func _ABCIQueryService_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIQueryServiceServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.abci_query.v1.ABCIQueryService/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIQueryServiceServer).Query(ctx, req.(*QueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ABCIQueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cometbft.services.abci_query.v1.ABCIQueryService",
+	HandlerType: (*ABCIQueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Query",
+			Handler:    _ABCIQueryService_Query_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cometbft/services/abci_query/v1/abci_query_service.proto",
+}
@@ -206,11 +206,106 @@ func (m *GetLatestHeightResponse) GetHeight() int64 {
 	return 0
 }
 
+// GetSignedHeaderRequest is a request for the signed header of the block at
+// the specified height.
+type GetSignedHeaderRequest struct {
+	// The height of the block requested.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetSignedHeaderRequest) Reset()         { *m = GetSignedHeaderRequest{} }
+func (m *GetSignedHeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSignedHeaderRequest) ProtoMessage()    {}
+func (*GetSignedHeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30eb8f0c11b1783, []int{4}
+}
+func (m *GetSignedHeaderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSignedHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSignedHeaderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSignedHeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSignedHeaderRequest.Merge(m, src)
+}
+func (m *GetSignedHeaderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSignedHeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSignedHeaderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSignedHeaderRequest proto.InternalMessageInfo
+
+func (m *GetSignedHeaderRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// GetSignedHeaderResponse contains the header of the block at the specified
+// height and the commit signing it, stored in the next block.
+type GetSignedHeaderResponse struct {
+	SignedHeader *v1.SignedHeader `protobuf:"bytes,1,opt,name=signed_header,json=signedHeader,proto3" json:"signed_header,omitempty"`
+}
+
+func (m *GetSignedHeaderResponse) Reset()         { *m = GetSignedHeaderResponse{} }
+func (m *GetSignedHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSignedHeaderResponse) ProtoMessage()    {}
+func (*GetSignedHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30eb8f0c11b1783, []int{5}
+}
+func (m *GetSignedHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSignedHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSignedHeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSignedHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSignedHeaderResponse.Merge(m, src)
+}
+func (m *GetSignedHeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSignedHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSignedHeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSignedHeaderResponse proto.InternalMessageInfo
+
+func (m *GetSignedHeaderResponse) GetSignedHeader() *v1.SignedHeader {
+	if m != nil {
+		return m.SignedHeader
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetByHeightRequest)(nil), "cometbft.services.block.v1.GetByHeightRequest")
 	proto.RegisterType((*GetByHeightResponse)(nil), "cometbft.services.block.v1.GetByHeightResponse")
 	proto.RegisterType((*GetLatestHeightRequest)(nil), "cometbft.services.block.v1.GetLatestHeightRequest")
 	proto.RegisterType((*GetLatestHeightResponse)(nil), "cometbft.services.block.v1.GetLatestHeightResponse")
+	proto.RegisterType((*GetSignedHeaderRequest)(nil), "cometbft.services.block.v1.GetSignedHeaderRequest")
+	proto.RegisterType((*GetSignedHeaderResponse)(nil), "cometbft.services.block.v1.GetSignedHeaderResponse")
 }
 
 func init() {
@@ -218,7 +313,7 @@ func init() {
 }
 
 var fileDescriptor_a30eb8f0c11b1783 = []byte{
-	// 271 bytes of a gzipped FileDescriptorProto
+	// 315 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xce, 0xcf, 0x4d,
 	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0x4f, 0xca,
 	0xc9, 0x4f, 0xce, 0xd6, 0x2f, 0x33, 0x84, 0x30, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4,
@@ -231,11 +326,14 @@ var fileDescriptor_a30eb8f0c11b1783 = []byte{
 	0x86, 0x38, 0xa6, 0xcc, 0x50, 0xcf, 0x09, 0xa4, 0xc4, 0xd3, 0x25, 0x88, 0x1d, 0xac, 0xd6, 0x33,
 	0x45, 0x48, 0x8f, 0x8b, 0x15, 0xcc, 0x94, 0x60, 0x02, 0xeb, 0x91, 0xc0, 0xa5, 0x27, 0x08, 0xa2,
 	0x4c, 0x49, 0x82, 0x4b, 0xcc, 0x3d, 0xb5, 0xc4, 0x27, 0xb1, 0x24, 0xb5, 0xb8, 0x04, 0xc5, 0xbd,
-	0x4a, 0x86, 0x5c, 0xe2, 0x18, 0x32, 0x50, 0xb7, 0xe1, 0xf0, 0x8a, 0x53, 0xe8, 0x89, 0x47, 0x72,
-	0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7,
-	0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x59, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0x81, 0x5c,
-	0xa3, 0x0f, 0x0f, 0x3c, 0x38, 0x23, 0xb1, 0x20, 0x53, 0x1f, 0x77, 0xac, 0x25, 0xb1, 0x81, 0x83,
-	0xd5, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x65, 0xb5, 0xa5, 0x74, 0xda, 0x01, 0x00, 0x00,
+	0x4a, 0x86, 0x5c, 0xe2, 0x18, 0x32, 0x50, 0xb7, 0xe1, 0xf2, 0x8a, 0x01, 0xd8, 0xb0, 0xe0, 0xcc,
+	0xf4, 0xbc, 0xd4, 0x14, 0x8f, 0xd4, 0xc4, 0x94, 0xd4, 0x22, 0x42, 0x9e, 0x8f, 0xe7, 0x12, 0xc7,
+	0xd0, 0x01, 0xb5, 0xc4, 0x85, 0x8b, 0xb7, 0x18, 0x2c, 0x1e, 0x9f, 0x01, 0x96, 0x80, 0x86, 0x82,
+	0x3c, 0x16, 0x1f, 0xa1, 0xe8, 0xe7, 0x29, 0x46, 0xe2, 0x39, 0x85, 0x9e, 0x78, 0x24, 0xc7, 0x78,
+	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
+	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x75, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x12, 0xc8, 0x38, 0x7d,
+	0x78, 0x7c, 0xc2, 0x19, 0x89, 0x05, 0x99, 0xfa, 0xb8, 0x13, 0x52, 0x12, 0x1b, 0x38, 0xa6, 0x8d,
+	0x01, 0x03, 0x00, 0x25, 0x73, 0xb7, 0x23, 0x6d, 0x02, 0x00, 0x00,
 }
 
 func (m *GetByHeightRequest) Marshal() (dAtA []byte, err error) {
@@ -364,6 +462,69 @@ func (m *GetLatestHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *GetSignedHeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSignedHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSignedHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSignedHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSignedHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSignedHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignedHeader != nil {
+		{
+			size, err := m.SignedHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBlock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBlock(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBlock(v)
 	base := offset
@@ -425,6 +586,31 @@ func (m *GetLatestHeightResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetSignedHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlock(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetSignedHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedHeader != nil {
+		l = m.SignedHeader.Size()
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	return n
+}
+
 func sovBlock(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -741,6 +927,161 @@ func (m *GetLatestHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetSignedHeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSignedHeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSignedHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSignedHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSignedHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSignedHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignedHeader == nil {
+				m.SignedHeader = &v1.SignedHeader{}
+			}
+			if err := m.SignedHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBlock(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
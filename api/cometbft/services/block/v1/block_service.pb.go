@@ -30,21 +30,23 @@ func init() {
 }
 
 var fileDescriptor_5768ae424af71eff = []byte{
-	// 219 bytes of a gzipped FileDescriptorProto
+	// 243 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0xce, 0xcf, 0x4d,
 	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0x4f, 0xca,
 	0xc9, 0x4f, 0xce, 0xd6, 0x2f, 0x33, 0x84, 0x30, 0xe2, 0xa1, 0xe2, 0x7a, 0x05, 0x45, 0xf9, 0x25,
 	0xf9, 0x42, 0x52, 0x30, 0xf5, 0x7a, 0x30, 0xf5, 0x7a, 0x60, 0x65, 0x7a, 0x65, 0x86, 0x52, 0x6a,
-	0x84, 0xcc, 0x82, 0x98, 0x61, 0xf4, 0x87, 0x91, 0x8b, 0xc7, 0x09, 0xc4, 0x0f, 0x86, 0x28, 0x13,
+	0x84, 0xcc, 0x82, 0x98, 0x61, 0xf4, 0x83, 0x89, 0x8b, 0xc7, 0x09, 0xc4, 0x0f, 0x86, 0x28, 0x13,
 	0xca, 0xe3, 0xe2, 0x76, 0x4f, 0x2d, 0x71, 0xaa, 0xf4, 0x48, 0xcd, 0x4c, 0xcf, 0x28, 0x11, 0xd2,
 	0xd3, 0xc3, 0x6d, 0x89, 0x1e, 0x92, 0xc2, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x29, 0x7d,
-	0xa2, 0xd5, 0x17, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x0a, 0xd5, 0x70, 0xf1, 0xbb, 0xa7, 0x96, 0xf8,
-	0x24, 0x96, 0xa4, 0x16, 0x97, 0x40, 0xed, 0x34, 0x22, 0x60, 0x06, 0xb2, 0x62, 0x98, 0xbd, 0xc6,
-	0x24, 0xe9, 0x81, 0xd8, 0x6d, 0xc0, 0xe8, 0x14, 0x7a, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0xd6, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x20, 0x63, 0xf5, 0xe1, 0x61, 0x09,
-	0x67, 0x24, 0x16, 0x64, 0xea, 0xe3, 0x0e, 0xe1, 0x24, 0x36, 0x70, 0xe0, 0x1a, 0x03, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xab, 0x27, 0xc3, 0x98, 0xd2, 0x01, 0x00, 0x00,
+	0xa2, 0xd5, 0x17, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x0a, 0x55, 0x71, 0xf1, 0xbb, 0xa7, 0x96, 0x04,
+	0x67, 0xa6, 0xe7, 0xa5, 0xa6, 0x78, 0xa4, 0x26, 0xa6, 0xa4, 0x16, 0x09, 0x19, 0x11, 0x30, 0x03,
+	0x59, 0x31, 0xcc, 0x5e, 0x63, 0x92, 0xf4, 0x40, 0xed, 0xae, 0x01, 0xdb, 0xed, 0x93, 0x58, 0x92,
+	0x5a, 0x5c, 0x02, 0xf5, 0x2f, 0x21, 0xbb, 0x91, 0x15, 0x13, 0x6b, 0x37, 0xaa, 0x1e, 0x88, 0xdd,
+	0x06, 0x8c, 0x4e, 0xa1, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c,
+	0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x9d,
+	0x9e, 0x59, 0x92, 0x51, 0x9a, 0x04, 0x32, 0x56, 0x1f, 0x1e, 0x8f, 0x70, 0x46, 0x62, 0x41, 0xa6,
+	0x3e, 0xee, 0xd8, 0x4d, 0x62, 0x03, 0x47, 0xac, 0x31, 0x60, 0x00, 0xb7, 0x1e, 0x26, 0x9f, 0x4e,
+	0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -61,6 +63,10 @@ const _ = grpc.SupportPackageIsVersion4
 type BlockServiceClient interface {
 	// GetBlock retrieves the block information at a particular height.
 	GetByHeight(ctx context.Context, in *GetByHeightRequest, opts ...grpc.CallOption) (*GetByHeightResponse, error)
+	// GetSignedHeader retrieves the header of the block at a particular height
+	// and the commit signing it, without the transactions and evidence of the
+	// block.
+	GetSignedHeader(ctx context.Context, in *GetSignedHeaderRequest, opts ...grpc.CallOption) (*GetSignedHeaderResponse, error)
 	// GetLatestHeight returns a stream of the latest block heights committed by
 	// the network. This is a long-lived stream that is only terminated by the
 	// server if an error occurs. The caller is expected to handle such
@@ -85,6 +91,15 @@ func (c *blockServiceClient) GetByHeight(ctx context.Context, in *GetByHeightReq
 	return out, nil
 }
 
+func (c *blockServiceClient) GetSignedHeader(ctx context.Context, in *GetSignedHeaderRequest, opts ...grpc.CallOption) (*GetSignedHeaderResponse, error) {
+	out := new(GetSignedHeaderResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.block.v1.BlockService/GetSignedHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockServiceClient) GetLatestHeight(ctx context.Context, in *GetLatestHeightRequest, opts ...grpc.CallOption) (BlockService_GetLatestHeightClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_BlockService_serviceDesc.Streams[0], "/cometbft.services.block.v1.BlockService/GetLatestHeight", opts...)
 	if err != nil {
@@ -121,6 +136,10 @@ func (x *blockServiceGetLatestHeightClient) Recv() (*GetLatestHeightResponse, er
 type BlockServiceServer interface {
 	// GetBlock retrieves the block information at a particular height.
 	GetByHeight(context.Context, *GetByHeightRequest) (*GetByHeightResponse, error)
+	// GetSignedHeader retrieves the header of the block at a particular height
+	// and the commit signing it, without the transactions and evidence of the
+	// block.
+	GetSignedHeader(context.Context, *GetSignedHeaderRequest) (*GetSignedHeaderResponse, error)
 	// GetLatestHeight returns a stream of the latest block heights committed by
 	// the network. This is a long-lived stream that is only terminated by the
 	// server if an error occurs. The caller is expected to handle such
@@ -135,6 +154,9 @@ type UnimplementedBlockServiceServer struct {
 func (*UnimplementedBlockServiceServer) GetByHeight(ctx context.Context, req *GetByHeightRequest) (*GetByHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByHeight not implemented")
 }
+func (*UnimplementedBlockServiceServer) GetSignedHeader(ctx context.Context, req *GetSignedHeaderRequest) (*GetSignedHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignedHeader not implemented")
+}
 func (*UnimplementedBlockServiceServer) GetLatestHeight(req *GetLatestHeightRequest, srv BlockService_GetLatestHeightServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetLatestHeight not implemented")
 }
@@ -161,6 +183,24 @@ func _BlockService_GetByHeight_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockService_GetSignedHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSignedHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockServiceServer).GetSignedHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.block.v1.BlockService/GetSignedHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockServiceServer).GetSignedHeader(ctx, req.(*GetSignedHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlockService_GetLatestHeight_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetLatestHeightRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -190,6 +230,10 @@ var _BlockService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetByHeight",
 			Handler:    _BlockService_GetByHeight_Handler,
 		},
+		{
+			MethodName: "GetSignedHeader",
+			Handler:    _BlockService_GetSignedHeader_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
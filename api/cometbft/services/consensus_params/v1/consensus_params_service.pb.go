@@ -0,0 +1,129 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/services/consensus_params/v1/consensus_params_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("cometbft/services/consensus_params/v1/consensus_params_service.proto", fileDescriptor_23ae41097aeb995f)
+}
+
+var fileDescriptor_23ae41097aeb995f = []byte{
+	// 200 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x72, 0x49, 0xce, 0xcf, 0x4d,
+	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0x4f, 0xce,
+	0xcf, 0x2b, 0x4e, 0xcd, 0x2b, 0x2e, 0x2d, 0x8e, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2f,
+	0x33, 0xc4, 0x10, 0x8b, 0x87, 0xaa, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x85, 0x99,
+	0xa2, 0x07, 0x33, 0x45, 0x0f, 0x5d, 0x87, 0x5e, 0x99, 0xa1, 0x94, 0x0d, 0x79, 0x96, 0x41, 0x2c,
+	0x31, 0x9a, 0xcf, 0xc8, 0x25, 0xe6, 0x0c, 0x93, 0x0a, 0x00, 0xcb, 0x04, 0x43, 0x8c, 0x11, 0x6a,
+	0x61, 0xe4, 0xe2, 0x76, 0x4f, 0x2d, 0x71, 0xaa, 0xf4, 0x48, 0xcd, 0x4c, 0xcf, 0x28, 0x11, 0xb2,
+	0xd4, 0x23, 0xca, 0x41, 0x7a, 0x48, 0x7a, 0x82, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0xa4, 0xac,
+	0xc8, 0xd1, 0x5a, 0x5c, 0x00, 0x52, 0xe1, 0x94, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
+	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
+	0x72, 0x0c, 0x51, 0x6e, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x20, 0xb3, 0xf5, 0xe1, 0x81, 0x00,
+	0x67, 0x24, 0x16, 0x64, 0xea, 0x13, 0x15, 0x34, 0x49, 0x6c, 0xe0, 0xa0, 0x30, 0x06, 0x0c, 0x00,
+	0x9a, 0x19, 0x1e, 0x77, 0xb7, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ConsensusParamsServiceClient is the client API for ConsensusParamsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ConsensusParamsServiceClient interface {
+	// GetByHeight retrieves the consensus parameters at a particular height.
+	GetByHeight(ctx context.Context, in *GetByHeightRequest, opts ...grpc.CallOption) (*GetByHeightResponse, error)
+}
+
+type consensusParamsServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewConsensusParamsServiceClient(cc grpc1.ClientConn) ConsensusParamsServiceClient {
+	return &consensusParamsServiceClient{cc}
+}
+
+func (c *consensusParamsServiceClient) GetByHeight(ctx context.Context, in *GetByHeightRequest, opts ...grpc.CallOption) (*GetByHeightResponse, error) {
+	out := new(GetByHeightResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.consensus_params.v1.ConsensusParamsService/GetByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConsensusParamsServiceServer is the server API for ConsensusParamsService service.
+type ConsensusParamsServiceServer interface {
+	// GetByHeight retrieves the consensus parameters at a particular height.
+	GetByHeight(context.Context, *GetByHeightRequest) (*GetByHeightResponse, error)
+}
+
+// UnimplementedConsensusParamsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedConsensusParamsServiceServer struct {
+}
+
+func (*UnimplementedConsensusParamsServiceServer) GetByHeight(ctx context.Context, req *GetByHeightRequest) (*GetByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByHeight not implemented")
+}
+
+func RegisterConsensusParamsServiceServer(s grpc1.Server, srv ConsensusParamsServiceServer) {
+	s.RegisterService(&_ConsensusParamsService_serviceDesc, srv)
+}
+
+func _ConsensusParamsService_GetByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsensusParamsServiceServer).GetByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.consensus_params.v1.ConsensusParamsService/GetByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsensusParamsServiceServer).GetByHeight(ctx, req.(*GetByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ConsensusParamsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cometbft.services.consensus_params.v1.ConsensusParamsService",
+	HandlerType: (*ConsensusParamsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetByHeight",
+			Handler:    _ConsensusParamsService_GetByHeight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cometbft/services/consensus_params/v1/consensus_params_service.proto",
+}
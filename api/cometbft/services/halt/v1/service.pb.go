@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/services/halt/v1/service.proto
+
+package cometbft_services_halt_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("cometbft/services/halt/v1/service.proto", fileDescriptor_5715e6a614c7e726)
+}
+
+var fileDescriptor_5715e6a614c7e726 = []byte{
+	// 175 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0xce, 0xcf, 0x4d,
+	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0xcf, 0x48,
+	0xcc, 0x29, 0xd1, 0x2f, 0x33, 0x84, 0x09, 0xe8, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x49, 0xc2,
+	0x14, 0xea, 0xc1, 0x14, 0xea, 0x81, 0x14, 0xea, 0x95, 0x19, 0x4a, 0xa9, 0xe0, 0x36, 0x03, 0xac,
+	0x04, 0x6c, 0x80, 0xd1, 0x57, 0x46, 0x2e, 0x6e, 0x8f, 0xc4, 0x9c, 0x92, 0x60, 0x88, 0x1a, 0xa1,
+	0x22, 0x2e, 0xde, 0xe0, 0xd4, 0x12, 0x90, 0x88, 0x47, 0x6a, 0x66, 0x7a, 0x46, 0x89, 0x90, 0xbe,
+	0x1e, 0x4e, 0x2b, 0xf4, 0x50, 0x54, 0x06, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97, 0x48, 0x19, 0x10,
+	0xaf, 0xa1, 0xb8, 0x20, 0x3f, 0xaf, 0x18, 0x6c, 0xa7, 0x3b, 0xd1, 0x76, 0xba, 0x93, 0x6a, 0xa7,
+	0x3b, 0x36, 0x3b, 0x9d, 0x24, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
+	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x89,
+	0x0d, 0x1c, 0x30, 0xc6, 0x80, 0x01, 0x00, 0xb6, 0x21, 0xee, 0xbc, 0x84, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// HaltServiceClient is the client API for HaltService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type HaltServiceClient interface {
+	// SetHaltHeight makes the node stop consensus after committing the block at
+	// the specified height. The node then exits with code 3.
+	//
+	// A height of 0 cancels a previously set halt height.
+	SetHaltHeight(ctx context.Context, in *SetHaltHeightRequest, opts ...grpc.CallOption) (*SetHaltHeightResponse, error)
+	// GetHaltHeight returns the height after which the node stops consensus.
+	GetHaltHeight(ctx context.Context, in *GetHaltHeightRequest, opts ...grpc.CallOption) (*GetHaltHeightResponse, error)
+}
+
+type haltServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewHaltServiceClient(cc grpc1.ClientConn) HaltServiceClient {
+	return &haltServiceClient{cc}
+}
+
+func (c *haltServiceClient) SetHaltHeight(ctx context.Context, in *SetHaltHeightRequest, opts ...grpc.CallOption) (*SetHaltHeightResponse, error) {
+	out := new(SetHaltHeightResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.halt.v1.HaltService/SetHaltHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *haltServiceClient) GetHaltHeight(ctx context.Context, in *GetHaltHeightRequest, opts ...grpc.CallOption) (*GetHaltHeightResponse, error) {
+	out := new(GetHaltHeightResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.halt.v1.HaltService/GetHaltHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HaltServiceServer is the server API for HaltService service.
+type HaltServiceServer interface {
+	// SetHaltHeight makes the node stop consensus after committing the block at
+	// the specified height. The node then exits with code 3.
+	//
+	// A height of 0 cancels a previously set halt height.
+	SetHaltHeight(context.Context, *SetHaltHeightRequest) (*SetHaltHeightResponse, error)
+	// GetHaltHeight returns the height after which the node stops consensus.
+	GetHaltHeight(context.Context, *GetHaltHeightRequest) (*GetHaltHeightResponse, error)
+}
+
+// UnimplementedHaltServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedHaltServiceServer struct {
+}
+
+func (*UnimplementedHaltServiceServer) SetHaltHeight(ctx context.Context, req *SetHaltHeightRequest) (*SetHaltHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHaltHeight not implemented")
+}
+func (*UnimplementedHaltServiceServer) GetHaltHeight(ctx context.Context, req *GetHaltHeightRequest) (*GetHaltHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHaltHeight not implemented")
+}
+
+func RegisterHaltServiceServer(s grpc1.Server, srv HaltServiceServer) {
+	s.RegisterService(&_HaltService_serviceDesc, srv)
+}
+
+func _HaltService_SetHaltHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHaltHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HaltServiceServer).SetHaltHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.halt.v1.HaltService/SetHaltHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HaltServiceServer).SetHaltHeight(ctx, req.(*SetHaltHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HaltService_GetHaltHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHaltHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HaltServiceServer).GetHaltHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.halt.v1.HaltService/GetHaltHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HaltServiceServer).GetHaltHeight(ctx, req.(*GetHaltHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _HaltService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cometbft.services.halt.v1.HaltService",
+	HandlerType: (*HaltServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetHaltHeight",
+			Handler:    _HaltService_SetHaltHeight_Handler,
+		},
+		{
+			MethodName: "GetHaltHeight",
+			Handler:    _HaltService_GetHaltHeight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cometbft/services/halt/v1/service.proto",
+}
@@ -0,0 +1,995 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/services/validator_set/v1/validator_set.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	v1 "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GetByHeightRequest is a request for the validator set at the specified
+// height.
+type GetByHeightRequest struct {
+	// The height of the validator set requested. If 0, the validator set of the
+	// latest committed block is returned.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetByHeightRequest) Reset()         { *m = GetByHeightRequest{} }
+func (m *GetByHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*GetByHeightRequest) ProtoMessage()    {}
+func (*GetByHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c11fb543ac2431be, []int{0}
+}
+func (m *GetByHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetByHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetByHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetByHeightRequest.Merge(m, src)
+}
+func (m *GetByHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetByHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetByHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetByHeightRequest proto.InternalMessageInfo
+
+func (m *GetByHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// GetByHeightResponse contains the validator set at the specified height.
+type GetByHeightResponse struct {
+	// The height of the validator set.
+	Height       int64            `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	ValidatorSet *v1.ValidatorSet `protobuf:"bytes,2,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+}
+
+func (m *GetByHeightResponse) Reset()         { *m = GetByHeightResponse{} }
+func (m *GetByHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*GetByHeightResponse) ProtoMessage()    {}
+func (*GetByHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c11fb543ac2431be, []int{1}
+}
+func (m *GetByHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetByHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetByHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetByHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetByHeightResponse.Merge(m, src)
+}
+func (m *GetByHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetByHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetByHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetByHeightResponse proto.InternalMessageInfo
+
+func (m *GetByHeightResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetByHeightResponse) GetValidatorSet() *v1.ValidatorSet {
+	if m != nil {
+		return m.ValidatorSet
+	}
+	return nil
+}
+
+// GetChangesRequest is a request for the heights at which the validator set
+// changed within a range.
+type GetChangesRequest struct {
+	// The first height of the range.
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// The last height of the range. If 0, the range ends at the latest committed
+	// block.
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *GetChangesRequest) Reset()         { *m = GetChangesRequest{} }
+func (m *GetChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetChangesRequest) ProtoMessage()    {}
+func (*GetChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c11fb543ac2431be, []int{2}
+}
+func (m *GetChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChangesRequest.Merge(m, src)
+}
+func (m *GetChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChangesRequest proto.InternalMessageInfo
+
+func (m *GetChangesRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *GetChangesRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// GetChangesResponse contains the heights at which the validator set changed.
+type GetChangesResponse struct {
+	// The heights, in ascending order, whose validator set differs from the one
+	// of the previous height.
+	Heights []int64 `protobuf:"varint,1,rep,packed,name=heights,proto3" json:"heights,omitempty"`
+	// The last height of the range that was scanned. It is lower than the
+	// requested to_height if the range was truncated, in which case the rest of
+	// the range can be requested from last_height + 1.
+	LastHeight int64 `protobuf:"varint,2,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+}
+
+func (m *GetChangesResponse) Reset()         { *m = GetChangesResponse{} }
+func (m *GetChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetChangesResponse) ProtoMessage()    {}
+func (*GetChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c11fb543ac2431be, []int{3}
+}
+func (m *GetChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChangesResponse.Merge(m, src)
+}
+func (m *GetChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChangesResponse proto.InternalMessageInfo
+
+func (m *GetChangesResponse) GetHeights() []int64 {
+	if m != nil {
+		return m.Heights
+	}
+	return nil
+}
+
+func (m *GetChangesResponse) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GetByHeightRequest)(nil), "cometbft.services.validator_set.v1.GetByHeightRequest")
+	proto.RegisterType((*GetByHeightResponse)(nil), "cometbft.services.validator_set.v1.GetByHeightResponse")
+	proto.RegisterType((*GetChangesRequest)(nil), "cometbft.services.validator_set.v1.GetChangesRequest")
+	proto.RegisterType((*GetChangesResponse)(nil), "cometbft.services.validator_set.v1.GetChangesResponse")
+}
+
+func init() {
+	proto.RegisterFile("cometbft/services/validator_set/v1/validator_set.proto", fileDescriptor_c11fb543ac2431be)
+}
+
+var fileDescriptor_c11fb543ac2431be = []byte{
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0xed, 0xb6, 0x50, 0x75, 0xaa, 0x07, 0x23, 0x48, 0x50, 0xd8, 0xd6, 0x9c, 0x7a, 0x90, 0x0d,
+	0x55, 0xf0, 0x03, 0x5a, 0xa1, 0xde, 0xc4, 0x08, 0x1e, 0x04, 0x29, 0x69, 0x9d, 0x36, 0x81, 0xb6,
+	0x1b, 0xb3, 0xd3, 0x85, 0xfe, 0x85, 0x9f, 0xe5, 0xb1, 0x47, 0x8f, 0xd2, 0xfc, 0x88, 0x24, 0xe9,
+	0x86, 0xee, 0x41, 0xbc, 0xed, 0xbc, 0xf7, 0xe6, 0xcd, 0x1b, 0x66, 0xe1, 0x6e, 0x22, 0x17, 0x48,
+	0xe3, 0x29, 0xf9, 0x0a, 0x53, 0x1d, 0x4f, 0x50, 0xf9, 0x3a, 0x9c, 0xc7, 0xef, 0x21, 0xc9, 0x74,
+	0xa4, 0x90, 0x7c, 0xdd, 0xb3, 0x01, 0x91, 0xa4, 0x92, 0xa4, 0xe3, 0x99, 0x3e, 0x61, 0xfa, 0x84,
+	0x2d, 0xd3, 0xbd, 0x8b, 0xab, 0xca, 0x9b, 0xd6, 0x09, 0x2a, 0xcb, 0xaa, 0xb4, 0xf1, 0xae, 0xc1,
+	0x19, 0x22, 0xf5, 0xd7, 0x0f, 0x18, 0xcf, 0x22, 0x0a, 0xf0, 0x63, 0x85, 0x8a, 0x9c, 0x73, 0x68,
+	0x46, 0x05, 0xe0, 0xb2, 0x0e, 0xeb, 0x36, 0x82, 0x5d, 0xe5, 0x29, 0x38, 0xb3, 0xd4, 0x2a, 0x91,
+	0x4b, 0x85, 0x7f, 0xc9, 0x9d, 0x7b, 0x38, 0xb1, 0x32, 0xb9, 0xf5, 0x0e, 0xeb, 0xb6, 0x6e, 0xda,
+	0xa2, 0xca, 0x5e, 0xe4, 0x12, 0xba, 0x27, 0x5e, 0x8c, 0xee, 0x19, 0x29, 0x38, 0xd6, 0x7b, 0x95,
+	0xf7, 0x04, 0xa7, 0x43, 0xa4, 0x41, 0x14, 0x2e, 0x67, 0xa8, 0x4c, 0xc2, 0x36, 0xb4, 0xa6, 0xa9,
+	0x5c, 0x8c, 0xac, 0xb9, 0x90, 0x43, 0x65, 0x36, 0xe7, 0x12, 0x8e, 0x48, 0x1a, 0xba, 0x5e, 0xd0,
+	0x87, 0x24, 0x4b, 0xd2, 0x7b, 0x04, 0x67, 0xdf, 0x72, 0xb7, 0x86, 0x0b, 0x07, 0xa5, 0x5e, 0xb9,
+	0xac, 0xd3, 0xe8, 0x36, 0x02, 0x53, 0xe6, 0xd3, 0xe6, 0xa1, 0x22, 0xdb, 0x0e, 0x72, 0xa8, 0x34,
+	0xec, 0xbf, 0x7d, 0x6d, 0x39, 0xdb, 0x6c, 0x39, 0xfb, 0xd9, 0x72, 0xf6, 0x99, 0xf1, 0xda, 0x26,
+	0xe3, 0xb5, 0xef, 0x8c, 0xd7, 0x5e, 0x07, 0xb3, 0x98, 0xa2, 0xd5, 0x38, 0x5f, 0xd9, 0xaf, 0xce,
+	0x51, 0x3d, 0xc2, 0x24, 0xf6, 0xff, 0xff, 0x00, 0xe3, 0x66, 0x71, 0xac, 0xdb, 0xdf, 0x01, 0x00,
+	0xff, 0x34, 0x0e, 0x62, 0x2d, 0x02, 0x00, 0x00,
+}
+
+func (m *GetByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintValidatorSet(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetByHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorSet != nil {
+		{
+			size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintValidatorSet(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintValidatorSet(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintValidatorSet(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintValidatorSet(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		i = encodeVarintValidatorSet(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Heights) > 0 {
+		dAtA3 := make([]byte, len(m.Heights)*10)
+		var j2 int
+		for _, num1 := range m.Heights {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintValidatorSet(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidatorSet(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidatorSet(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovValidatorSet(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovValidatorSet(uint64(m.Height))
+	}
+	if m.ValidatorSet != nil {
+		l = m.ValidatorSet.Size()
+		n += 1 + l + sovValidatorSet(uint64(l))
+	}
+	return n
+}
+
+func (m *GetChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovValidatorSet(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovValidatorSet(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *GetChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Heights) > 0 {
+		l = 0
+		for _, e := range m.Heights {
+			l += sovValidatorSet(uint64(e))
+		}
+		n += 1 + sovValidatorSet(uint64(l)) + l
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovValidatorSet(uint64(m.LastHeight))
+	}
+	return n
+}
+
+func sovValidatorSet(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidatorSet(x uint64) (n int) {
+	return sovValidatorSet(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetByHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorSet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetByHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorSet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorSet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetByHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorSet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetByHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidatorSet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorSet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorSet == nil {
+				m.ValidatorSet = &v1.ValidatorSet{}
+			}
+			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorSet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorSet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorSet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorSet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorSet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorSet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorSet
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Heights = append(m.Heights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidatorSet
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidatorSet
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidatorSet
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Heights) == 0 {
+					m.Heights = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidatorSet
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Heights = append(m.Heights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heights", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorSet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorSet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidatorSet(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidatorSet
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorSet
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorSet
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidatorSet
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidatorSet
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidatorSet
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidatorSet        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidatorSet          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidatorSet = fmt.Errorf("proto: unexpected end of group")
+)
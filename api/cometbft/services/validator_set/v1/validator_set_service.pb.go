@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/services/validator_set/v1/validator_set_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("cometbft/services/validator_set/v1/validator_set_service.proto", fileDescriptor_02af5d2611446903)
+}
+
+var fileDescriptor_02af5d2611446903 = []byte{
+	// 226 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0x4b, 0xce, 0xcf, 0x4d,
+	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0x2f, 0x4b,
+	0xcc, 0xc9, 0x4c, 0x49, 0x2c, 0xc9, 0x2f, 0x8a, 0x2f, 0x4e, 0x2d, 0xd1, 0x2f, 0x33, 0x44, 0x15,
+	0x88, 0x87, 0xaa, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x82, 0xe9, 0xd7, 0x83, 0xe9,
+	0xd7, 0x43, 0x51, 0xae, 0x57, 0x66, 0x28, 0x65, 0x46, 0xaa, 0x1d, 0x10, 0xb3, 0x8d, 0x26, 0x31,
+	0x71, 0x09, 0x87, 0xc1, 0xc4, 0x83, 0x53, 0x4b, 0x82, 0x21, 0xba, 0x85, 0xea, 0xb8, 0xb8, 0xdd,
+	0x53, 0x4b, 0x9c, 0x2a, 0x3d, 0x52, 0x33, 0xd3, 0x33, 0x4a, 0x84, 0xcc, 0xf4, 0x08, 0xbb, 0x41,
+	0x0f, 0x49, 0x43, 0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71, 0x89, 0x94, 0x39, 0xc9, 0xfa, 0x8a, 0x0b,
+	0xf2, 0xf3, 0x8a, 0x53, 0x85, 0xaa, 0xb9, 0xb8, 0xdc, 0x53, 0x4b, 0x9c, 0x33, 0x12, 0xf3, 0xd2,
+	0x53, 0x8b, 0x85, 0x4c, 0x89, 0x34, 0x06, 0xaa, 0x1e, 0x66, 0xbb, 0x19, 0xa9, 0xda, 0x20, 0x96,
+	0x3b, 0xc5, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
+	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x73, 0x7a, 0x66,
+	0x49, 0x46, 0x69, 0x12, 0xc8, 0x5c, 0x7d, 0x78, 0x88, 0xc3, 0x19, 0x89, 0x05, 0x99, 0xfa, 0x84,
+	0xe3, 0x21, 0x89, 0x0d, 0x1c, 0xf4, 0xc6, 0x80, 0x01, 0x00, 0xf5, 0x92, 0xa3, 0x23, 0x18, 0x02,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ValidatorSetServiceClient is the client API for ValidatorSetService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ValidatorSetServiceClient interface {
+	// GetByHeight retrieves the validator set at a particular height.
+	GetByHeight(ctx context.Context, in *GetByHeightRequest, opts ...grpc.CallOption) (*GetByHeightResponse, error)
+	// GetChanges retrieves the heights at which the validator set changed
+	// within a range.
+	GetChanges(ctx context.Context, in *GetChangesRequest, opts ...grpc.CallOption) (*GetChangesResponse, error)
+}
+
+type validatorSetServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewValidatorSetServiceClient(cc grpc1.ClientConn) ValidatorSetServiceClient {
+	return &validatorSetServiceClient{cc}
+}
+
+func (c *validatorSetServiceClient) GetByHeight(ctx context.Context, in *GetByHeightRequest, opts ...grpc.CallOption) (*GetByHeightResponse, error) {
+	out := new(GetByHeightResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.validator_set.v1.ValidatorSetService/GetByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorSetServiceClient) GetChanges(ctx context.Context, in *GetChangesRequest, opts ...grpc.CallOption) (*GetChangesResponse, error) {
+	out := new(GetChangesResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.validator_set.v1.ValidatorSetService/GetChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ValidatorSetServiceServer is the server API for ValidatorSetService service.
+type ValidatorSetServiceServer interface {
+	// GetByHeight retrieves the validator set at a particular height.
+	GetByHeight(context.Context, *GetByHeightRequest) (*GetByHeightResponse, error)
+	// GetChanges retrieves the heights at which the validator set changed
+	// within a range.
+	GetChanges(context.Context, *GetChangesRequest) (*GetChangesResponse, error)
+}
+
+// UnimplementedValidatorSetServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedValidatorSetServiceServer struct {
+}
+
+func (*UnimplementedValidatorSetServiceServer) GetByHeight(ctx context.Context, req *GetByHeightRequest) (*GetByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByHeight not implemented")
+}
+func (*UnimplementedValidatorSetServiceServer) GetChanges(ctx context.Context, req *GetChangesRequest) (*GetChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChanges not implemented")
+}
+
+func RegisterValidatorSetServiceServer(s grpc1.Server, srv ValidatorSetServiceServer) {
+	s.RegisterService(&_ValidatorSetService_serviceDesc, srv)
+}
+
+func _ValidatorSetService_GetByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorSetServiceServer).GetByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.validator_set.v1.ValidatorSetService/GetByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorSetServiceServer).GetByHeight(ctx, req.(*GetByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorSetService_GetChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorSetServiceServer).GetChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.validator_set.v1.ValidatorSetService/GetChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorSetServiceServer).GetChanges(ctx, req.(*GetChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ValidatorSetService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cometbft.services.validator_set.v1.ValidatorSetService",
+	HandlerType: (*ValidatorSetServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetByHeight",
+			Handler:    _ValidatorSetService_GetByHeight_Handler,
+		},
+		{
+			MethodName: "GetChanges",
+			Handler:    _ValidatorSetService_GetChanges_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cometbft/services/validator_set/v1/validator_set_service.proto",
+}
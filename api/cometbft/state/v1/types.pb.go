@@ -457,6 +457,9 @@ type State struct {
 	// delay between the time when this block is committed and the next height is started.
 	// previously `timeout_commit` in config.toml
 	NextBlockDelay time.Duration `protobuf:"bytes,15,opt,name=next_block_delay,json=nextBlockDelay,proto3,stdduration" json:"next_block_delay"`
+	// LastCommitHash of the last block, seeding the verifiable-random proposer
+	// selection.
+	ProposerSeed []byte `protobuf:"bytes,16,opt,name=proposer_seed,json=proposerSeed,proto3" json:"proposer_seed,omitempty"`
 }
 
 func (m *State) Reset()         { *m = State{} }
@@ -597,6 +600,13 @@ func (m *State) GetNextBlockDelay() time.Duration {
 	return 0
 }
 
+func (m *State) GetProposerSeed() []byte {
+	if m != nil {
+		return m.ProposerSeed
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*LegacyABCIResponses)(nil), "cometbft.state.v1.LegacyABCIResponses")
 	proto.RegisterType((*ResponseBeginBlock)(nil), "cometbft.state.v1.ResponseBeginBlock")
@@ -611,70 +621,71 @@ func init() {
 func init() { proto.RegisterFile("cometbft/state/v1/types.proto", fileDescriptor_eb6b2e03ecdbc0c2) }
 
 var fileDescriptor_eb6b2e03ecdbc0c2 = []byte{
-	// 1008 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0xa3, 0xc4, 0x92, 0x46, 0xd6, 0x8f, 0x57, 0x4d, 0xc3, 0xb8, 0x8d, 0xe4, 0xa8, 0x4d,
-	0x6a, 0x14, 0x05, 0x05, 0xbb, 0xb7, 0x5e, 0xd2, 0x50, 0x4a, 0x60, 0x01, 0x6e, 0x50, 0xd0, 0x6e,
-	0x0e, 0xbe, 0x10, 0x2b, 0x72, 0x25, 0x2d, 0x4a, 0x91, 0x84, 0x76, 0xc5, 0xca, 0x7d, 0x80, 0x5e,
-	0x9b, 0x63, 0xd1, 0x07, 0xe9, 0x33, 0xe4, 0x98, 0x5b, 0x7b, 0x72, 0x03, 0xf9, 0xd6, 0xa7, 0x28,
-	0x76, 0xb9, 0xfc, 0xd1, 0x0f, 0x0a, 0x17, 0xb9, 0x91, 0x3b, 0x33, 0xdf, 0x7c, 0xf3, 0xed, 0xcc,
-	0xee, 0xc2, 0x23, 0x27, 0x98, 0x12, 0x3e, 0x1c, 0xf1, 0x2e, 0xe3, 0x98, 0x93, 0x6e, 0x74, 0xdc,
-	0xe5, 0x57, 0x21, 0x61, 0x46, 0x38, 0x0b, 0x78, 0x80, 0xf6, 0x13, 0xb3, 0x21, 0xcd, 0x46, 0x74,
-	0x7c, 0xf0, 0x69, 0x1a, 0x81, 0x87, 0x0e, 0x5d, 0x0b, 0x38, 0x68, 0xa5, 0x56, 0xb9, 0x2a, 0xcc,
-	0x21, 0x9e, 0xe1, 0x69, 0x62, 0x7f, 0xb4, 0x69, 0xcf, 0x87, 0x3f, 0xde, 0x34, 0x47, 0xd8, 0xa3,
-	0x2e, 0xe6, 0xc1, 0x4c, 0xb9, 0xb4, 0x53, 0x97, 0x88, 0xcc, 0x18, 0x0d, 0xfc, 0x75, 0x8c, 0x8f,
-	0xc6, 0xc1, 0x38, 0x90, 0x9f, 0x5d, 0xf1, 0x95, 0x84, 0x8d, 0x83, 0x60, 0xec, 0x91, 0xae, 0xfc,
-	0x1b, 0xce, 0x47, 0x5d, 0x4e, 0xa7, 0x84, 0x71, 0x3c, 0x0d, 0x13, 0xe6, 0xeb, 0x0e, 0xee, 0x7c,
-	0x86, 0x39, 0x0d, 0xfc, 0xd8, 0xde, 0x79, 0xaf, 0x41, 0xf3, 0x8c, 0x8c, 0xb1, 0x73, 0xf5, 0xdc,
-	0xec, 0x0d, 0x2c, 0xc2, 0xc2, 0xc0, 0x67, 0x84, 0xa1, 0x67, 0x50, 0x71, 0x89, 0x47, 0x23, 0x32,
-	0xb3, 0xf9, 0x82, 0xe9, 0xda, 0x61, 0xe1, 0xa8, 0x72, 0xd2, 0x32, 0x52, 0xe1, 0x84, 0x4a, 0x46,
-	0x74, 0x6c, 0xbc, 0x58, 0x10, 0xe7, 0x62, 0x61, 0x11, 0x36, 0xf7, 0xb8, 0x05, 0x2a, 0xe4, 0x62,
-	0xc1, 0xd0, 0xb7, 0x50, 0x26, 0xbe, 0x6b, 0x0f, 0xbd, 0xc0, 0xf9, 0x51, 0xbf, 0x73, 0xa8, 0x1d,
-	0x55, 0x4e, 0x3e, 0x33, 0x36, 0x74, 0x37, 0x92, 0x8c, 0x2f, 0x7c, 0xd7, 0x14, 0xae, 0x56, 0x89,
-	0xa8, 0x2f, 0xf4, 0x12, 0x2a, 0x43, 0x32, 0xa6, 0xbe, 0xc2, 0x28, 0x48, 0x8c, 0x27, 0xff, 0x81,
-	0x61, 0x0a, 0xef, 0x18, 0x05, 0x86, 0xe9, 0x77, 0xc7, 0x06, 0xb4, 0xe9, 0x81, 0x06, 0xb0, 0x4b,
-	0x22, 0xe2, 0xf3, 0xa4, 0xb6, 0x07, 0x5b, 0x6a, 0x13, 0x76, 0x53, 0x7f, 0x7b, 0xdd, 0xde, 0xf9,
-	0xe7, 0xba, 0xdd, 0x88, 0xdd, 0xbf, 0x0a, 0xa6, 0x94, 0x93, 0x69, 0xc8, 0xaf, 0x2c, 0x05, 0xd0,
-	0xf9, 0xf5, 0x0e, 0x34, 0xd6, 0xeb, 0x40, 0x17, 0xb0, 0x9f, 0xee, 0xb1, 0x3d, 0x0f, 0x5d, 0xcc,
-	0x49, 0x92, 0xea, 0xf1, 0x66, 0xaa, 0xd7, 0x89, 0xeb, 0x0f, 0xd2, 0xd3, 0xbc, 0x2b, 0x92, 0x5a,
-	0x8d, 0x68, 0x75, 0x99, 0xa1, 0x4b, 0x78, 0xe0, 0x88, 0x34, 0x3e, 0x9b, 0x33, 0x5b, 0xb6, 0x60,
-	0x8a, 0x1d, 0x6b, 0xdc, 0xc9, 0xb0, 0xe3, 0xee, 0x89, 0x8e, 0x8d, 0x5e, 0x12, 0xf1, 0xbd, 0xec,
-	0x59, 0xeb, 0xbe, 0xb3, 0xb2, 0x90, 0x60, 0x67, 0x8a, 0x14, 0x3e, 0x54, 0x91, 0x5f, 0x34, 0xa8,
-	0xa5, 0x25, 0xb1, 0x81, 0x3f, 0x0a, 0x50, 0x1f, 0xaa, 0x99, 0x1e, 0x8c, 0x70, 0x5d, 0x93, 0x7c,
-	0xdb, 0x5b, 0xf8, 0xa6, 0x91, 0xe7, 0x84, 0x5b, 0x7b, 0x51, 0xee, 0x0f, 0x19, 0xd0, 0xf4, 0x30,
-	0xe3, 0xf6, 0x84, 0xd0, 0xf1, 0x84, 0xdb, 0xce, 0x04, 0xfb, 0x63, 0xe2, 0xca, 0xda, 0x0b, 0xd6,
-	0xbe, 0x30, 0x9d, 0x4a, 0x4b, 0x2f, 0x36, 0x74, 0x7e, 0xd7, 0xa0, 0xb9, 0x56, 0xbe, 0x64, 0x73,
-	0x0e, 0x8d, 0x35, 0x1d, 0x99, 0x22, 0x74, 0x0b, 0x01, 0xd5, 0xee, 0xd4, 0x57, 0x65, 0x64, 0xff,
-	0x9b, 0xdc, 0x9f, 0x1a, 0xec, 0xaf, 0x4c, 0x9d, 0xa4, 0x76, 0x09, 0xf7, 0x3d, 0x39, 0x90, 0xb6,
-	0x50, 0xdd, 0x9e, 0x25, 0x46, 0xc5, 0xef, 0xe9, 0x96, 0x01, 0xd8, 0x32, 0xc0, 0x56, 0x33, 0x06,
-	0x79, 0x3e, 0x74, 0x68, 0x36, 0xd5, 0x1f, 0xc3, 0x6e, 0x4c, 0x4e, 0x91, 0x52, 0x7f, 0xe8, 0x15,
-	0xd4, 0x46, 0xd4, 0xc7, 0x1e, 0xfd, 0x99, 0xac, 0x4c, 0xdb, 0x17, 0x9b, 0x2d, 0xf0, 0x52, 0xf9,
-	0xc5, 0x73, 0xa6, 0x90, 0xad, 0xea, 0x28, 0xbf, 0xdc, 0xa1, 0x50, 0x7c, 0x1d, 0x1f, 0x63, 0xc8,
-	0x84, 0x72, 0xaa, 0x93, 0x2a, 0x21, 0x77, 0x8c, 0xa8, 0xc3, 0x6e, 0x45, 0x64, 0x25, 0x6f, 0x16,
-	0x86, 0x0e, 0xa0, 0xc4, 0x82, 0x11, 0xff, 0x09, 0xcf, 0x88, 0x24, 0x5e, 0xb6, 0xd2, 0xff, 0xce,
-	0x1f, 0x45, 0xb8, 0x77, 0x2e, 0x84, 0x40, 0xdf, 0x40, 0x51, 0xc1, 0xa9, 0x3c, 0x07, 0x5b, 0xa4,
-	0x52, 0xb4, 0x54, 0x8e, 0x24, 0x00, 0x3d, 0x85, 0x92, 0x33, 0xc1, 0xd4, 0xb7, 0x69, 0xbc, 0x5f,
-	0x65, 0xb3, 0xb2, 0xbc, 0x6e, 0x17, 0x7b, 0x62, 0x6d, 0xd0, 0xb7, 0x8a, 0xd2, 0x38, 0x70, 0xd1,
-	0x13, 0xa8, 0x51, 0x9f, 0x72, 0x8a, 0x3d, 0xb5, 0xcb, 0x7a, 0x4d, 0x0a, 0x59, 0x55, 0xab, 0xf1,
-	0x06, 0xa3, 0x2f, 0x41, 0x6e, 0x77, 0xac, 0x65, 0xe2, 0x59, 0x90, 0x9e, 0x75, 0x61, 0x90, 0x2a,
-	0x29, 0xdf, 0x73, 0xa8, 0xe6, 0x7c, 0xa9, 0xab, 0xdf, 0x5d, 0x27, 0x9f, 0xf6, 0xa1, 0x0c, 0x1b,
-	0xf4, 0xcd, 0xa6, 0x20, 0xbf, 0xbc, 0x6e, 0x57, 0xce, 0x12, 0xac, 0x41, 0xdf, 0xaa, 0xa4, 0xc0,
-	0x03, 0x17, 0x9d, 0x41, 0x3d, 0x07, 0x2a, 0x2e, 0x05, 0xfd, 0x9e, 0x82, 0x8d, 0x2f, 0x04, 0x23,
-	0xb9, 0x10, 0x8c, 0x8b, 0xe4, 0xc6, 0x30, 0x4b, 0x02, 0xf6, 0xcd, 0xdf, 0x6d, 0xcd, 0xaa, 0xa6,
-	0x58, 0xc2, 0x8a, 0x4e, 0xa1, 0xee, 0x93, 0x05, 0xb7, 0xd3, 0x51, 0x64, 0xfa, 0xee, 0xed, 0xa6,
-	0xb7, 0x26, 0xe2, 0xb2, 0x93, 0x00, 0x3d, 0x03, 0xc8, 0x81, 0x14, 0x6f, 0x07, 0x92, 0x0b, 0x11,
-	0x54, 0x64, 0x61, 0x39, 0x94, 0xd2, 0x2d, 0xa9, 0x88, 0xb8, 0x1c, 0x95, 0x1e, 0xb4, 0xf2, 0xd3,
-	0x9a, 0x01, 0xa6, 0x83, 0x5b, 0x96, 0x1b, 0xf6, 0x49, 0x36, 0xb8, 0x59, 0xb4, 0x1a, 0xe1, 0xad,
-	0xe7, 0x08, 0x7c, 0xe8, 0x39, 0xf2, 0x0a, 0x3e, 0x5f, 0x39, 0x47, 0xd6, 0x12, 0xa4, 0xfc, 0x2a,
-	0x92, 0xdf, 0x61, 0xee, 0x60, 0x59, 0x05, 0x4a, 0x48, 0x26, 0xdd, 0x38, 0x93, 0xb7, 0x34, 0xb3,
-	0x27, 0x98, 0x4d, 0xf4, 0xbd, 0x43, 0xed, 0x68, 0x2f, 0xee, 0xc6, 0xf8, 0xf6, 0x66, 0xa7, 0x98,
-	0x4d, 0xd0, 0x43, 0x28, 0xe1, 0x30, 0x8c, 0x5d, 0xaa, 0xd2, 0xa5, 0x88, 0xc3, 0x50, 0x9a, 0xbe,
-	0x83, 0x86, 0xec, 0x82, 0xb8, 0xa7, 0x5c, 0xe2, 0xe1, 0x2b, 0xbd, 0x2e, 0x6b, 0x7d, 0xb8, 0xd1,
-	0x54, 0x7d, 0xf5, 0xca, 0x88, 0x7b, 0xea, 0x37, 0xd1, 0x53, 0xb2, 0x15, 0x64, 0x4f, 0xf5, 0x45,
-	0xa8, 0x79, 0xf6, 0x76, 0xd9, 0xd2, 0xde, 0x2d, 0x5b, 0xda, 0xfb, 0x65, 0x4b, 0x7b, 0x73, 0xd3,
-	0xda, 0x79, 0x77, 0xd3, 0xda, 0xf9, 0xeb, 0xa6, 0xb5, 0x73, 0x79, 0x32, 0xa6, 0x7c, 0x32, 0x1f,
-	0x0a, 0x01, 0xbb, 0xe9, 0xb3, 0x28, 0x7b, 0x9f, 0x85, 0xb4, 0xbb, 0xf1, 0xbc, 0x1b, 0xee, 0xca,
-	0xd4, 0x5f, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x5a, 0xd2, 0xd5, 0x93, 0xfa, 0x09, 0x00, 0x00,
+	// 1024 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0xdb, 0x36,
+	0x14, 0x8f, 0x9a, 0x36, 0x76, 0x9e, 0xe3, 0xc4, 0x61, 0xd6, 0x55, 0xcd, 0x56, 0x3b, 0x75, 0xd7,
+	0x2e, 0x18, 0x06, 0x19, 0xc9, 0x6e, 0xbb, 0x6c, 0x95, 0xdd, 0x22, 0x06, 0xb2, 0x62, 0x90, 0xb3,
+	0x1e, 0x72, 0x11, 0x68, 0x89, 0xb6, 0x89, 0xc9, 0x92, 0x20, 0xd2, 0x9a, 0xb3, 0x0f, 0xb0, 0xeb,
+	0x7a, 0x1c, 0xf6, 0x89, 0x7a, 0xec, 0x6d, 0x03, 0x06, 0x64, 0x85, 0x73, 0xdb, 0xa7, 0x18, 0x48,
+	0x51, 0xb4, 0xfc, 0x07, 0x43, 0x86, 0xde, 0x28, 0xbe, 0xf7, 0x7e, 0xef, 0xf7, 0x7e, 0x7c, 0x8f,
+	0x14, 0x3c, 0xf2, 0xa2, 0x31, 0xe1, 0xfd, 0x01, 0x6f, 0x31, 0x8e, 0x39, 0x69, 0xa5, 0x27, 0x2d,
+	0x7e, 0x15, 0x13, 0x66, 0xc5, 0x49, 0xc4, 0x23, 0xb4, 0x9f, 0x9b, 0x2d, 0x69, 0xb6, 0xd2, 0x93,
+	0xc3, 0x4f, 0x75, 0x04, 0xee, 0x7b, 0x74, 0x29, 0xe0, 0xb0, 0xae, 0xad, 0x72, 0x57, 0x98, 0x63,
+	0x9c, 0xe0, 0x71, 0x6e, 0x7f, 0xb4, 0x6a, 0x2f, 0x86, 0x3f, 0x5e, 0x35, 0xa7, 0x38, 0xa0, 0x3e,
+	0xe6, 0x51, 0xa2, 0x5c, 0x1a, 0xda, 0x25, 0x25, 0x09, 0xa3, 0x51, 0xb8, 0x8c, 0xf1, 0xd1, 0x30,
+	0x1a, 0x46, 0x72, 0xd9, 0x12, 0xab, 0x3c, 0x6c, 0x18, 0x45, 0xc3, 0x80, 0xb4, 0xe4, 0x57, 0x7f,
+	0x32, 0x68, 0x71, 0x3a, 0x26, 0x8c, 0xe3, 0x71, 0x9c, 0x33, 0x5f, 0x76, 0xf0, 0x27, 0x09, 0xe6,
+	0x34, 0x0a, 0x33, 0x7b, 0xf3, 0xbd, 0x01, 0x07, 0xe7, 0x64, 0x88, 0xbd, 0xab, 0xe7, 0x76, 0xbb,
+	0xeb, 0x10, 0x16, 0x47, 0x21, 0x23, 0x0c, 0x7d, 0x03, 0x15, 0x9f, 0x04, 0x34, 0x25, 0x89, 0xcb,
+	0xa7, 0xcc, 0x34, 0x8e, 0x36, 0x8f, 0x2b, 0xa7, 0x75, 0x4b, 0x0b, 0x27, 0x54, 0xb2, 0xd2, 0x13,
+	0xeb, 0xc5, 0x94, 0x78, 0x17, 0x53, 0x87, 0xb0, 0x49, 0xc0, 0x1d, 0x50, 0x21, 0x17, 0x53, 0x86,
+	0xbe, 0x85, 0x6d, 0x12, 0xfa, 0x6e, 0x3f, 0x88, 0xbc, 0x1f, 0xcd, 0x3b, 0x47, 0xc6, 0x71, 0xe5,
+	0xf4, 0x89, 0xb5, 0xa2, 0xbb, 0x95, 0x67, 0x7c, 0x11, 0xfa, 0xb6, 0x70, 0x75, 0xca, 0x44, 0xad,
+	0xd0, 0x4b, 0xa8, 0xf4, 0xc9, 0x90, 0x86, 0x0a, 0x63, 0x53, 0x62, 0x3c, 0xfd, 0x0f, 0x0c, 0x5b,
+	0x78, 0x67, 0x28, 0xd0, 0xd7, 0xeb, 0xa6, 0x0b, 0x68, 0xd5, 0x03, 0x75, 0x61, 0x8b, 0xa4, 0x24,
+	0xe4, 0x79, 0x6d, 0x0f, 0xd6, 0xd4, 0x26, 0xec, 0xb6, 0xf9, 0xf6, 0xba, 0xb1, 0xf1, 0xcf, 0x75,
+	0xa3, 0x96, 0xb9, 0x7f, 0x19, 0x8d, 0x29, 0x27, 0xe3, 0x98, 0x5f, 0x39, 0x0a, 0xa0, 0xf9, 0xeb,
+	0x1d, 0xa8, 0x2d, 0xd7, 0x81, 0x2e, 0x60, 0x5f, 0x9f, 0xb1, 0x3b, 0x89, 0x7d, 0xcc, 0x49, 0x9e,
+	0xea, 0xf1, 0x6a, 0xaa, 0xd7, 0xb9, 0xeb, 0x0f, 0xd2, 0xd3, 0xbe, 0x2b, 0x92, 0x3a, 0xb5, 0x74,
+	0x71, 0x9b, 0xa1, 0x4b, 0x78, 0xe0, 0x89, 0x34, 0x21, 0x9b, 0x30, 0x57, 0xb6, 0xa0, 0xc6, 0xce,
+	0x34, 0x6e, 0xce, 0xb1, 0xb3, 0xee, 0x49, 0x4f, 0xac, 0x76, 0x1e, 0xf1, 0xbd, 0x08, 0x60, 0xce,
+	0x7d, 0x6f, 0x61, 0x23, 0xc7, 0x9e, 0x2b, 0xb2, 0xf9, 0xa1, 0x8a, 0xfc, 0x62, 0xc0, 0xae, 0x2e,
+	0x89, 0x75, 0xc3, 0x41, 0x84, 0x3a, 0x50, 0x9d, 0xeb, 0xc1, 0x08, 0x37, 0x0d, 0xc9, 0xb7, 0xb1,
+	0x86, 0xaf, 0x8e, 0xec, 0x11, 0xee, 0xec, 0xa4, 0x85, 0x2f, 0x64, 0xc1, 0x41, 0x80, 0x19, 0x77,
+	0x47, 0x84, 0x0e, 0x47, 0xdc, 0xf5, 0x46, 0x38, 0x1c, 0x12, 0x5f, 0xd6, 0xbe, 0xe9, 0xec, 0x0b,
+	0xd3, 0x99, 0xb4, 0xb4, 0x33, 0x43, 0xf3, 0x77, 0x03, 0x0e, 0x96, 0xca, 0x97, 0x6c, 0x7a, 0x50,
+	0x5b, 0xd2, 0x91, 0x99, 0xc6, 0x6d, 0x05, 0x54, 0xa7, 0xb3, 0xb7, 0x28, 0x23, 0xfb, 0xdf, 0xe4,
+	0xfe, 0x30, 0x60, 0x7f, 0x61, 0xea, 0x24, 0xb5, 0x4b, 0xb8, 0x1f, 0xc8, 0x81, 0x74, 0x85, 0xea,
+	0x6e, 0x92, 0x1b, 0x15, 0xbf, 0x67, 0x6b, 0x06, 0x60, 0xcd, 0x00, 0x3b, 0x07, 0x19, 0xc8, 0xf3,
+	0xbe, 0x47, 0xe7, 0x53, 0xfd, 0x31, 0x6c, 0x65, 0xe4, 0x14, 0x29, 0xf5, 0x85, 0x5e, 0xc1, 0xee,
+	0x80, 0x86, 0x38, 0xa0, 0x3f, 0x93, 0x85, 0x69, 0xfb, 0x7c, 0xb5, 0x05, 0x5e, 0x2a, 0xbf, 0x6c,
+	0xce, 0x14, 0xb2, 0x53, 0x1d, 0x14, 0xb7, 0x9b, 0x14, 0x4a, 0xaf, 0xb3, 0x6b, 0x0c, 0xd9, 0xb0,
+	0xad, 0x75, 0x52, 0x25, 0x14, 0xae, 0x11, 0x75, 0xd9, 0x2d, 0x88, 0xac, 0xe4, 0x9d, 0x87, 0xa1,
+	0x43, 0x28, 0xb3, 0x68, 0xc0, 0x7f, 0xc2, 0x09, 0x91, 0xc4, 0xb7, 0x1d, 0xfd, 0xdd, 0xfc, 0xab,
+	0x04, 0xf7, 0x7a, 0x42, 0x08, 0xf4, 0x35, 0x94, 0x14, 0x9c, 0xca, 0x73, 0xb8, 0x46, 0x2a, 0x45,
+	0x4b, 0xe5, 0xc8, 0x03, 0xd0, 0x33, 0x28, 0x7b, 0x23, 0x4c, 0x43, 0x97, 0x66, 0xe7, 0xb5, 0x6d,
+	0x57, 0x66, 0xd7, 0x8d, 0x52, 0x5b, 0xec, 0x75, 0x3b, 0x4e, 0x49, 0x1a, 0xbb, 0x3e, 0x7a, 0x0a,
+	0xbb, 0x34, 0xa4, 0x9c, 0xe2, 0x40, 0x9d, 0xb2, 0xb9, 0x2b, 0x85, 0xac, 0xaa, 0xdd, 0xec, 0x80,
+	0xd1, 0x17, 0x20, 0x8f, 0x3b, 0xd3, 0x32, 0xf7, 0xdc, 0x94, 0x9e, 0x7b, 0xc2, 0x20, 0x55, 0x52,
+	0xbe, 0x3d, 0xa8, 0x16, 0x7c, 0xa9, 0x6f, 0xde, 0x5d, 0x26, 0xaf, 0xfb, 0x50, 0x86, 0x75, 0x3b,
+	0xf6, 0x81, 0x20, 0x3f, 0xbb, 0x6e, 0x54, 0xce, 0x73, 0xac, 0x6e, 0xc7, 0xa9, 0x68, 0xe0, 0xae,
+	0x8f, 0xce, 0x61, 0xaf, 0x00, 0x2a, 0x1e, 0x05, 0xf3, 0x9e, 0x82, 0xcd, 0x1e, 0x04, 0x2b, 0x7f,
+	0x10, 0xac, 0x8b, 0xfc, 0xc5, 0xb0, 0xcb, 0x02, 0xf6, 0xcd, 0xdf, 0x0d, 0xc3, 0xa9, 0x6a, 0x2c,
+	0x61, 0x45, 0x67, 0xb0, 0x17, 0x92, 0x29, 0x77, 0xf5, 0x28, 0x32, 0x73, 0xeb, 0x76, 0xd3, 0xbb,
+	0x2b, 0xe2, 0xf4, 0x8e, 0x78, 0x56, 0xa0, 0x00, 0x52, 0xba, 0x1d, 0x48, 0x21, 0x44, 0x50, 0x91,
+	0x85, 0x15, 0x50, 0xca, 0xb7, 0xa4, 0x22, 0xe2, 0x0a, 0x54, 0xda, 0x50, 0x2f, 0x4e, 0xeb, 0x1c,
+	0x50, 0x0f, 0xee, 0xb6, 0x3c, 0xb0, 0x4f, 0xe6, 0x83, 0x3b, 0x8f, 0x56, 0x23, 0xbc, 0xf6, 0x1e,
+	0x81, 0x0f, 0xbd, 0x47, 0x5e, 0xc1, 0x67, 0x0b, 0xf7, 0xc8, 0x52, 0x02, 0xcd, 0xaf, 0x22, 0xf9,
+	0x1d, 0x15, 0x2e, 0x96, 0x45, 0xa0, 0x9c, 0x64, 0xde, 0x8d, 0x89, 0x7c, 0xa5, 0x99, 0x3b, 0xc2,
+	0x6c, 0x64, 0xee, 0x1c, 0x19, 0xc7, 0x3b, 0x59, 0x37, 0x66, 0xaf, 0x37, 0x3b, 0xc3, 0x6c, 0x84,
+	0x1e, 0x42, 0x19, 0xc7, 0x71, 0xe6, 0x52, 0x95, 0x2e, 0x25, 0x1c, 0xc7, 0xd2, 0xf4, 0x1d, 0xd4,
+	0x64, 0x17, 0x64, 0x3d, 0xe5, 0x93, 0x00, 0x5f, 0x99, 0x7b, 0xb2, 0xd6, 0x87, 0x2b, 0x4d, 0xd5,
+	0x51, 0x7f, 0x19, 0x59, 0x4f, 0xfd, 0x26, 0x7a, 0x4a, 0xb6, 0x82, 0xec, 0xa9, 0x8e, 0x08, 0x45,
+	0x4f, 0xa0, 0x1a, 0x27, 0x51, 0x1c, 0x31, 0x22, 0xde, 0x03, 0xe2, 0x9b, 0x35, 0x99, 0x6e, 0x27,
+	0xdf, 0xec, 0x11, 0xe2, 0xdb, 0xe7, 0x6f, 0x67, 0x75, 0xe3, 0xdd, 0xac, 0x6e, 0xbc, 0x9f, 0xd5,
+	0x8d, 0x37, 0x37, 0xf5, 0x8d, 0x77, 0x37, 0xf5, 0x8d, 0x3f, 0x6f, 0xea, 0x1b, 0x97, 0xa7, 0x43,
+	0xca, 0x47, 0x93, 0xbe, 0x50, 0xb9, 0xa5, 0xff, 0x9d, 0xf4, 0x02, 0xc7, 0xb4, 0xb5, 0xf2, 0x0f,
+	0xd8, 0xdf, 0x92, 0xfc, 0xbe, 0xfa, 0x77, 0x00, 0x7b, 0x5b, 0x16, 0xf1, 0x1f, 0x0a, 0x00, 0x00,
 }
 
 func (m *LegacyABCIResponses) Marshal() (dAtA []byte, err error) {
@@ -1028,6 +1039,15 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposerSeed) > 0 {
+		i -= len(m.ProposerSeed)
+		copy(dAtA[i:], m.ProposerSeed)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProposerSeed)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NextBlockDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NextBlockDelay):])
 	if err9 != nil {
 		return 0, err9
@@ -1345,6 +1365,10 @@ func (m *State) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NextBlockDelay)
 	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.ProposerSeed)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2689,6 +2713,40 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerSeed = append(m.ProposerSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerSeed == nil {
+				m.ProposerSeed = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,1647 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/store/v1/archive.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	v11 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	v12 "github.com/cometbft/cometbft/api/cometbft/state/v1"
+	v1 "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ArchiveRecord is a record of a block store archive.
+//
+// An archive is a sequence of length-delimited records: a header, the blocks
+// in ascending order of height, and a footer.
+type ArchiveRecord struct {
+	// Types that are valid to be assigned to Sum:
+	//
+	//	*ArchiveRecord_Header
+	//	*ArchiveRecord_Block
+	//	*ArchiveRecord_Footer
+	Sum isArchiveRecord_Sum `protobuf_oneof:"sum"`
+}
+
+func (m *ArchiveRecord) Reset()         { *m = ArchiveRecord{} }
+func (m *ArchiveRecord) String() string { return proto.CompactTextString(m) }
+func (*ArchiveRecord) ProtoMessage()    {}
+func (*ArchiveRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d78c11878449a87, []int{0}
+}
+func (m *ArchiveRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchiveRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveRecord.Merge(m, src)
+}
+func (m *ArchiveRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveRecord proto.InternalMessageInfo
+
+type isArchiveRecord_Sum interface {
+	isArchiveRecord_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ArchiveRecord_Header struct {
+	Header *ArchiveHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof" json:"header,omitempty"`
+}
+type ArchiveRecord_Block struct {
+	Block *ArchiveBlock `protobuf:"bytes,2,opt,name=block,proto3,oneof" json:"block,omitempty"`
+}
+type ArchiveRecord_Footer struct {
+	Footer *ArchiveFooter `protobuf:"bytes,3,opt,name=footer,proto3,oneof" json:"footer,omitempty"`
+}
+
+func (*ArchiveRecord_Header) isArchiveRecord_Sum() {}
+func (*ArchiveRecord_Block) isArchiveRecord_Sum()  {}
+func (*ArchiveRecord_Footer) isArchiveRecord_Sum() {}
+
+func (m *ArchiveRecord) GetSum() isArchiveRecord_Sum {
+	if m != nil {
+		return m.Sum
+	}
+	return nil
+}
+
+func (m *ArchiveRecord) GetHeader() *ArchiveHeader {
+	if x, ok := m.GetSum().(*ArchiveRecord_Header); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (m *ArchiveRecord) GetBlock() *ArchiveBlock {
+	if x, ok := m.GetSum().(*ArchiveRecord_Block); ok {
+		return x.Block
+	}
+	return nil
+}
+
+func (m *ArchiveRecord) GetFooter() *ArchiveFooter {
+	if x, ok := m.GetSum().(*ArchiveRecord_Footer); ok {
+		return x.Footer
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ArchiveRecord) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ArchiveRecord_Header)(nil),
+		(*ArchiveRecord_Block)(nil),
+		(*ArchiveRecord_Footer)(nil),
+	}
+}
+
+// ArchiveHeader is the first record of an archive.
+type ArchiveHeader struct {
+	// The version of the archive format.
+	Version    uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ChainId    string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	FromHeight int64  `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64  `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *ArchiveHeader) Reset()         { *m = ArchiveHeader{} }
+func (m *ArchiveHeader) String() string { return proto.CompactTextString(m) }
+func (*ArchiveHeader) ProtoMessage()    {}
+func (*ArchiveHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d78c11878449a87, []int{1}
+}
+func (m *ArchiveHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchiveHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveHeader.Merge(m, src)
+}
+func (m *ArchiveHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveHeader proto.InternalMessageInfo
+
+func (m *ArchiveHeader) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ArchiveHeader) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ArchiveHeader) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *ArchiveHeader) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// ArchiveBlock holds everything stored for the block at a height.
+type ArchiveBlock struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// The header of the part set of the block, and its parts.
+	PartSetHeader *v1.PartSetHeader `protobuf:"bytes,2,opt,name=part_set_header,json=partSetHeader,proto3" json:"part_set_header,omitempty"`
+	Parts         []*v1.Part        `protobuf:"bytes,3,rep,name=parts,proto3" json:"parts,omitempty"`
+	// The commit seen for the block, and the extended commit if vote
+	// extensions were enabled at its height.
+	SeenCommit     *v1.Commit         `protobuf:"bytes,4,opt,name=seen_commit,json=seenCommit,proto3" json:"seen_commit,omitempty"`
+	ExtendedCommit *v1.ExtendedCommit `protobuf:"bytes,5,opt,name=extended_commit,json=extendedCommit,proto3" json:"extended_commit,omitempty"`
+	// The response of the application to FinalizeBlock, if retained.
+	FinalizeBlockResponse *v11.FinalizeBlockResponse `protobuf:"bytes,6,opt,name=finalize_block_response,json=finalizeBlockResponse,proto3" json:"finalize_block_response,omitempty"`
+	// The validator set of the block, if retained. It is only trusted once
+	// its hash matches the NextValidatorsHash of a verified previous block.
+	ValidatorSet *v1.ValidatorSet `protobuf:"bytes,7,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+}
+
+func (m *ArchiveBlock) Reset()         { *m = ArchiveBlock{} }
+func (m *ArchiveBlock) String() string { return proto.CompactTextString(m) }
+func (*ArchiveBlock) ProtoMessage()    {}
+func (*ArchiveBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d78c11878449a87, []int{2}
+}
+func (m *ArchiveBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchiveBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveBlock.Merge(m, src)
+}
+func (m *ArchiveBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveBlock proto.InternalMessageInfo
+
+func (m *ArchiveBlock) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ArchiveBlock) GetPartSetHeader() *v1.PartSetHeader {
+	if m != nil {
+		return m.PartSetHeader
+	}
+	return nil
+}
+
+func (m *ArchiveBlock) GetParts() []*v1.Part {
+	if m != nil {
+		return m.Parts
+	}
+	return nil
+}
+
+func (m *ArchiveBlock) GetSeenCommit() *v1.Commit {
+	if m != nil {
+		return m.SeenCommit
+	}
+	return nil
+}
+
+func (m *ArchiveBlock) GetExtendedCommit() *v1.ExtendedCommit {
+	if m != nil {
+		return m.ExtendedCommit
+	}
+	return nil
+}
+
+func (m *ArchiveBlock) GetFinalizeBlockResponse() *v11.FinalizeBlockResponse {
+	if m != nil {
+		return m.FinalizeBlockResponse
+	}
+	return nil
+}
+
+func (m *ArchiveBlock) GetValidatorSet() *v1.ValidatorSet {
+	if m != nil {
+		return m.ValidatorSet
+	}
+	return nil
+}
+
+// ArchiveFooter is the last record of an archive.
+type ArchiveFooter struct {
+	// The number of blocks in the archive.
+	NumBlocks int64 `protobuf:"varint,1,opt,name=num_blocks,json=numBlocks,proto3" json:"num_blocks,omitempty"`
+	// The state after the last block of the archive.
+	State *v12.State `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// The SHA-256 checksum of the encoding of all the previous records.
+	Checksum []byte `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *ArchiveFooter) Reset()         { *m = ArchiveFooter{} }
+func (m *ArchiveFooter) String() string { return proto.CompactTextString(m) }
+func (*ArchiveFooter) ProtoMessage()    {}
+func (*ArchiveFooter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d78c11878449a87, []int{3}
+}
+func (m *ArchiveFooter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveFooter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveFooter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchiveFooter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveFooter.Merge(m, src)
+}
+func (m *ArchiveFooter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveFooter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveFooter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveFooter proto.InternalMessageInfo
+
+func (m *ArchiveFooter) GetNumBlocks() int64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
+
+func (m *ArchiveFooter) GetState() *v12.State {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *ArchiveFooter) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ArchiveRecord)(nil), "cometbft.store.v1.ArchiveRecord")
+	proto.RegisterType((*ArchiveHeader)(nil), "cometbft.store.v1.ArchiveHeader")
+	proto.RegisterType((*ArchiveBlock)(nil), "cometbft.store.v1.ArchiveBlock")
+	proto.RegisterType((*ArchiveFooter)(nil), "cometbft.store.v1.ArchiveFooter")
+}
+
+func init() { proto.RegisterFile("cometbft/store/v1/archive.proto", fileDescriptor_4d78c11878449a87) }
+
+var fileDescriptor_4d78c11878449a87 = []byte{
+	// 579 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xe3, 0x9a, 0xf4, 0x63, 0xd3, 0x50, 0xb1, 0x12, 0xd4, 0x2d, 0xd4, 0x6d, 0x73, 0xa1,
+	0x17, 0x1c, 0x35, 0x1c, 0x90, 0x7a, 0x23, 0x40, 0x15, 0x10, 0x07, 0xb4, 0x91, 0x38, 0x70, 0xb1,
+	0x36, 0xf6, 0xa4, 0x5e, 0x35, 0xf6, 0x5a, 0xbb, 0x1b, 0x0b, 0x7a, 0xe4, 0x09, 0x78, 0x2b, 0x38,
+	0xa1, 0x1e, 0x39, 0xa2, 0xe4, 0x45, 0xd0, 0xee, 0x3a, 0x6e, 0x9a, 0x0f, 0x71, 0xf3, 0xcc, 0xfc,
+	0xfe, 0xb3, 0x33, 0xbb, 0x33, 0x46, 0xc7, 0x11, 0x4f, 0x41, 0x0d, 0x86, 0xaa, 0x2d, 0x15, 0x17,
+	0xd0, 0x2e, 0xce, 0xdb, 0x54, 0x44, 0x09, 0x2b, 0x20, 0xc8, 0x05, 0x57, 0x1c, 0x3f, 0x9a, 0x01,
+	0x81, 0x01, 0x82, 0xe2, 0xfc, 0xf0, 0x59, 0xa5, 0xa1, 0x83, 0x88, 0x69, 0x89, 0xfa, 0x96, 0x83,
+	0xb4, 0x82, 0xc3, 0xa3, 0xb9, 0x8c, 0x54, 0xc1, 0xfa, 0xb0, 0xf1, 0x2e, 0x86, 0x4f, 0x97, 0xc3,
+	0x05, 0x1d, 0xb1, 0x98, 0x2a, 0x2e, 0x2c, 0xd2, 0xfa, 0xe9, 0xa0, 0xe6, 0x6b, 0x5b, 0x23, 0x81,
+	0x88, 0x8b, 0x18, 0x5f, 0xa0, 0xcd, 0x04, 0x68, 0x0c, 0xc2, 0x73, 0x4e, 0x9c, 0xb3, 0x46, 0xe7,
+	0x24, 0x58, 0x2a, 0x3a, 0x28, 0x15, 0x3d, 0xc3, 0xf5, 0x6a, 0xa4, 0x54, 0xe0, 0x57, 0xa8, 0x3e,
+	0x18, 0xf1, 0xe8, 0xda, 0xdb, 0x30, 0xd2, 0xe3, 0xf5, 0xd2, 0xae, 0xc6, 0x7a, 0x35, 0x62, 0x79,
+	0x7d, 0xe8, 0x90, 0x73, 0x05, 0xc2, 0x73, 0xff, 0x77, 0xe8, 0xa5, 0xe1, 0xf4, 0xa1, 0x56, 0xd1,
+	0xad, 0x23, 0x57, 0x8e, 0xd3, 0xd6, 0xf7, 0xbb, 0x4e, 0x6c, 0x5d, 0xd8, 0x43, 0x5b, 0x05, 0x08,
+	0xc9, 0x78, 0x66, 0x5a, 0x69, 0x92, 0x99, 0x89, 0x0f, 0xd0, 0x76, 0x94, 0x50, 0x96, 0x85, 0x2c,
+	0x36, 0xa5, 0xee, 0x90, 0x2d, 0x63, 0xbf, 0x8f, 0xf1, 0x31, 0x6a, 0x0c, 0x05, 0x4f, 0xc3, 0x04,
+	0xd8, 0x55, 0xa2, 0x4c, 0x39, 0x2e, 0x41, 0xda, 0xd5, 0x33, 0x1e, 0xfc, 0x14, 0xed, 0x28, 0x3e,
+	0x0b, 0x3f, 0x30, 0xe1, 0x6d, 0xc5, 0x6d, 0xb0, 0xf5, 0xdb, 0x45, 0xbb, 0xf3, 0x1d, 0xe2, 0x27,
+	0xfa, 0x36, 0x0d, 0xea, 0x18, 0xb4, 0xb4, 0x70, 0x0f, 0xed, 0xe5, 0x54, 0xa8, 0x50, 0x82, 0x0a,
+	0xcb, 0xeb, 0xde, 0x58, 0xec, 0xdc, 0x3e, 0x65, 0x71, 0x1e, 0x7c, 0xa2, 0x42, 0xf5, 0x41, 0xd9,
+	0xb6, 0x48, 0x33, 0x9f, 0x37, 0xf1, 0x0b, 0x54, 0xd7, 0x0e, 0xe9, 0xb9, 0x27, 0xee, 0x59, 0xa3,
+	0xb3, 0xbf, 0x46, 0x4f, 0x2c, 0x85, 0x2f, 0x50, 0x43, 0x02, 0x64, 0x61, 0xc4, 0xd3, 0x94, 0xd9,
+	0x06, 0x1a, 0x9d, 0x83, 0x15, 0xa2, 0x37, 0x06, 0x20, 0x48, 0xd3, 0xf6, 0x1b, 0x7f, 0x40, 0x7b,
+	0xf0, 0x55, 0x41, 0x16, 0x43, 0x3c, 0xd3, 0xd7, 0x8d, 0xfe, 0x74, 0x85, 0xfe, 0x5d, 0x49, 0x96,
+	0x79, 0x1e, 0xc2, 0x3d, 0x1b, 0x87, 0x68, 0x7f, 0xc8, 0x32, 0x3a, 0x62, 0x37, 0x10, 0x9a, 0x19,
+	0x08, 0x05, 0xc8, 0x9c, 0x67, 0x12, 0xbc, 0x4d, 0x93, 0xf3, 0xf9, 0x5d, 0x4e, 0xbd, 0x19, 0x3a,
+	0xe5, 0x65, 0x29, 0x30, 0x57, 0x4b, 0x4a, 0x9c, 0x3c, 0x1e, 0xae, 0x72, 0xe3, 0xb7, 0xa8, 0x59,
+	0x0d, 0xbb, 0xbe, 0x66, 0x6f, 0x6b, 0x71, 0x26, 0xab, 0x52, 0x3f, 0xcf, 0xb8, 0x3e, 0x28, 0xb2,
+	0x5b, 0xcc, 0x59, 0xad, 0x1b, 0xd4, 0xbc, 0x37, 0x77, 0xf8, 0x08, 0xa1, 0x6c, 0x9c, 0xda, 0x92,
+	0x65, 0xf9, 0xa8, 0x3b, 0xd9, 0x38, 0x35, 0x87, 0x4b, 0x1c, 0xa0, 0xba, 0xd9, 0xd4, 0xf2, 0x35,
+	0xbd, 0xf9, 0x39, 0xa6, 0xca, 0xcc, 0x71, 0x5f, 0x7f, 0x10, 0x8b, 0xe1, 0x43, 0x3d, 0x89, 0x10,
+	0x5d, 0xcb, 0x71, 0x6a, 0x66, 0x6d, 0x97, 0x54, 0x76, 0xf7, 0xe3, 0xaf, 0x89, 0xef, 0xdc, 0x4e,
+	0x7c, 0xe7, 0xef, 0xc4, 0x77, 0x7e, 0x4c, 0xfd, 0xda, 0xed, 0xd4, 0xaf, 0xfd, 0x99, 0xfa, 0xb5,
+	0x2f, 0x9d, 0x2b, 0xa6, 0x92, 0xf1, 0x40, 0x27, 0x6f, 0x57, 0x3b, 0x5e, 0x7d, 0xd0, 0x9c, 0xb5,
+	0x97, 0xfe, 0x44, 0x83, 0x4d, 0xb3, 0xf0, 0x2f, 0xff, 0x0d, 0x00, 0x5f, 0x7a, 0xe0, 0x53, 0xa5,
+	0x04, 0x00, 0x00,
+}
+
+func (m *ArchiveRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveRecord_Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveRecord_Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ArchiveRecord_Block) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveRecord_Block) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ArchiveRecord_Footer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveRecord_Footer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Footer != nil {
+		{
+			size, err := m.Footer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ArchiveHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorSet != nil {
+		{
+			size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.FinalizeBlockResponse != nil {
+		{
+			size, err := m.FinalizeBlockResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExtendedCommit != nil {
+		{
+			size, err := m.ExtendedCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SeenCommit != nil {
+		{
+			size, err := m.SeenCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Parts) > 0 {
+		for iNdEx := len(m.Parts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintArchive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PartSetHeader != nil {
+		{
+			size, err := m.PartSetHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveFooter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveFooter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveFooter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NumBlocks != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintArchive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovArchive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ArchiveRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *ArchiveRecord_Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	return n
+}
+func (m *ArchiveRecord_Block) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	return n
+}
+func (m *ArchiveRecord_Footer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Footer != nil {
+		l = m.Footer.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	return n
+}
+func (m *ArchiveHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovArchive(uint64(m.Version))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovArchive(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovArchive(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *ArchiveBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovArchive(uint64(m.Height))
+	}
+	if m.PartSetHeader != nil {
+		l = m.PartSetHeader.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if len(m.Parts) > 0 {
+		for _, e := range m.Parts {
+			l = e.Size()
+			n += 1 + l + sovArchive(uint64(l))
+		}
+	}
+	if m.SeenCommit != nil {
+		l = m.SeenCommit.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.ExtendedCommit != nil {
+		l = m.ExtendedCommit.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.FinalizeBlockResponse != nil {
+		l = m.FinalizeBlockResponse.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.ValidatorSet != nil {
+		l = m.ValidatorSet.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	return n
+}
+
+func (m *ArchiveFooter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumBlocks != 0 {
+		n += 1 + sovArchive(uint64(m.NumBlocks))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	return n
+}
+
+func sovArchive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozArchive(x uint64) (n int) {
+	return sovArchive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ArchiveRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ArchiveHeader{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ArchiveRecord_Header{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ArchiveBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ArchiveRecord_Block{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Footer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ArchiveFooter{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ArchiveRecord_Footer{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchiveHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchiveBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartSetHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartSetHeader == nil {
+				m.PartSetHeader = &v1.PartSetHeader{}
+			}
+			if err := m.PartSetHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parts = append(m.Parts, &v1.Part{})
+			if err := m.Parts[len(m.Parts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeenCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SeenCommit == nil {
+				m.SeenCommit = &v1.Commit{}
+			}
+			if err := m.SeenCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtendedCommit == nil {
+				m.ExtendedCommit = &v1.ExtendedCommit{}
+			}
+			if err := m.ExtendedCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeBlockResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalizeBlockResponse == nil {
+				m.FinalizeBlockResponse = &v11.FinalizeBlockResponse{}
+			}
+			if err := m.FinalizeBlockResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorSet == nil {
+				m.ValidatorSet = &v1.ValidatorSet{}
+			}
+			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchiveFooter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveFooter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveFooter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &v12.State{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipArchive(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthArchive
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupArchive
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthArchive
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthArchive        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowArchive          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupArchive = fmt.Errorf("proto: unexpected end of group")
+)
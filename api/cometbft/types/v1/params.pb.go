@@ -264,6 +264,9 @@ func (m *EvidenceParams) GetMaxBytes() int64 {
 // NOTE: uses ABCI public keys naming, not Amino names.
 type ValidatorParams struct {
 	PubKeyTypes []string `protobuf:"bytes,1,rep,name=pub_key_types,json=pubKeyTypes,proto3" json:"pub_key_types,omitempty"`
+	// Name of the algorithm used to select the proposer of each round, e.g.
+	// "weighted_round_robin" (the default, if empty) or "verifiable_random".
+	ProposerSelection string `protobuf:"bytes,2,opt,name=proposer_selection,json=proposerSelection,proto3" json:"proposer_selection,omitempty"`
 }
 
 func (m *ValidatorParams) Reset()         { *m = ValidatorParams{} }
@@ -306,6 +309,13 @@ func (m *ValidatorParams) GetPubKeyTypes() []string {
 	return nil
 }
 
+func (m *ValidatorParams) GetProposerSelection() string {
+	if m != nil {
+		return m.ProposerSelection
+	}
+	return ""
+}
+
 // VersionParams contain the version of specific components of CometBFT.
 type VersionParams struct {
 	// The ABCI application version.
@@ -608,53 +618,54 @@ func init() {
 func init() { proto.RegisterFile("cometbft/types/v1/params.proto", fileDescriptor_8c2f6d19461b2fe7) }
 
 var fileDescriptor_8c2f6d19461b2fe7 = []byte{
-	// 729 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xbf, 0x4e, 0xdb, 0x50,
-	0x14, 0xc6, 0x73, 0xe3, 0x00, 0xc9, 0x09, 0x21, 0xe9, 0x55, 0xa5, 0xba, 0x20, 0x1c, 0xea, 0xa1,
-	0x42, 0x42, 0xb2, 0x05, 0xa5, 0x1d, 0x90, 0x50, 0x4b, 0x80, 0x02, 0xad, 0x68, 0x91, 0xa9, 0x18,
-	0x58, 0xac, 0xeb, 0xe4, 0xe2, 0x58, 0xc4, 0x7f, 0xe4, 0x6b, 0xa7, 0xc9, 0x5b, 0x74, 0xaa, 0x3a,
-	0x32, 0xb6, 0x6f, 0xd0, 0xbe, 0x40, 0xc5, 0xc8, 0xd8, 0x89, 0x56, 0x61, 0xe9, 0x63, 0x54, 0xbe,
-	0xb6, 0x13, 0x12, 0x42, 0x61, 0xb3, 0x7d, 0xbe, 0xdf, 0xe7, 0xef, 0x9e, 0x73, 0x6c, 0x90, 0xea,
-	0xae, 0x4d, 0x03, 0xe3, 0x24, 0x50, 0x83, 0xae, 0x47, 0x99, 0xda, 0x5e, 0x56, 0x3d, 0xe2, 0x13,
-	0x9b, 0x29, 0x9e, 0xef, 0x06, 0x2e, 0x7e, 0x90, 0xd6, 0x15, 0x5e, 0x57, 0xda, 0xcb, 0xb3, 0x0f,
-	0x4d, 0xd7, 0x74, 0x79, 0x55, 0x8d, 0xae, 0x62, 0xe1, 0xac, 0x64, 0xba, 0xae, 0xd9, 0xa2, 0x2a,
-	0xbf, 0x33, 0xc2, 0x13, 0xb5, 0x11, 0xfa, 0x24, 0xb0, 0x5c, 0xe7, 0xb6, 0xfa, 0x47, 0x9f, 0x78,
-	0x1e, 0xf5, 0x93, 0x17, 0xc9, 0x3f, 0x04, 0x28, 0x6f, 0xba, 0x0e, 0xa3, 0x0e, 0x0b, 0xd9, 0x01,
-	0x8f, 0x80, 0x57, 0x61, 0xc2, 0x68, 0xb9, 0xf5, 0x53, 0x11, 0x2d, 0xa0, 0xc5, 0xe2, 0x8a, 0xa4,
-	0xdc, 0x08, 0xa3, 0xd4, 0xa2, 0x7a, 0x2c, 0xd7, 0x62, 0x31, 0x5e, 0x87, 0x3c, 0x6d, 0x5b, 0x0d,
-	0xea, 0xd4, 0xa9, 0x98, 0xe5, 0xe0, 0x93, 0x31, 0xe0, 0x76, 0x22, 0x49, 0xd8, 0x3e, 0x82, 0x5f,
-	0x41, 0xa1, 0x4d, 0x5a, 0x56, 0x83, 0x04, 0xae, 0x2f, 0x0a, 0x9c, 0x97, 0xc7, 0xf0, 0x47, 0xa9,
-	0x26, 0x31, 0x18, 0x40, 0x78, 0x0d, 0xa6, 0xda, 0xd4, 0x67, 0x96, 0xeb, 0x88, 0x39, 0xce, 0x2f,
-	0x8c, 0xe3, 0x63, 0x45, 0x42, 0xa7, 0x00, 0x7e, 0x0e, 0x39, 0x62, 0xd4, 0x2d, 0x71, 0x82, 0x83,
-	0xf3, 0x63, 0xc0, 0x8d, 0xda, 0xe6, 0x5e, 0x4c, 0xd5, 0xb2, 0x22, 0xd2, 0xb8, 0x3c, 0x0a, 0xcd,
-	0xba, 0x4e, 0xbd, 0xe9, 0xbb, 0x4e, 0x57, 0x9c, 0xbc, 0x35, 0xf4, 0x61, 0xaa, 0x49, 0x43, 0xf7,
-	0xa1, 0x28, 0xf4, 0x09, 0x25, 0x41, 0xe8, 0x53, 0x71, 0xea, 0xd6, 0xd0, 0xaf, 0x63, 0x45, 0x1a,
-	0x3a, 0x01, 0xe4, 0x3d, 0x28, 0x5e, 0x9b, 0x03, 0x9e, 0x83, 0x82, 0x4d, 0x3a, 0xba, 0xd1, 0x0d,
-	0x28, 0xe3, 0xa3, 0x13, 0xb4, 0xbc, 0x4d, 0x3a, 0xb5, 0xe8, 0x1e, 0x3f, 0x82, 0xa9, 0xa8, 0x68,
-	0x12, 0xc6, 0x87, 0x23, 0x68, 0x93, 0x36, 0xe9, 0xec, 0x10, 0xf6, 0x26, 0x97, 0x17, 0x2a, 0x39,
-	0xf9, 0x1b, 0x82, 0x99, 0xe1, 0xd1, 0xe0, 0x25, 0xc0, 0x11, 0x41, 0x4c, 0xaa, 0x3b, 0xa1, 0xad,
-	0xf3, 0x21, 0xa7, 0xbe, 0x65, 0x9b, 0x74, 0x36, 0x4c, 0xfa, 0x2e, 0xb4, 0x79, 0x00, 0x86, 0xf7,
-	0xa1, 0x92, 0x8a, 0xd3, 0x05, 0x4c, 0x96, 0xe0, 0xb1, 0x12, 0x6f, 0xa0, 0x92, 0x6e, 0xa0, 0xb2,
-	0x95, 0x08, 0x6a, 0xf9, 0xf3, 0xcb, 0x6a, 0xe6, 0xcb, 0xef, 0x2a, 0xd2, 0x66, 0x62, 0xbf, 0xb4,
-	0x32, 0x7c, 0x14, 0x61, 0xf8, 0x28, 0xf2, 0x4b, 0x28, 0x8f, 0x6c, 0x01, 0x96, 0xa1, 0xe4, 0x85,
-	0x86, 0x7e, 0x4a, 0xbb, 0x3a, 0x6f, 0x9a, 0x88, 0x16, 0x84, 0xc5, 0x82, 0x56, 0xf4, 0x42, 0xe3,
-	0x2d, 0xed, 0x7e, 0x88, 0x1e, 0xad, 0xe5, 0xbf, 0x9f, 0x55, 0xd1, 0xdf, 0xb3, 0x2a, 0x92, 0x97,
-	0xa0, 0x34, 0xb4, 0x06, 0xb8, 0x02, 0x02, 0xf1, 0x3c, 0x7e, 0xb6, 0x9c, 0x16, 0x5d, 0x5e, 0x13,
-	0x1f, 0xc3, 0xf4, 0x2e, 0x61, 0x4d, 0xda, 0x48, 0xb4, 0x4f, 0xa1, 0xcc, 0x5b, 0xa1, 0x8f, 0xf6,
-	0xba, 0xc4, 0x1f, 0xef, 0xa7, 0x0d, 0x97, 0xa1, 0x34, 0xd0, 0x0d, 0xda, 0x5e, 0x4c, 0x55, 0x3b,
-	0x84, 0xc9, 0x9f, 0x11, 0x94, 0x47, 0x76, 0x03, 0xaf, 0x43, 0xc1, 0xf3, 0x69, 0xdd, 0xe2, 0x7b,
-	0x8c, 0xee, 0x6a, 0x61, 0x8e, 0xb7, 0x6f, 0x40, 0xe0, 0x2d, 0x28, 0xd9, 0x94, 0x31, 0x3e, 0x08,
-	0xda, 0x22, 0xdd, 0xbb, 0xa7, 0x10, 0x5b, 0x4c, 0x27, 0xd4, 0x56, 0x04, 0xc9, 0x3f, 0x11, 0x94,
-	0x86, 0x96, 0x0e, 0x37, 0x60, 0xbe, 0xed, 0x06, 0x54, 0xa7, 0x9d, 0x80, 0x3a, 0xd1, 0x9b, 0x98,
-	0x4e, 0x1d, 0x62, 0xb4, 0xa8, 0xde, 0xa4, 0x96, 0xd9, 0x0c, 0x92, 0xa8, 0x73, 0x37, 0xde, 0xb3,
-	0xe7, 0x04, 0x2f, 0x56, 0x8f, 0x48, 0x2b, 0xa4, 0xb5, 0xdc, 0xf9, 0x65, 0x15, 0x69, 0xb3, 0x91,
-	0xcf, 0x76, 0xdf, 0x66, 0x9b, 0xbb, 0xec, 0x72, 0x13, 0xfc, 0x1e, 0xb0, 0x67, 0x04, 0xa3, 0xd6,
-	0xd9, 0xfb, 0x5a, 0x57, 0x22, 0xf8, 0xba, 0xa1, 0x7c, 0x08, 0x30, 0xf8, 0x70, 0xf1, 0xc6, 0x7d,
-	0x0e, 0x21, 0xfc, 0x2f, 0xe1, 0x5a, 0x56, 0x44, 0xb5, 0x83, 0xaf, 0x3d, 0x09, 0x9d, 0xf7, 0x24,
-	0x74, 0xd1, 0x93, 0xd0, 0x9f, 0x9e, 0x84, 0x3e, 0x5d, 0x49, 0x99, 0x8b, 0x2b, 0x29, 0xf3, 0xeb,
-	0x4a, 0xca, 0x1c, 0xaf, 0x98, 0x56, 0xd0, 0x0c, 0x8d, 0xe8, 0x33, 0x56, 0xfb, 0x7f, 0xf9, 0xfe,
-	0x05, 0xf1, 0x2c, 0xf5, 0xc6, 0xbf, 0xdf, 0x98, 0xe4, 0x67, 0x7a, 0xf6, 0x2f, 0x00, 0x00, 0xff,
-	0xff, 0xd6, 0x46, 0x5b, 0x10, 0x17, 0x06, 0x00, 0x00,
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xc1, 0x4e, 0xdb, 0x30,
+	0x18, 0xc7, 0xeb, 0xa6, 0x40, 0xeb, 0x52, 0x5a, 0xac, 0x49, 0xcb, 0x40, 0xa4, 0x2c, 0x87, 0x09,
+	0x09, 0x2d, 0x11, 0x8c, 0xed, 0x80, 0x84, 0x34, 0x0a, 0x0c, 0xd8, 0xc4, 0x86, 0xc2, 0xc4, 0x81,
+	0x4b, 0xe4, 0xb4, 0x26, 0x8d, 0x68, 0xe2, 0x28, 0x4e, 0xba, 0xf6, 0x2d, 0x76, 0x9a, 0x76, 0xe4,
+	0xb8, 0xbd, 0xc1, 0xf6, 0x02, 0x13, 0x47, 0x8e, 0x3b, 0xb1, 0xa9, 0x5c, 0xf6, 0x18, 0x53, 0x9c,
+	0xb8, 0xa5, 0xa5, 0x6c, 0xdc, 0x9c, 0x7c, 0xff, 0xdf, 0xdf, 0x7f, 0xfb, 0xfb, 0xa2, 0x40, 0xa5,
+	0x4e, 0x5d, 0x12, 0x5a, 0xa7, 0xa1, 0x1e, 0x76, 0x7d, 0xc2, 0xf4, 0xf6, 0x8a, 0xee, 0xe3, 0x00,
+	0xbb, 0x4c, 0xf3, 0x03, 0x1a, 0x52, 0x34, 0x2b, 0xea, 0x1a, 0xaf, 0x6b, 0xed, 0x95, 0xb9, 0x07,
+	0x36, 0xb5, 0x29, 0xaf, 0xea, 0xf1, 0x2a, 0x11, 0xce, 0x29, 0x36, 0xa5, 0x76, 0x8b, 0xe8, 0xfc,
+	0xc9, 0x8a, 0x4e, 0xf5, 0x46, 0x14, 0xe0, 0xd0, 0xa1, 0xde, 0x5d, 0xf5, 0x0f, 0x01, 0xf6, 0x7d,
+	0x12, 0xa4, 0x1b, 0xa9, 0xdf, 0x25, 0x58, 0xde, 0xa2, 0x1e, 0x23, 0x1e, 0x8b, 0xd8, 0x21, 0x8f,
+	0x80, 0xd6, 0xe0, 0x84, 0xd5, 0xa2, 0xf5, 0x33, 0x19, 0x2c, 0x82, 0xa5, 0xe2, 0xaa, 0xa2, 0xdd,
+	0x0a, 0xa3, 0xd5, 0xe2, 0x7a, 0x22, 0x37, 0x12, 0x31, 0xda, 0x80, 0x79, 0xd2, 0x76, 0x1a, 0xc4,
+	0xab, 0x13, 0x39, 0xcb, 0xc1, 0xc7, 0x63, 0xc0, 0x9d, 0x54, 0x92, 0xb2, 0x7d, 0x04, 0xbd, 0x84,
+	0x85, 0x36, 0x6e, 0x39, 0x0d, 0x1c, 0xd2, 0x40, 0x96, 0x38, 0xaf, 0x8e, 0xe1, 0x8f, 0x85, 0x26,
+	0x35, 0x18, 0x40, 0x68, 0x1d, 0x4e, 0xb5, 0x49, 0xc0, 0x1c, 0xea, 0xc9, 0x39, 0xce, 0x2f, 0x8e,
+	0xe3, 0x13, 0x45, 0x4a, 0x0b, 0x00, 0x3d, 0x87, 0x39, 0x6c, 0xd5, 0x1d, 0x79, 0x82, 0x83, 0x0b,
+	0x63, 0xc0, 0xcd, 0xda, 0xd6, 0x7e, 0x42, 0xd5, 0xb2, 0x32, 0x30, 0xb8, 0x3c, 0x0e, 0xcd, 0xba,
+	0x5e, 0xbd, 0x19, 0x50, 0xaf, 0x2b, 0x4f, 0xde, 0x19, 0xfa, 0x48, 0x68, 0x44, 0xe8, 0x3e, 0x14,
+	0x87, 0x3e, 0x25, 0x38, 0x8c, 0x02, 0x22, 0x4f, 0xdd, 0x19, 0xfa, 0x55, 0xa2, 0x10, 0xa1, 0x53,
+	0x40, 0xdd, 0x87, 0xc5, 0x1b, 0x7d, 0x40, 0xf3, 0xb0, 0xe0, 0xe2, 0x8e, 0x69, 0x75, 0x43, 0xc2,
+	0x78, 0xeb, 0x24, 0x23, 0xef, 0xe2, 0x4e, 0x2d, 0x7e, 0x46, 0x0f, 0xe1, 0x54, 0x5c, 0xb4, 0x31,
+	0xe3, 0xcd, 0x91, 0x8c, 0x49, 0x17, 0x77, 0x76, 0x31, 0x7b, 0x9d, 0xcb, 0x4b, 0x95, 0x9c, 0xfa,
+	0x15, 0xc0, 0x99, 0xe1, 0xd6, 0xa0, 0x65, 0x88, 0x62, 0x02, 0xdb, 0xc4, 0xf4, 0x22, 0xd7, 0xe4,
+	0x4d, 0x16, 0xbe, 0x65, 0x17, 0x77, 0x36, 0x6d, 0xf2, 0x36, 0x72, 0x79, 0x00, 0x86, 0x0e, 0x60,
+	0x45, 0x88, 0xc5, 0x00, 0xa6, 0x43, 0xf0, 0x48, 0x4b, 0x26, 0x50, 0x13, 0x13, 0xa8, 0x6d, 0xa7,
+	0x82, 0x5a, 0xfe, 0xe2, 0xaa, 0x9a, 0xf9, 0xfc, 0xab, 0x0a, 0x8c, 0x99, 0xc4, 0x4f, 0x54, 0x86,
+	0x8f, 0x22, 0x0d, 0x1f, 0x45, 0xf5, 0x60, 0x79, 0x64, 0x0a, 0x90, 0x0a, 0x4b, 0x7e, 0x64, 0x99,
+	0x67, 0xa4, 0x6b, 0xf2, 0x4b, 0x93, 0xc1, 0xa2, 0xb4, 0x54, 0x30, 0x8a, 0x7e, 0x64, 0xbd, 0x21,
+	0xdd, 0xf7, 0xf1, 0x2b, 0xf4, 0x14, 0x22, 0x3f, 0xa0, 0x3e, 0x65, 0x24, 0x30, 0x19, 0x69, 0x91,
+	0x7a, 0x3f, 0x64, 0xc1, 0x98, 0x15, 0x95, 0x23, 0x51, 0x58, 0xcf, 0x7f, 0x3b, 0xaf, 0x82, 0x3f,
+	0xe7, 0x55, 0xa0, 0x2e, 0xc3, 0xd2, 0xd0, 0xd4, 0xa0, 0x0a, 0x94, 0xb0, 0xef, 0xf3, 0xab, 0xc8,
+	0x19, 0xf1, 0xf2, 0x86, 0xf8, 0x04, 0x4e, 0xef, 0x61, 0xd6, 0x24, 0x8d, 0x54, 0xfb, 0x04, 0x96,
+	0xf9, 0xcd, 0x99, 0xa3, 0xad, 0x29, 0xf1, 0xd7, 0x07, 0xa2, 0x3f, 0x2a, 0x2c, 0x0d, 0x74, 0x83,
+	0x2e, 0x15, 0x85, 0x6a, 0x17, 0x33, 0xf5, 0x13, 0x80, 0xe5, 0x91, 0x51, 0x42, 0x1b, 0xb0, 0xe0,
+	0x07, 0xa4, 0xee, 0xf0, 0xb1, 0x07, 0xff, 0xbb, 0xf1, 0x1c, 0xbf, 0xed, 0x01, 0x81, 0xb6, 0x61,
+	0xc9, 0x25, 0x8c, 0xf1, 0xbe, 0x91, 0x16, 0xee, 0xca, 0xd9, 0xfb, 0x59, 0x4c, 0xa7, 0xd4, 0x76,
+	0x0c, 0xa9, 0x3f, 0x00, 0x2c, 0x0d, 0xcd, 0x28, 0x6a, 0xc0, 0x85, 0x36, 0x0d, 0x89, 0x49, 0x3a,
+	0x21, 0xf1, 0xe2, 0x9d, 0x98, 0x49, 0x3c, 0x6c, 0xb5, 0x88, 0xd9, 0x24, 0x8e, 0xdd, 0x0c, 0xd3,
+	0xa8, 0xf3, 0xb7, 0xf6, 0xd9, 0xf7, 0xc2, 0x17, 0x6b, 0xc7, 0xb8, 0x15, 0x91, 0x5a, 0xee, 0xe2,
+	0xaa, 0x0a, 0x8c, 0xb9, 0xd8, 0x67, 0xa7, 0x6f, 0xb3, 0xc3, 0x5d, 0xf6, 0xb8, 0x09, 0x7a, 0x07,
+	0x91, 0x6f, 0x85, 0xa3, 0xd6, 0xd9, 0xfb, 0x5a, 0x57, 0x62, 0xf8, 0xa6, 0xa1, 0x7a, 0x04, 0xe1,
+	0xe0, 0x3b, 0x47, 0x9b, 0xf7, 0x39, 0x84, 0xf4, 0xaf, 0x84, 0xeb, 0x59, 0x19, 0xd4, 0x0e, 0xbf,
+	0xf4, 0x14, 0x70, 0xd1, 0x53, 0xc0, 0x65, 0x4f, 0x01, 0xbf, 0x7b, 0x0a, 0xf8, 0x78, 0xad, 0x64,
+	0x2e, 0xaf, 0x95, 0xcc, 0xcf, 0x6b, 0x25, 0x73, 0xb2, 0x6a, 0x3b, 0x61, 0x33, 0xb2, 0xe2, 0xaf,
+	0x5e, 0xef, 0xff, 0x14, 0xfa, 0x0b, 0xec, 0x3b, 0xfa, 0xad, 0x5f, 0x85, 0x35, 0xc9, 0xcf, 0xf4,
+	0xec, 0xef, 0x00, 0x51, 0x76, 0x66, 0x50, 0x46, 0x06, 0x00, 0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -783,6 +794,9 @@ func (this *ValidatorParams) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.ProposerSelection != that1.ProposerSelection {
+		return false
+	}
 	return true
 }
 func (this *VersionParams) Equal(that interface{}) bool {
@@ -1127,6 +1141,13 @@ func (m *ValidatorParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposerSelection) > 0 {
+		i -= len(m.ProposerSelection)
+		copy(dAtA[i:], m.ProposerSelection)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ProposerSelection)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.PubKeyTypes) > 0 {
 		for iNdEx := len(m.PubKeyTypes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.PubKeyTypes[iNdEx])
@@ -1336,6 +1357,7 @@ func NewPopulatedValidatorParams(r randyParams, easy bool) *ValidatorParams {
 	for i := 0; i < v1; i++ {
 		this.PubKeyTypes[i] = string(randStringParams(r))
 	}
+	this.ProposerSelection = string(randStringParams(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1502,6 +1524,10 @@ func (m *ValidatorParams) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = len(m.ProposerSelection)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -2156,6 +2182,38 @@ func (m *ValidatorParams) Unmarshal(dAtA []byte) error {
 			}
 			m.PubKeyTypes = append(m.PubKeyTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSelection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerSelection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
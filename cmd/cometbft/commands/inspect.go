@@ -50,7 +50,11 @@ func runInspect(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return err
 	}
-	blockStore := store.NewBlockStore(blockStoreDB, store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout))
+	blockStore, err := store.OpenBlockStore(blockStoreDB, store.WithStorageConfig(config.Storage))
+	if err != nil {
+		_ = blockStoreDB.Close()
+		return err
+	}
 	defer blockStore.Close()
 
 	stateDB, err := cfg.DefaultDBProvider(&cfg.DBContext{ID: "state", Config: config})
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 
 	dbm "github.com/cometbft/cometbft-db"
 	cmtos "github.com/cometbft/cometbft/internal/os"
@@ -21,6 +22,7 @@ import (
 	lproxy "github.com/cometbft/cometbft/light/proxy"
 	lrpc "github.com/cometbft/cometbft/light/rpc"
 	dbs "github.com/cometbft/cometbft/light/store/db"
+	grpcserver "github.com/cometbft/cometbft/rpc/grpc/server"
 	rpcserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
 )
 
@@ -45,6 +47,11 @@ When /abci_query is called, the Merkle key path format is:
 
 Please verify with your application that this Merkle key format is used (true
 for applications built w/ Cosmos SDK).
+
+If --grpc-laddr is set, the proxy also serves the gRPC BlockService,
+BlockResultsService and ABCIQueryService on this address, with their responses
+verified in the same way. The proofs of the ABCI queries are always requested
+and verified, and GetLatestHeight streams the latest verified heights.
 `,
 	RunE: runProxy,
 	Args: cobra.ExactArgs(1),
@@ -54,6 +61,7 @@ for applications built w/ Cosmos SDK).
 
 var (
 	listenAddr         string
+	grpcListenAddr     string
 	primaryAddr        string
 	witnessAddrsJoined string
 	chainID            string
@@ -75,6 +83,8 @@ var (
 func init() {
 	LightCmd.Flags().StringVar(&listenAddr, "laddr", "tcp://localhost:8888",
 		"serve the proxy on the given address")
+	LightCmd.Flags().StringVar(&grpcListenAddr, "grpc-laddr", "",
+		"also serve the verified gRPC services on the given address, e.g. tcp://localhost:8889")
 	LightCmd.Flags().StringVarP(&primaryAddr, "primary", "p", "",
 		"connect to a CometBFT node at this address")
 	LightCmd.Flags().StringVarP(&witnessAddrsJoined, "witnesses", "w", "",
@@ -216,8 +226,26 @@ func runProxy(_ *cobra.Command, args []string) error {
 		return err
 	}
 
+	var grpcServer *grpc.Server
+	if grpcListenAddr != "" {
+		listener, err := grpcserver.Listen(grpcListenAddr)
+		if err != nil {
+			return fmt.Errorf("failed to listen on %s: %w", grpcListenAddr, err)
+		}
+		grpcServer = p.NewGRPCServer()
+		logger.Info("Starting gRPC proxy...", "laddr", grpcListenAddr)
+		go func() {
+			if err := grpcServer.Serve(listener); err != nil {
+				logger.Error("gRPC proxy Serve", "err", err)
+			}
+		}()
+	}
+
 	// Stop upon receiving SIGTERM or CTRL-C.
 	cmtos.TrapSignal(logger, func() {
+		if grpcServer != nil {
+			grpcServer.Stop()
+		}
 		p.Listener.Close()
 	})
 
@@ -0,0 +1,287 @@
+package commands
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+	cmtos "github.com/cometbft/cometbft/internal/os"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+	"github.com/cometbft/cometbft/light"
+	lproxy "github.com/cometbft/cometbft/light/proxy"
+	lrpc "github.com/cometbft/cometbft/light/rpc"
+	dbs "github.com/cometbft/cometbft/light/store/db"
+	rpcserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
+	cmterrors "github.com/cometbft/cometbft/types/errors"
+)
+
+var lightDaemonConfigFile string
+
+func init() {
+	LightDaemonCmd.Flags().StringVar(&lightDaemonConfigFile, "config", "",
+		"path to the TOML file listing the chains to track")
+	LightDaemonCmd.Flags().BoolVar(&verbose, "verbose", false, "Verbose output")
+	_ = LightDaemonCmd.MarkFlagRequired("config")
+
+	LightCmd.AddCommand(LightDaemonCmd)
+}
+
+// LightDaemonCmd runs a light client proxy for each of the chains listed in a
+// config file, behind a single HTTP server.
+var LightDaemonCmd = &cobra.Command{
+	Use:   "daemon",
+	Short: "Run light client proxies for multiple chains from a config file",
+	Long: `
+daemon runs a light client for each of the chains listed in a config file, and
+serves their verified RPC proxies on a single address, each under its own path
+prefix. The light blocks of all the chains are stored in the same database,
+each chain under its own namespace.
+
+The config file has the following format:
+
+  laddr = "tcp://localhost:8888"
+  home_dir = "/home/user/.cometbft-light"
+  max_open_connections = 900
+
+  [[chain]]
+  chain_id = "cosmoshub-4"
+  primary = "http://52.57.29.196:26657"
+  witnesses = ["http://public-seed-node.cosmoshub.certus.one:26657"]
+  trusting_period = "168h"
+  trust_height = 962118
+  trust_hash = "28B97BE9F6DE51AC69F70E0B7BFD7E5C9CD1A595B7DC31AFF27C50D4948020CD"
+  trust_level = "1/3"
+  sequential = false
+  # Namespace of the light blocks in the database, defaults to chain_id.
+  db_prefix = "cosmoshub-4"
+  # Path prefix of the proxy, defaults to /<chain_id>.
+  path_prefix = "/cosmoshub-4"
+
+As with the single chain proxy, trust_height and trust_hash are only needed for
+the first run: the light client then continues from its latest trusted block.
+
+With the config above, the status of cosmoshub-4 is served at
+/cosmoshub-4/status, its JSON-RPC endpoint at /cosmoshub-4/ and its websocket
+at /cosmoshub-4/websocket. The divergence reports of a chain are exported by
+"light divergences <db_prefix> --home-dir <home_dir>" once the daemon is stopped.
+`,
+	Args: cobra.NoArgs,
+	RunE: runLightDaemon,
+}
+
+type lightDaemonConfig struct {
+	ListenAddr         string                    `toml:"laddr"`
+	HomeDir            string                    `toml:"home_dir"`
+	MaxOpenConnections int                       `toml:"max_open_connections"`
+	Chains             []*lightDaemonChainConfig `toml:"chain"`
+}
+
+type lightDaemonChainConfig struct {
+	ChainID        string        `toml:"chain_id"`
+	Primary        string        `toml:"primary"`
+	Witnesses      []string      `toml:"witnesses"`
+	TrustingPeriod time.Duration `toml:"trusting_period"`
+	TrustHeight    int64         `toml:"trust_height"`
+	TrustHash      string        `toml:"trust_hash"`
+	TrustLevel     string        `toml:"trust_level"`
+	Sequential     bool          `toml:"sequential"`
+	DBPrefix       string        `toml:"db_prefix"`
+	PathPrefix     string        `toml:"path_prefix"`
+}
+
+// loadLightDaemonConfig reads the config file, fills in the defaults and
+// validates it.
+func loadLightDaemonConfig(file string) (*lightDaemonConfig, error) {
+	cfg := &lightDaemonConfig{
+		ListenAddr:         "tcp://localhost:8888",
+		HomeDir:            os.ExpandEnv(filepath.Join("$HOME", ".cometbft-light")),
+		MaxOpenConnections: 900,
+	}
+	if _, err := toml.DecodeFile(file, cfg); err != nil {
+		return nil, fmt.Errorf("failed to load config from %q: %w", file, err)
+	}
+
+	for _, c := range cfg.Chains {
+		if c.TrustingPeriod == 0 {
+			c.TrustingPeriod = 168 * time.Hour
+		}
+		if c.TrustLevel == "" {
+			c.TrustLevel = "1/3"
+		}
+		if c.DBPrefix == "" {
+			c.DBPrefix = c.ChainID
+		}
+		if c.PathPrefix == "" {
+			c.PathPrefix = "/" + c.ChainID
+		}
+		c.PathPrefix = strings.TrimSuffix(c.PathPrefix, "/")
+	}
+
+	return cfg, cfg.ValidateBasic()
+}
+
+// ValidateBasic checks that each chain is fully configured, and that the
+// chains do not share a database namespace or a path prefix.
+func (cfg *lightDaemonConfig) ValidateBasic() error {
+	if len(cfg.Chains) == 0 {
+		return cmterrors.ErrRequiredField{Field: "chain"}
+	}
+
+	var (
+		dbPrefixes   = make(map[string]string, len(cfg.Chains))
+		pathPrefixes = make(map[string]string, len(cfg.Chains))
+	)
+	for i, c := range cfg.Chains {
+		switch {
+		case c.ChainID == "":
+			return fmt.Errorf("chain #%d: %w", i, cmterrors.ErrRequiredField{Field: "chain_id"})
+		case c.Primary == "":
+			return fmt.Errorf("chain %s: %w", c.ChainID, cmterrors.ErrRequiredField{Field: "primary"})
+		case c.PathPrefix == "" || !strings.HasPrefix(c.PathPrefix, "/"):
+			return fmt.Errorf("chain %s: path_prefix %q must start with / and not be the root",
+				c.ChainID, c.PathPrefix)
+		}
+		if _, err := cmtmath.ParseFraction(c.TrustLevel); err != nil {
+			return fmt.Errorf("chain %s: can't parse trust level: %w", c.ChainID, err)
+		}
+		if _, err := hex.DecodeString(c.TrustHash); err != nil {
+			return fmt.Errorf("chain %s: can't parse trust hash: %w", c.ChainID, err)
+		}
+		if other, ok := dbPrefixes[c.DBPrefix]; ok {
+			return fmt.Errorf("chains %s and %s have the same db_prefix %q", other, c.ChainID, c.DBPrefix)
+		}
+		dbPrefixes[c.DBPrefix] = c.ChainID
+		if other, ok := pathPrefixes[c.PathPrefix]; ok {
+			return fmt.Errorf("chains %s and %s have the same path_prefix %q", other, c.ChainID, c.PathPrefix)
+		}
+		pathPrefixes[c.PathPrefix] = c.ChainID
+	}
+	return nil
+}
+
+func runLightDaemon(_ *cobra.Command, _ []string) error {
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	var option log.Option
+	if verbose {
+		option, _ = log.AllowLevel("debug")
+	} else {
+		option, _ = log.AllowLevel("info")
+	}
+	logger = log.NewFilter(logger, option)
+
+	daemonCfg, err := loadLightDaemonConfig(lightDaemonConfigFile)
+	if err != nil {
+		return err
+	}
+
+	db, err := dbm.NewGoLevelDB("light-client-db", daemonCfg.HomeDir)
+	if err != nil {
+		return fmt.Errorf("can't create a db: %w", err)
+	}
+	defer db.Close()
+
+	cfg := rpcserver.DefaultConfig()
+	cfg.MaxBodyBytes = config.RPC.MaxBodyBytes
+	cfg.MaxHeaderBytes = config.RPC.MaxHeaderBytes
+	cfg.MaxOpenConnections = daemonCfg.MaxOpenConnections
+	// If necessary adjust global WriteTimeout to ensure it's greater than
+	// TimeoutBroadcastTxCommit.
+	if cfg.WriteTimeout <= config.RPC.TimeoutBroadcastTxCommit {
+		cfg.WriteTimeout = config.RPC.TimeoutBroadcastTxCommit + 1*time.Second
+	}
+
+	mux := http.NewServeMux()
+	for _, chainCfg := range daemonCfg.Chains {
+		chainLogger := logger.With("chain", chainCfg.ChainID)
+		chainLogger.Info("Creating client...", "path_prefix", chainCfg.PathPrefix)
+
+		c, err := newLightDaemonClient(db, chainCfg, chainLogger)
+		if err != nil {
+			return fmt.Errorf("chain %s: %w", chainCfg.ChainID, err)
+		}
+
+		p, err := lproxy.NewProxy(c, "", chainCfg.Primary, cfg, chainLogger,
+			lrpc.KeyPathFn(lrpc.DefaultMerkleKeyPathFn()))
+		if err != nil {
+			return fmt.Errorf("chain %s: %w", chainCfg.ChainID, err)
+		}
+		handler, err := p.Handler()
+		if err != nil {
+			return fmt.Errorf("chain %s: %w", chainCfg.ChainID, err)
+		}
+		mux.Handle(chainCfg.PathPrefix+"/", http.StripPrefix(chainCfg.PathPrefix, handler))
+	}
+
+	listener, err := rpcserver.Listen(daemonCfg.ListenAddr, cfg.MaxOpenConnections)
+	if err != nil {
+		return err
+	}
+
+	// Stop upon receiving SIGTERM or CTRL-C.
+	cmtos.TrapSignal(logger, func() {
+		listener.Close()
+	})
+
+	logger.Info("Starting proxies...", "laddr", daemonCfg.ListenAddr, "chains", len(daemonCfg.Chains))
+	if err := rpcserver.Serve(listener, mux, logger, cfg); err != http.ErrServerClosed {
+		// Error starting or closing listener:
+		logger.Error("proxy Serve", "err", err)
+	}
+
+	return nil
+}
+
+// newLightDaemonClient creates the light client of a chain, initializing it
+// from its trust options on the first run, and from the trusted store after.
+func newLightDaemonClient(db dbm.DB, chainCfg *lightDaemonChainConfig, logger log.Logger) (*light.Client, error) {
+	trustLevel, err := cmtmath.ParseFraction(chainCfg.TrustLevel)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse trust level: %w", err)
+	}
+	trustHash, err := hex.DecodeString(chainCfg.TrustHash)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse trust hash: %w", err)
+	}
+
+	options := []light.Option{light.Logger(logger)}
+	if chainCfg.Sequential {
+		options = append(options, light.SequentialVerification())
+	} else {
+		options = append(options, light.SkippingVerification(trustLevel))
+	}
+
+	if chainCfg.TrustHeight > 0 && len(trustHash) > 0 { // fresh installation
+		return light.NewHTTPClient(
+			context.Background(),
+			chainCfg.ChainID,
+			light.TrustOptions{
+				Period: chainCfg.TrustingPeriod,
+				Height: chainCfg.TrustHeight,
+				Hash:   trustHash,
+			},
+			chainCfg.Primary,
+			chainCfg.Witnesses,
+			dbs.New(db, chainCfg.DBPrefix),
+			options...,
+		)
+	}
+	// continue from latest state
+	return light.NewHTTPClientFromTrustedStore(
+		chainCfg.ChainID,
+		chainCfg.TrustingPeriod,
+		chainCfg.Primary,
+		chainCfg.Witnesses,
+		dbs.New(db, chainCfg.DBPrefix),
+		options...,
+	)
+}
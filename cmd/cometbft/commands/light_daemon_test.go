@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadLightDaemonConfig(t *testing.T) {
+	const chains = `
+[[chain]]
+chain_id = "chain-a"
+primary = "http://localhost:26657"
+witnesses = ["http://localhost:36657"]
+trust_height = 10
+trust_hash = "28B97BE9F6DE51AC69F70E0B7BFD7E5C9CD1A595B7DC31AFF27C50D4948020CD"
+
+[[chain]]
+chain_id = "chain-b"
+primary = "http://localhost:46657"
+trusting_period = "24h"
+sequential = true
+db_prefix = "b"
+path_prefix = "/b/"
+`
+	testCases := []struct {
+		name   string
+		config string
+		errMsg string
+	}{
+		{"valid", chains, ""},
+		{"no chains", `laddr = "tcp://localhost:8888"`, "chain"},
+		{"missing primary", "[[chain]]\nchain_id = \"c\"", "primary"},
+		{"root path prefix", "[[chain]]\nchain_id = \"c\"\nprimary = \"p\"\npath_prefix = \"/\"", "path_prefix"},
+		{"bad trust level", "[[chain]]\nchain_id = \"c\"\nprimary = \"p\"\ntrust_level = \"1\"", "trust level"},
+		{"bad trust hash", "[[chain]]\nchain_id = \"c\"\nprimary = \"p\"\ntrust_hash = \"zz\"", "trust hash"},
+		{"same db prefix", chains + "\n[[chain]]\nchain_id = \"c\"\nprimary = \"p\"\ndb_prefix = \"b\"", "db_prefix"},
+		{"same path prefix", chains + "\n[[chain]]\nchain_id = \"c\"\nprimary = \"p\"\npath_prefix = \"/chain-a\"", "path_prefix"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "light.toml")
+			require.NoError(t, os.WriteFile(file, []byte(tc.config), 0o600))
+
+			cfg, err := loadLightDaemonConfig(file)
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, "tcp://localhost:8888", cfg.ListenAddr)
+			require.Len(t, cfg.Chains, 2)
+			a, b := cfg.Chains[0], cfg.Chains[1]
+			assert.Equal(t, 168*time.Hour, a.TrustingPeriod)
+			assert.Equal(t, "1/3", a.TrustLevel)
+			assert.Equal(t, "chain-a", a.DBPrefix)
+			assert.Equal(t, "/chain-a", a.PathPrefix)
+			assert.Equal(t, 24*time.Hour, b.TrustingPeriod)
+			assert.True(t, b.Sequential)
+			assert.Equal(t, "b", b.DBPrefix)
+			assert.Equal(t, "/b", b.PathPrefix)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/light/store"
+	dbs "github.com/cometbft/cometbft/light/store/db"
+)
+
+var (
+	divergenceReportID uint64
+	divergenceOutput   string
+)
+
+func init() {
+	LightDivergencesCmd.Flags().StringVar(&home, "home-dir", os.ExpandEnv(filepath.Join("$HOME", ".cometbft-light")),
+		"specify the home directory")
+	LightDivergencesCmd.Flags().Uint64Var(&divergenceReportID, "id", 0,
+		"ID of the report to export (0 exports all the reports)")
+	LightDivergencesCmd.Flags().StringVarP(&divergenceOutput, "output", "o", "",
+		"file to write the reports to (defaults to stdout)")
+
+	LightCmd.AddCommand(LightDivergencesCmd)
+}
+
+// LightDivergencesCmd exports the divergence reports saved by the light client.
+var LightDivergencesCmd = &cobra.Command{
+	Use:   "divergences [chainID]",
+	Short: "Export the divergence reports saved by the light client as JSON",
+	Long: `
+divergences reads the reports saved by the light client of the given chain each
+time it detected a conflicting header, and writes them as JSON. A report holds
+the conflicting light blocks of the primary and the witness, their addresses,
+and the LightClientAttackEvidence computed against them, which can be
+resubmitted to a full node through the broadcast_evidence RPC endpoint.
+
+The light client must be stopped, as it holds a lock on its database. While it
+is running, the reports are served by its divergence_reports RPC endpoint.
+`,
+	Args:    cobra.ExactArgs(1),
+	RunE:    exportDivergenceReports,
+	Example: `light divergences cosmoshub-3 --id 1 -o report.json`,
+}
+
+func exportDivergenceReports(_ *cobra.Command, args []string) error {
+	db, err := dbm.NewGoLevelDB("light-client-db", home)
+	if err != nil {
+		return fmt.Errorf("can't open the db: %w", err)
+	}
+	defer db.Close()
+
+	ds, ok := dbs.New(db, args[0]).(store.DivergenceStore)
+	if !ok {
+		return errors.New("the light store does not persist divergence reports")
+	}
+
+	var v any
+	if divergenceReportID != 0 {
+		v, err = ds.DivergenceReport(divergenceReportID)
+	} else {
+		v, err = ds.DivergenceReports()
+	}
+	if err != nil {
+		return err
+	}
+
+	bz, err := cmtjson.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling the reports: %w", err)
+	}
+	bz = append(bz, '\n')
+
+	if divergenceOutput == "" {
+		_, err = os.Stdout.Write(bz)
+		return err
+	}
+	return os.WriteFile(divergenceOutput, bz, 0o600)
+}
@@ -0,0 +1,382 @@
+package commands
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/evidence"
+	cmtos "github.com/cometbft/cometbft/internal/os"
+	"github.com/cometbft/cometbft/internal/tempfile"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+)
+
+const (
+	// migrationDir is the directory, under the database directory, the
+	// databases are migrated to before replacing the original ones.
+	migrationDir = "migrate-db.tmp"
+	// migrationBackupDir is the directory, under the database directory, the
+	// original databases are moved to once migrated.
+	migrationBackupDir = "migrate-db.bak"
+	// migrationJournalFile is the file, under the database directory, listing
+	// the migrated databases while they replace the original ones.
+	migrationJournalFile = "migrate-db.journal"
+
+	// migrationBatchSize is the number of entries written per batch.
+	migrationBatchSize = 10_000
+)
+
+// layoutVersionKey is the key under which the stores record the version of
+// their key layout.
+var layoutVersionKey = []byte("version")
+
+var targetBackend, targetLayout string
+
+func init() {
+	MigrateDBCmd.Flags().StringVar(&targetBackend, "target-backend", "",
+		"database backend to migrate to (defaults to db_backend)")
+	MigrateDBCmd.Flags().StringVar(&targetLayout, "target-layout", "",
+		"key layout to migrate to, v1 or v2 (defaults to storage.experimental_db_key_layout)")
+}
+
+var MigrateDBCmd = &cobra.Command{
+	Use:     "migrate-db",
+	Aliases: []string{"migrate_db"},
+	Short:   "migrate the databases to another backend or key layout",
+	Long: `
+migrate-db copies the blockstore, state, evidence and tx_index databases key by
+key to the given database backend, rewriting the keys of the blockstore, state
+and evidence databases to the given key layout. Each migrated database is
+verified against the original one, by comparing the number of entries and a
+checksum of their keys, mapped back to the original key layout, and values,
+before the original databases are moved to the migrate-db.bak directory of the
+database directory.
+
+The databases to replace are recorded in the migrate-db.journal file of the
+database directory until all of them are replaced. If the migration is
+interrupted meanwhile, run migrate-db again with the same target backend and
+key layout to complete it.
+
+The node must be stopped during the migration. Once the migration is complete,
+set db_backend and storage.experimental_db_key_layout in config.toml to the
+target backend and key layout before restarting the node.
+`,
+	Example: `
+	cometbft migrate-db --target-backend pebbledb
+	cometbft migrate-db --target-layout v2
+	`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		backend, layout := targetBackend, targetLayout
+		if backend == "" {
+			backend = config.DBBackend
+		}
+		if layout == "" {
+			layout = config.Storage.ExperimentalKeyLayout
+		}
+		if err := MigrateDBs(config, dbm.BackendType(backend), layout); err != nil {
+			return fmt.Errorf("failed to migrate databases: %w", err)
+		}
+		fmt.Printf("Migrated databases to backend %s and key layout %s\n", backend, layout)
+		fmt.Printf("Set db_backend = %q and experimental_db_key_layout = %q in config.toml before restarting the node\n",
+			backend, layout)
+		return nil
+	},
+}
+
+// migratedDB is a database migrated by migrate-db. Its keys are rewritten by
+// migrateKey, unless nil.
+type migratedDB struct {
+	name       string
+	migrateKey func(key []byte, from, to string) ([]byte, error)
+}
+
+var migratedDBs = []migratedDB{
+	{name: "blockstore", migrateKey: store.MigrateKey},
+	{name: "state", migrateKey: state.MigrateKey},
+	{name: "evidence", migrateKey: evidence.MigrateKey},
+	{name: "tx_index"},
+}
+
+// migrationJournal lists the migrated databases replacing the original ones,
+// so that an interrupted migration is completed when run again.
+type migrationJournal struct {
+	SourceBackend dbm.BackendType `json:"source_backend"`
+	TargetBackend dbm.BackendType `json:"target_backend"`
+	Layout        string          `json:"layout"`
+	DBs           []string        `json:"dbs"`
+}
+
+// MigrateDBs migrates the databases of config to the given backend and key
+// layout. The original databases are only replaced once all of them are
+// migrated and verified. If a previous migration was interrupted while
+// replacing them, it is completed instead.
+func MigrateDBs(config *cfg.Config, backend dbm.BackendType, layout string) error {
+	if layout != "v1" && layout != "v2" {
+		return fmt.Errorf("unsupported key layout %q, expected v1 or v2", layout)
+	}
+	srcBackend := dbm.BackendType(config.DBBackend)
+	dbDir := config.DBDir()
+	tmpDir := filepath.Join(dbDir, migrationDir)
+	backupDir := filepath.Join(dbDir, migrationBackupDir)
+	journalFile := filepath.Join(dbDir, migrationJournalFile)
+	if cmtos.FileExists(journalFile) {
+		journal, err := readMigrationJournal(journalFile)
+		if err != nil {
+			return err
+		}
+		if journal.TargetBackend != backend || journal.Layout != layout {
+			return fmt.Errorf("a migration to backend %s and key layout %s was interrupted, "+
+				"run migrate-db again with these targets to complete it", journal.TargetBackend, journal.Layout)
+		}
+		fmt.Printf("Completing the interrupted migration of the %v databases\n", journal.DBs)
+		return replaceDBs(dbDir, journal)
+	}
+	if cmtos.FileExists(backupDir) {
+		return fmt.Errorf("%s already exists, remove it to migrate again", backupDir)
+	}
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return err
+	}
+
+	var migrated []string
+	for _, mdb := range migratedDBs {
+		if !cmtos.FileExists(dbPath(srcBackend, dbDir, mdb.name)) {
+			continue
+		}
+		n, err := migrateDBFiles(mdb, srcBackend, dbDir, backend, tmpDir, layout)
+		if err != nil {
+			return fmt.Errorf("%s: %w", mdb.name, err)
+		}
+		fmt.Printf("Migrated %d entries of the %s database\n", n, mdb.name)
+		migrated = append(migrated, mdb.name)
+	}
+	if len(migrated) == 0 {
+		return fmt.Errorf("no database found in %v", dbDir)
+	}
+
+	journal := &migrationJournal{
+		SourceBackend: srcBackend,
+		TargetBackend: backend,
+		Layout:        layout,
+		DBs:           migrated,
+	}
+	bz, err := json.Marshal(journal)
+	if err != nil {
+		return err
+	}
+	if err := tempfile.WriteFileAtomic(journalFile, bz, 0o600); err != nil {
+		return err
+	}
+	return replaceDBs(dbDir, journal)
+}
+
+// replaceDBs moves the original databases of the journal to the backup
+// directory, and the migrated ones in their place, skipping the databases
+// already moved by an interrupted run. The journal is removed once done.
+func replaceDBs(dbDir string, journal *migrationJournal) error {
+	tmpDir := filepath.Join(dbDir, migrationDir)
+	backupDir := filepath.Join(dbDir, migrationBackupDir)
+	if err := os.MkdirAll(backupDir, 0o700); err != nil {
+		return err
+	}
+	for _, name := range journal.DBs {
+		migrated := dbPath(journal.TargetBackend, tmpDir, name)
+		if !cmtos.FileExists(migrated) {
+			// Already replaced.
+			continue
+		}
+		backup := dbPath(journal.SourceBackend, backupDir, name)
+		if !cmtos.FileExists(backup) {
+			if err := os.Rename(dbPath(journal.SourceBackend, dbDir, name), backup); err != nil {
+				return err
+			}
+		}
+		if err := os.Rename(migrated, dbPath(journal.TargetBackend, dbDir, name)); err != nil {
+			return err
+		}
+	}
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return err
+	}
+	return os.Remove(filepath.Join(dbDir, migrationJournalFile))
+}
+
+func readMigrationJournal(file string) (*migrationJournal, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	journal := new(migrationJournal)
+	if err := json.Unmarshal(bz, journal); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file, err)
+	}
+	return journal, nil
+}
+
+// migrateDBFiles migrates the database of srcDir using srcBackend to a
+// database of the same name in dstDir using dstBackend. It returns the number
+// of entries migrated.
+func migrateDBFiles(
+	mdb migratedDB,
+	srcBackend dbm.BackendType,
+	srcDir string,
+	dstBackend dbm.BackendType,
+	dstDir string,
+	layout string,
+) (int64, error) {
+	src, err := dbm.NewDB(mdb.name, srcBackend, srcDir)
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+	dst, err := dbm.NewDB(mdb.name, dstBackend, dstDir)
+	if err != nil {
+		return 0, err
+	}
+	defer dst.Close()
+	return migrateDB(src, dst, mdb.migrateKey, layout)
+}
+
+// migrateDB copies the entries of src to dst, rewriting their keys to the key
+// layout with migrateKey, if not nil. It then verifies that dst holds the same
+// number of entries as src, with the same checksum once their keys are mapped
+// back to the original layout, and returns this number.
+func migrateDB(
+	src, dst dbm.DB,
+	migrateKey func(key []byte, from, to string) ([]byte, error),
+	layout string,
+) (int64, error) {
+	from := "v1"
+	if migrateKey != nil {
+		version, err := src.Get(layoutVersionKey)
+		if err != nil {
+			return 0, err
+		}
+		if len(version) != 0 {
+			from = string(version)
+		}
+	}
+
+	var (
+		count    int64
+		checksum migrationChecksum
+	)
+	iter, err := src.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+	batch := dst.NewBatch()
+	defer func() { _ = batch.Close() }()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if migrateKey != nil && bytes.Equal(key, layoutVersionKey) {
+			continue
+		}
+		checksum.add(key, iter.Value())
+		if migrateKey != nil {
+			if key, err = migrateKey(key, from, layout); err != nil {
+				return 0, err
+			}
+		}
+		if err := batch.Set(key, iter.Value()); err != nil {
+			return 0, err
+		}
+		count++
+		if count%migrationBatchSize == 0 {
+			if err := batch.Write(); err != nil {
+				return 0, err
+			}
+			_ = batch.Close()
+			batch = dst.NewBatch()
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+	if migrateKey != nil {
+		if err := batch.Set(layoutVersionKey, []byte(layout)); err != nil {
+			return 0, err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return 0, err
+	}
+
+	// Checksumming the keys as written would not catch a wrong mapping.
+	var unmigrateKey func(key []byte) ([]byte, error)
+	if migrateKey != nil {
+		unmigrateKey = func(key []byte) ([]byte, error) {
+			return migrateKey(key, layout, from)
+		}
+	}
+	n, sum, err := checksumDB(dst, unmigrateKey)
+	if err != nil {
+		return 0, err
+	}
+	if n != count || sum != checksum {
+		return 0, fmt.Errorf("migrated database does not match the original one: "+
+			"%d entries copied, %d entries found", count, n)
+	}
+	return count, nil
+}
+
+// checksumDB returns the number of entries of db and their checksum. If
+// unmigrateKey is set, the key layout version is skipped and the keys are
+// mapped back to the original layout with unmigrateKey.
+func checksumDB(db dbm.DB, unmigrateKey func(key []byte) ([]byte, error)) (int64, migrationChecksum, error) {
+	var (
+		count    int64
+		checksum migrationChecksum
+	)
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return 0, checksum, err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if unmigrateKey != nil {
+			if bytes.Equal(key, layoutVersionKey) {
+				continue
+			}
+			if key, err = unmigrateKey(key); err != nil {
+				return 0, checksum, err
+			}
+		}
+		checksum.add(key, iter.Value())
+		count++
+	}
+	return count, checksum, iter.Error()
+}
+
+// migrationChecksum is a checksum of the entries of a database independent
+// of their order, as rewriting the keys changes the order of the entries: it
+// is the XOR of the SHA-256 hashes of the entries.
+type migrationChecksum [sha256.Size]byte
+
+func (c *migrationChecksum) add(key, value []byte) {
+	h := sha256.New()
+	h.Write([]byte(fmt.Sprintf("%d:", len(key))))
+	h.Write(key)
+	h.Write(value)
+	for i, b := range h.Sum(nil) {
+		c[i] ^= b
+	}
+}
+
+// dbPath returns the path of the database name of dir with backend.
+func dbPath(backend dbm.BackendType, dir, name string) string {
+	if backend == dbm.BadgerDBBackend {
+		return filepath.Join(dir, name)
+	}
+	return filepath.Join(dir, name+".db")
+}
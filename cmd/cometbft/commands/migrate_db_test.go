@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+// openStores opens the block and state stores of config with the given key
+// layout.
+func openStores(t *testing.T, config *cfg.Config, layout string) (*store.BlockStore, state.Store) {
+	t.Helper()
+	backend := dbm.BackendType(config.DBBackend)
+	blockStoreDB, err := dbm.NewDB("blockstore", backend, config.DBDir())
+	require.NoError(t, err)
+	stateDB, err := dbm.NewDB("state", backend, config.DBDir())
+	require.NoError(t, err)
+	return store.NewBlockStore(blockStoreDB, store.WithDBKeyLayout(layout)),
+		state.NewStore(stateDB, state.StoreOptions{DBKeyLayout: layout})
+}
+
+// initMigrationTestDBs returns a config whose block and state stores, with
+// the v1 key layout, hold 3 blocks.
+func initMigrationTestDBs(t *testing.T) *cfg.Config {
+	t.Helper()
+	config := test.ResetTestRoot("migrate_db_test")
+	config.SetRoot(t.TempDir())
+	config.DBBackend = string(dbm.GoLevelDBBackend)
+	cfg.EnsureRoot(config.RootDir)
+	require.NoError(t, initFilesWithConfig(config))
+
+	bs, ss := openStores(t, config, "v1")
+	st, err := ss.LoadFromDBOrGenesisFile(config.GenesisFile())
+	require.NoError(t, err)
+	require.NoError(t, ss.Save(st))
+	lastCommit := new(types.Commit)
+	for h := int64(1); h <= 3; h++ {
+		block := st.MakeBlock(h, test.MakeNTxs(h, 2), lastCommit, nil, st.Validators.GetProposer().Address)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+		lastCommit = &types.Commit{Height: h, BlockID: blockID, Signatures: []types.CommitSig{types.NewCommitSigAbsent()}}
+		bs.SaveBlock(block, partSet, lastCommit)
+		require.NoError(t, ss.SaveFinalizeBlockResponse(h, &abci.FinalizeBlockResponse{AppHash: []byte{byte(h)}}))
+		st.LastBlockHeight = h
+		st.LastBlockID = blockID
+		st.LastValidators = st.Validators.Copy()
+		require.NoError(t, ss.Save(st))
+	}
+	require.NoError(t, bs.Close())
+	require.NoError(t, ss.Close())
+	return config
+}
+
+func TestMigrateDBs(t *testing.T) {
+	config := initMigrationTestDBs(t)
+	require.NoError(t, MigrateDBs(config, dbm.GoLevelDBBackend, "v2"))
+	require.DirExists(t, filepath.Join(config.DBDir(), migrationBackupDir, "blockstore.db"))
+	require.NoDirExists(t, filepath.Join(config.DBDir(), migrationDir))
+	require.NoFileExists(t, filepath.Join(config.DBDir(), migrationJournalFile))
+
+	// The migrated stores keep their key layout whatever the configuration.
+	bs, ss := openStores(t, config, "v1")
+	defer func() {
+		_ = bs.Close()
+		_ = ss.Close()
+	}()
+	assert.Equal(t, "v2", bs.GetVersion())
+	assert.EqualValues(t, 3, bs.Height())
+	for h := int64(1); h <= 3; h++ {
+		block, _ := bs.LoadBlock(h)
+		require.NotNil(t, block, h)
+		byHash, _ := bs.LoadBlockByHash(block.Hash())
+		assert.Equal(t, block.Hash(), byHash.Hash(), h)
+		assert.NotNil(t, bs.LoadSeenCommit(h), h)
+		res, err := ss.LoadFinalizeBlockResponse(h)
+		require.NoError(t, err, h)
+		assert.Equal(t, []byte{byte(h)}, res.AppHash)
+	}
+	loaded, err := ss.Load()
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, loaded.LastBlockHeight)
+	_, err = ss.LoadValidators(3)
+	require.NoError(t, err)
+
+	// The backup of the previous migration must be removed first.
+	require.Error(t, MigrateDBs(config, dbm.GoLevelDBBackend, "v1"))
+}
+
+func TestMigrateDBsResume(t *testing.T) {
+	config := initMigrationTestDBs(t)
+	dbDir := config.DBDir()
+
+	// Interrupt a migration once the blockstore is moved to the backup
+	// directory, before the migrated one replaces it.
+	tmpDir := filepath.Join(dbDir, migrationDir)
+	for _, mdb := range migratedDBs[:2] {
+		_, err := migrateDBFiles(mdb, dbm.GoLevelDBBackend, dbDir, dbm.GoLevelDBBackend, tmpDir, "v2")
+		require.NoError(t, err)
+	}
+	journal := &migrationJournal{
+		SourceBackend: dbm.GoLevelDBBackend,
+		TargetBackend: dbm.GoLevelDBBackend,
+		Layout:        "v2",
+		DBs:           []string{"blockstore", "state"},
+	}
+	bz, err := json.Marshal(journal)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dbDir, migrationJournalFile), bz, 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dbDir, migrationBackupDir), 0o700))
+	require.NoError(t, os.Rename(filepath.Join(dbDir, "blockstore.db"),
+		filepath.Join(dbDir, migrationBackupDir, "blockstore.db")))
+
+	// The migration must be completed with the same targets.
+	require.Error(t, MigrateDBs(config, dbm.GoLevelDBBackend, "v1"))
+	require.NoError(t, MigrateDBs(config, dbm.GoLevelDBBackend, "v2"))
+	require.NoFileExists(t, filepath.Join(dbDir, migrationJournalFile))
+	require.NoDirExists(t, tmpDir)
+	require.DirExists(t, filepath.Join(dbDir, migrationBackupDir, "state.db"))
+
+	bs, ss := openStores(t, config, "v1")
+	defer func() {
+		_ = bs.Close()
+		_ = ss.Close()
+	}()
+	assert.Equal(t, "v2", bs.GetVersion())
+	assert.EqualValues(t, 3, bs.Height())
+	loaded, err := ss.Load()
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, loaded.LastBlockHeight)
+}
+
+func TestMigrateDBWrongMapping(t *testing.T) {
+	src := dbm.NewMemDB()
+	for i := byte(0); i < 10; i++ {
+		require.NoError(t, src.Set([]byte{'k', i}, []byte{i}))
+	}
+
+	// The mapping is injective, so the entries are all copied, but maps the
+	// keys to another layout than the one they are read with.
+	wrongKey := func(key []byte, _, _ string) ([]byte, error) {
+		return append([]byte{'x'}, key...), nil
+	}
+	_, err := migrateDB(src, dbm.NewMemDB(), wrongKey, "v2")
+	require.ErrorContains(t, err, "does not match")
+
+	n, err := migrateDB(src, dbm.NewMemDB(), store.MigrateKey, "v2")
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, n)
+}
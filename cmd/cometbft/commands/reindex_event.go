@@ -1,9 +1,14 @@
 package commands
 
 import (
+	"context"
+	"database/sql"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"runtime"
 	"strings"
+	"sync"
 
 	"github.com/spf13/cobra"
 
@@ -11,10 +16,13 @@ import (
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/internal/progressbar"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	"github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
 	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	"github.com/cometbft/cometbft/state/indexer/sink/psql"
+	"github.com/cometbft/cometbft/state/indexer/sink/sqlite"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/state/txindex/kv"
 	"github.com/cometbft/cometbft/types"
@@ -42,6 +50,22 @@ reindex from the base block height(inclusive); and the default end-height is 0,
 the tooling will reindex until the latest block height(inclusive). User can omit
 either or both arguments.
 
+Heights are re-indexed in batches of --batch-size heights, loaded by --workers
+concurrent workers and indexed in height order, so that the latest result of a
+transaction included at several heights is the one indexed. The progress is checkpointed in the event sink, and an interrupted run
+started again with the same start height resumes after the last checkpoint, unless
+--restart is set. The checkpoint is ignored, with a message, if the start height
+differs, e.g. if the default start height moved as the blockstore was pruned. The
+psql and sqlite sinks record it in the reindex_checkpoints table of their schema,
+which is created if missing, e.g. in a database installed with an older schema.
+
+The event sink defaults to the one of the tx-index section in the config.toml, and can
+be overridden with --sink and --psql-conn, e.g. to fill a psql sink from the blocks of
+a node using the kv indexer. With --source-rpc, blocks and their results are read from
+the RPC of a running node instead of the local stores. The node then does not have to
+be stopped only if the sink is psql or sqlite: the kv sink is the tx_index database of
+the node, which can't be opened while the node is running.
+
 Note: This operation requires ABCI Responses. Do not set DiscardABCIResponses to true if you
 want to use this command.
 	`,
@@ -50,39 +74,87 @@ want to use this command.
 	cometbft reindex-event --start-height 2
 	cometbft reindex-event --end-height 10
 	cometbft reindex-event --start-height 2 --end-height 10
+	cometbft reindex-event --workers 8 --batch-size 500
+	cometbft reindex-event --sink psql --psql-conn "postgresql://..." --source-rpc tcp://localhost:26657
 	`,
 	Run: func(cmd *cobra.Command, _ []string) {
-		bs, ss, err := loadStateAndBlockStore(config)
-		if err != nil {
-			fmt.Println(reindexFailed, err)
-			return
+		riArgs := eventReIndexArgs{
+			workers:   reindexWorkers,
+			batchSize: reindexBatchSize,
 		}
 
-		state, err := ss.Load()
-		if err != nil {
-			fmt.Println(reindexFailed, err)
-			return
+		var (
+			heights heightRange
+			chainID string
+		)
+		if reindexSourceRPC != "" {
+			client, err := rpchttp.New(reindexSourceRPC)
+			if err != nil {
+				fmt.Println(reindexFailed, err)
+				return
+			}
+			status, err := client.Status(cmd.Context())
+			if err != nil {
+				fmt.Println(reindexFailed, err)
+				return
+			}
+			chainID = status.NodeInfo.Network
+			heights = rpcHeightRange{
+				base:   status.SyncInfo.EarliestBlockHeight,
+				height: status.SyncInfo.LatestBlockHeight,
+			}
+			riArgs.rpcClient = client
+		} else {
+			bs, ss, err := loadStateAndBlockStore(config)
+			if err != nil {
+				fmt.Println(reindexFailed, err)
+				return
+			}
+
+			state, err := ss.Load()
+			if err != nil {
+				fmt.Println(reindexFailed, err)
+				return
+			}
+			chainID = state.ChainID
+			heights = bs
+			riArgs.blockStore = bs
+			riArgs.stateStore = ss
 		}
 
-		if err := checkValidHeight(bs); err != nil {
+		if err := checkValidHeight(heights); err != nil {
 			fmt.Println(reindexFailed, err)
 			return
 		}
 
-		bi, ti, err := loadEventSinks(config, state.ChainID)
+		if reindexSink != "" {
+			config.TxIndex.Indexer = reindexSink
+		}
+		if reindexPsqlConn != "" {
+			config.TxIndex.PsqlConn = reindexPsqlConn
+		}
+		sinks, err := loadEventSinks(config, chainID)
 		if err != nil {
 			fmt.Println(reindexFailed, err)
 			return
 		}
+		defer sinks.close()
 
-		riArgs := eventReIndexArgs{
-			startHeight:  startHeight,
-			endHeight:    endHeight,
-			blockIndexer: bi,
-			txIndexer:    ti,
-			blockStore:   bs,
-			stateStore:   ss,
+		riArgs.startHeight = startHeight
+		riArgs.endHeight = endHeight
+		riArgs.blockIndexer = sinks.blockIndexer
+		riArgs.txIndexer = sinks.txIndexer
+		riArgs.checkpoint = sinks.checkpoint
+		riArgs.checkpointStart = startHeight
+
+		if !reindexRestart {
+			riArgs.startHeight, err = resumeFromCheckpoint(sinks.checkpoint, startHeight, endHeight)
+			if err != nil {
+				fmt.Println(reindexFailed, err)
+				return
+			}
 		}
+
 		if err := eventReIndex(cmd, riArgs); err != nil {
 			panic(fmt.Errorf("%s: %w", reindexFailed, err))
 		}
@@ -94,38 +166,90 @@ want to use this command.
 var (
 	startHeight int64
 	endHeight   int64
+
+	reindexWorkers   int
+	reindexBatchSize int64
+	reindexRestart   bool
+	reindexSink      string
+	reindexPsqlConn  string
+	reindexSourceRPC string
 )
 
 func init() {
 	ReIndexEventCmd.Flags().Int64Var(&startHeight, "start-height", 0, "the block height would like to start for re-index")
 	ReIndexEventCmd.Flags().Int64Var(&endHeight, "end-height", 0, "the block height would like to finish for re-index")
+	ReIndexEventCmd.Flags().IntVar(&reindexWorkers, "workers", runtime.NumCPU(), "number of batches of heights re-indexed concurrently")
+	ReIndexEventCmd.Flags().Int64Var(&reindexBatchSize, "batch-size", 100, "number of heights per batch")
+	ReIndexEventCmd.Flags().BoolVar(&reindexRestart, "restart", false, "ignore the checkpoint of a previous run and re-index from the start height")
+	ReIndexEventCmd.Flags().StringVar(&reindexSink, "sink", "", "event sink to re-index into, overriding tx_index.indexer (kv, psql or sqlite)")
+	ReIndexEventCmd.Flags().StringVar(&reindexPsqlConn, "psql-conn", "", "psql connection settings, overriding tx_index.psql-conn")
+	ReIndexEventCmd.Flags().StringVar(&reindexSourceRPC, "source-rpc", "", "RPC address of a running node to read the blocks from, instead of the local stores (e.g. tcp://localhost:26657)")
+}
+
+// eventSinks are the indexers events are re-indexed into.
+type eventSinks struct {
+	blockIndexer indexer.BlockIndexer
+	txIndexer    txindex.TxIndexer
+	checkpoint   reindexCheckpoint
+	close        func() error
 }
 
-func loadEventSinks(cfg *cmtcfg.Config, chainID string) (indexer.BlockIndexer, txindex.TxIndexer, error) {
+func loadEventSinks(cfg *cmtcfg.Config, chainID string) (*eventSinks, error) {
 	switch strings.ToLower(cfg.TxIndex.Indexer) {
 	case "null":
-		return nil, nil, errors.New("found null event sink, please check the tx-index section in the config.toml")
+		return nil, errors.New("found null event sink, please check the tx-index section in the config.toml")
 	case "psql":
 		conn := cfg.TxIndex.PsqlConn
 		if conn == "" {
-			return nil, nil, errors.New("the psql connection settings cannot be empty")
+			return nil, errors.New("the psql connection settings cannot be empty")
 		}
 		es, err := psql.NewEventSink(conn, chainID)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
+		}
+		checkpoint, err := newSQLCheckpoint(es.DB(), chainID, "$")
+		if err != nil {
+			_ = es.Stop()
+			return nil, err
+		}
+		return &eventSinks{
+			blockIndexer: es.BlockIndexer(),
+			txIndexer:    es.TxIndexer(),
+			checkpoint:   checkpoint,
+			close:        es.Stop,
+		}, nil
+	case "sqlite":
+		es, err := sqlite.NewEventSink(cfg.TxIndex.SQLiteFile(), chainID)
+		if err != nil {
+			return nil, err
+		}
+		checkpoint, err := newSQLCheckpoint(es.DB(), chainID, "?")
+		if err != nil {
+			_ = es.Stop()
+			return nil, err
 		}
-		return es.BlockIndexer(), es.TxIndexer(), nil
+		return &eventSinks{
+			blockIndexer: es.BlockIndexer(),
+			txIndexer:    es.TxIndexer(),
+			checkpoint:   checkpoint,
+			close:        es.Stop,
+		}, nil
 	case "kv":
 		store, err := dbm.NewDB("tx_index", dbm.BackendType(cfg.DBBackend), cfg.DBDir())
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 
 		txIndexer := kv.NewTxIndex(store)
 		blockIndexer := blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))
-		return blockIndexer, txIndexer, nil
+		return &eventSinks{
+			blockIndexer: blockIndexer,
+			txIndexer:    txIndexer,
+			checkpoint:   kvCheckpoint{db: store},
+			close:        store.Close,
+		}, nil
 	default:
-		return nil, nil, fmt.Errorf("unsupported event sink type: %s", cfg.TxIndex.Indexer)
+		return nil, fmt.Errorf("unsupported event sink type: %s", cfg.TxIndex.Indexer)
 	}
 }
 
@@ -136,70 +260,272 @@ type eventReIndexArgs struct {
 	txIndexer    txindex.TxIndexer
 	blockStore   state.BlockStore
 	stateStore   state.Store
+
+	// rpcClient, if set, is used to load the blocks and their results instead
+	// of the stores.
+	rpcClient rpcclient.SignClient
+	// workers is the number of batches of batchSize heights loaded
+	// concurrently, then indexed in height order. Both default to 1.
+	workers   int
+	batchSize int64
+	// checkpoint, if set, records the height below which all heights are
+	// re-indexed, along with the checkpointStart height of the run.
+	checkpoint      reindexCheckpoint
+	checkpointStart int64
+}
+
+// reindexBatchTurn is a batch of heights to re-index starting at from. Its
+// heights are indexed once prev is closed, i.e. once the heights of the
+// previous batch are, and done is closed once they are.
+type reindexBatchTurn struct {
+	from int64
+	prev <-chan struct{}
+	done chan struct{}
+}
+
+// firstReindexBatchTurn returns the turn of the batch starting at from, which
+// has no previous batch to wait for.
+func firstReindexBatchTurn(from int64) reindexBatchTurn {
+	prev := make(chan struct{})
+	close(prev)
+	return reindexBatchTurn{from: from, prev: prev, done: make(chan struct{})}
+}
+
+// reindexedBatch is the outcome of the re-index of the batch starting at from.
+type reindexedBatch struct {
+	from int64
+	err  error
 }
 
 func eventReIndex(cmd *cobra.Command, args eventReIndexArgs) error {
+	workers := max(args.workers, 1)
+	batchSize := max(args.batchSize, 1)
+
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+
 	var bar progressbar.Bar
 	bar.NewOption(args.startHeight-1, args.endHeight)
 
 	fmt.Println("start re-indexing events:")
 	defer bar.Finish()
-	for height := args.startHeight; height <= args.endHeight; height++ {
-		select {
-		case <-cmd.Context().Done():
-			return fmt.Errorf("event re-index terminated at height %d: %w", height, cmd.Context().Err())
-		default:
-			block, _ := args.blockStore.LoadBlock(height)
-			if block == nil {
-				return fmt.Errorf("not able to load block at height %d from the blockstore", height)
-			}
 
-			resp, err := args.stateStore.LoadFinalizeBlockResponse(height)
-			if err != nil {
-				return fmt.Errorf("not able to load ABCI Response at height %d from the statestore", height)
+	// The batches are handed out in height order and chained, so that each
+	// one is indexed after the previous one: a transaction included at
+	// several heights must end up indexed with its latest result.
+	batches := make(chan reindexBatchTurn)
+	go func() {
+		defer close(batches)
+		turn := firstReindexBatchTurn(args.startHeight)
+		for turn.from <= args.endHeight {
+			select {
+			case batches <- turn:
+			case <-ctx.Done():
+				return
 			}
+			turn = reindexBatchTurn{from: turn.from + batchSize, prev: turn.done, done: make(chan struct{})}
+		}
+	}()
+
+	var (
+		wg   sync.WaitGroup
+		done = make(chan reindexedBatch)
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for turn := range batches {
+				if ctx.Err() != nil {
+					return
+				}
+				to := min(turn.from+batchSize-1, args.endHeight)
+				err := reindexBatch(ctx, args, turn, to)
+				select {
+				case done <- reindexedBatch{from: turn.from, err: err}:
+				case <-ctx.Done():
+					return
+				}
+				if err != nil {
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	// All heights below next are re-indexed; batches completed out of order
+	// are kept aside until next reaches them.
+	var (
+		next       = args.startHeight
+		completed  = make(map[int64]bool)
+		reindexErr error
+	)
+	for b := range done {
+		if reindexErr != nil {
+			continue
+		}
+		if b.err != nil {
+			reindexErr = b.err
+			cancel()
+			continue
+		}
 
-			e := types.EventDataNewBlockEvents{
-				Height: height,
-				Events: resp.Events,
+		completed[b.from] = true
+		for completed[next] {
+			delete(completed, next)
+			next = min(next+batchSize, args.endHeight+1)
+		}
+		if args.checkpoint != nil {
+			if err := args.checkpoint.save(args.checkpointStart, next-1); err != nil {
+				reindexErr = fmt.Errorf("saving the re-index checkpoint at height %d: %w", next-1, err)
+				cancel()
+				continue
 			}
+		}
+		bar.Play(next - 1)
+	}
+	if reindexErr != nil {
+		return reindexErr
+	}
+	if err := cmd.Context().Err(); err != nil {
+		return fmt.Errorf("event re-index terminated at height %d: %w", next, err)
+	}
 
-			numTxs := len(resp.TxResults)
+	if args.checkpoint != nil {
+		if err := args.checkpoint.clear(); err != nil {
+			return fmt.Errorf("clearing the re-index checkpoint: %w", err)
+		}
+	}
+	return nil
+}
 
-			var batch *txindex.Batch
-			if numTxs > 0 {
-				batch = txindex.NewBatch(int64(numTxs))
+// reindexedHeight is the data indexed at a height.
+type reindexedHeight struct {
+	events types.EventDataNewBlockEvents
+	batch  *txindex.Batch
+}
 
-				for idx, txResult := range resp.TxResults {
-					tr := abcitypes.TxResult{
-						Height: height,
-						Index:  uint32(idx),
-						Tx:     block.Txs[idx],
-						Result: *txResult,
-					}
+// reindexBatch re-indexes the heights from the from height of turn to the to
+// height (inclusive). They are loaded right away, but only indexed once the
+// previous batch is.
+func reindexBatch(ctx context.Context, args eventReIndexArgs, turn reindexBatchTurn, to int64) error {
+	heights := make([]reindexedHeight, 0, to-turn.from+1)
+	for height := turn.from; height <= to; height++ {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("event re-index terminated at height %d: %w", height, err)
+		}
 
-					if err = batch.Add(&tr); err != nil {
-						return fmt.Errorf("adding tx to batch: %w", err)
-					}
+		txs, resp, err := loadHeight(ctx, args, height)
+		if err != nil {
+			return err
+		}
+
+		e := types.EventDataNewBlockEvents{
+			Height: height,
+			Events: resp.Events,
+		}
+
+		numTxs := len(resp.TxResults)
+
+		var batch *txindex.Batch
+		if numTxs > 0 {
+			batch = txindex.NewBatch(int64(numTxs))
+
+			for idx, txResult := range resp.TxResults {
+				tr := abcitypes.TxResult{
+					Height: height,
+					Index:  uint32(idx),
+					Tx:     txs[idx],
+					Result: *txResult,
 				}
 
-				if err := args.txIndexer.AddBatch(batch); err != nil {
-					return fmt.Errorf("tx event re-index at height %d failed: %w", height, err)
+				if err = batch.Add(&tr); err != nil {
+					return fmt.Errorf("adding tx to batch: %w", err)
 				}
 			}
+		}
 
-			if err := args.blockIndexer.Index(e); err != nil {
-				return fmt.Errorf("block event re-index at height %d failed: %w", height, err)
-			}
+		heights = append(heights, reindexedHeight{events: e, batch: batch})
+	}
+
+	select {
+	case <-turn.prev:
+	case <-ctx.Done():
+		return fmt.Errorf("event re-index terminated at height %d: %w", turn.from, ctx.Err())
+	}
+	for _, h := range heights {
+		if err := indexHeight(args, h.events, h.batch); err != nil {
+			return err
 		}
+	}
+	close(turn.done)
+	return nil
+}
 
-		bar.Play(height)
+// indexHeight indexes the block events before the tx events, since the SQL
+// sinks attach the latter to the block.
+func indexHeight(args eventReIndexArgs, e types.EventDataNewBlockEvents, batch *txindex.Batch) error {
+	if err := args.blockIndexer.Index(e); err != nil {
+		return fmt.Errorf("block event re-index at height %d failed: %w", e.Height, err)
 	}
 
+	if batch != nil {
+		if err := args.txIndexer.AddBatch(batch); err != nil {
+			return fmt.Errorf("tx event re-index at height %d failed: %w", e.Height, err)
+		}
+	}
 	return nil
 }
 
-func checkValidHeight(bs state.BlockStore) error {
+// loadHeight loads the txs of the block at height and the response to their
+// finalization, either from the RPC client or from the stores.
+func loadHeight(ctx context.Context, args eventReIndexArgs, height int64) (types.Txs, *abcitypes.FinalizeBlockResponse, error) {
+	if args.rpcClient != nil {
+		block, err := args.rpcClient.Block(ctx, &height)
+		if err != nil {
+			return nil, nil, fmt.Errorf("not able to load block at height %d from the RPC: %w", height, err)
+		}
+		results, err := args.rpcClient.BlockResults(ctx, &height)
+		if err != nil {
+			return nil, nil, fmt.Errorf("not able to load block results at height %d from the RPC: %w", height, err)
+		}
+		return block.Block.Txs, &abcitypes.FinalizeBlockResponse{
+			Events:    results.FinalizeBlockEvents,
+			TxResults: results.TxResults,
+		}, nil
+	}
+
+	block, _ := args.blockStore.LoadBlock(height)
+	if block == nil {
+		return nil, nil, fmt.Errorf("not able to load block at height %d from the blockstore", height)
+	}
+
+	resp, err := args.stateStore.LoadFinalizeBlockResponse(height)
+	if err != nil {
+		return nil, nil, fmt.Errorf("not able to load ABCI Response at height %d from the statestore", height)
+	}
+	return block.Txs, resp, nil
+}
+
+// heightRange is the range of heights available for re-index.
+type heightRange interface {
+	Base() int64
+	Height() int64
+}
+
+// rpcHeightRange is the range of heights available from the RPC of a node.
+type rpcHeightRange struct {
+	base, height int64
+}
+
+func (r rpcHeightRange) Base() int64   { return r.base }
+func (r rpcHeightRange) Height() int64 { return r.height }
+
+func checkValidHeight(bs heightRange) error {
 	base := bs.Base()
 
 	if startHeight == 0 {
@@ -237,3 +563,115 @@ func checkValidHeight(bs state.BlockStore) error {
 
 	return nil
 }
+
+// reindexCheckpoint records the progress of a re-index in the event sink.
+type reindexCheckpoint interface {
+	// load returns the start height of the checkpointed run and the height
+	// below which all heights are re-indexed. ok is false if there is no
+	// checkpoint.
+	load() (start, height int64, ok bool, err error)
+	save(start, height int64) error
+	clear() error
+}
+
+// resumeFromCheckpoint returns the height to re-index from: the one following
+// the checkpoint of an interrupted run with the same start height, if any, or
+// startHeight.
+func resumeFromCheckpoint(checkpoint reindexCheckpoint, startHeight, endHeight int64) (int64, error) {
+	cpStart, cpHeight, ok, err := checkpoint.load()
+	if err != nil || !ok {
+		return startHeight, err
+	}
+	switch {
+	case cpStart != startHeight:
+		fmt.Printf("ignore the checkpoint at height %d of the re-index started at height %d, "+
+			"run with --start-height %d to resume it \n", cpHeight, cpStart, cpStart)
+	case cpHeight < startHeight || cpHeight >= endHeight:
+		fmt.Printf("ignore the checkpoint at height %d, out of the heights to re-index \n", cpHeight)
+	default:
+		fmt.Printf("resume the re-index from the checkpoint at height %d \n", cpHeight)
+		return cpHeight + 1, nil
+	}
+	return startHeight, nil
+}
+
+var reindexCheckpointKey = []byte("reindexEventCheckpoint")
+
+// kvCheckpoint stores the checkpoint in the database of the kv indexer.
+type kvCheckpoint struct {
+	db dbm.DB
+}
+
+func (c kvCheckpoint) load() (int64, int64, bool, error) {
+	bz, err := c.db.Get(reindexCheckpointKey)
+	if err != nil || bz == nil {
+		return 0, 0, false, err
+	}
+	if len(bz) != 16 {
+		return 0, 0, false, fmt.Errorf("invalid re-index checkpoint of %d bytes", len(bz))
+	}
+	return int64(binary.BigEndian.Uint64(bz)), int64(binary.BigEndian.Uint64(bz[8:])), true, nil
+}
+
+func (c kvCheckpoint) save(start, height int64) error {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, uint64(start))
+	binary.BigEndian.PutUint64(bz[8:], uint64(height))
+	return c.db.SetSync(reindexCheckpointKey, bz)
+}
+
+func (c kvCheckpoint) clear() error {
+	return c.db.DeleteSync(reindexCheckpointKey)
+}
+
+// sqlCheckpoint stores the checkpoint in the reindex_checkpoints table of the
+// schema of the SQL event sinks, with one row per chain.
+type sqlCheckpoint struct {
+	db      *sql.DB
+	chainID string
+	// placeholder prefixes the positional parameters of the queries: "$" for
+	// psql and "?" for sqlite.
+	placeholder string
+}
+
+// newSQLCheckpoint returns the checkpoint of chainID stored in db, creating
+// the reindex_checkpoints table if the schema of the sink predates it.
+func newSQLCheckpoint(db *sql.DB, chainID, placeholder string) (*sqlCheckpoint, error) {
+	if _, err := db.Exec(`
+CREATE TABLE IF NOT EXISTS reindex_checkpoints (
+  chain_id     VARCHAR NOT NULL PRIMARY KEY,
+  start_height BIGINT NOT NULL,
+  height       BIGINT NOT NULL
+);`); err != nil {
+		return nil, fmt.Errorf("creating the reindex_checkpoints table: %w", err)
+	}
+	return &sqlCheckpoint{db: db, chainID: chainID, placeholder: placeholder}, nil
+}
+
+func (c *sqlCheckpoint) query(q string) string {
+	return strings.NewReplacer("$", c.placeholder).Replace(q)
+}
+
+func (c *sqlCheckpoint) load() (start, height int64, ok bool, err error) {
+	err = c.db.QueryRow(c.query(`
+SELECT start_height, height FROM reindex_checkpoints WHERE chain_id = $1;`), c.chainID).Scan(&start, &height)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, 0, false, nil
+	} else if err != nil {
+		return 0, 0, false, fmt.Errorf("loading the re-index checkpoint: %w", err)
+	}
+	return start, height, true, nil
+}
+
+func (c *sqlCheckpoint) save(start, height int64) error {
+	_, err := c.db.Exec(c.query(`
+INSERT INTO reindex_checkpoints (chain_id, start_height, height) VALUES ($1, $2, $3)
+  ON CONFLICT (chain_id) DO UPDATE SET start_height = $2, height = $3;`), c.chainID, start, height)
+	return err
+}
+
+func (c *sqlCheckpoint) clear() error {
+	_, err := c.db.Exec(c.query(`
+DELETE FROM reindex_checkpoints WHERE chain_id = $1;`), c.chainID)
+	return err
+}
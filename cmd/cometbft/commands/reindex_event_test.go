@@ -3,6 +3,8 @@ package commands
 import (
 	"context"
 	"errors"
+	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -13,8 +15,11 @@ import (
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/internal/test"
+	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	blockmocks "github.com/cometbft/cometbft/state/indexer/mocks"
+	"github.com/cometbft/cometbft/state/indexer/sink/sqlite"
 	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/state/txindex/kv"
 	txmocks "github.com/cometbft/cometbft/state/txindex/mocks"
 	"github.com/cometbft/cometbft/types"
 )
@@ -97,11 +102,12 @@ func TestLoadEventSink(t *testing.T) {
 		cfg := cmtcfg.TestConfig()
 		cfg.TxIndex.Indexer = tc.sinks
 		cfg.TxIndex.PsqlConn = tc.connURL
-		_, _, err := loadEventSinks(cfg, test.DefaultTestChainID)
+		sinks, err := loadEventSinks(cfg, test.DefaultTestChainID)
 		if tc.loadErr {
 			require.Error(t, err, idx)
 		} else {
 			require.NoError(t, err, idx)
+			require.NoError(t, sinks.close(), idx)
 		}
 	}
 }
@@ -188,3 +194,158 @@ func TestReIndexEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestReIndexEventParallelCheckpoint(t *testing.T) {
+	const last int64 = 20
+
+	mockBlockStore := &mocks.BlockStore{}
+	mockBlockStore.
+		On("LoadBlock", mock.Anything).Return(&types.Block{Data: types.Data{Txs: types.Txs{types.Tx("tx")}}}, &types.BlockMeta{})
+
+	abciResp := &abcitypes.FinalizeBlockResponse{
+		TxResults: []*abcitypes.ExecTxResult{
+			{Code: 0},
+		},
+	}
+	mockStateStore := &mocks.Store{}
+	mockStateStore.
+		On("LoadFinalizeBlockResponse", int64(8)).Return(nil, errors.New("")).Once().
+		On("LoadFinalizeBlockResponse", mock.Anything).Return(abciResp, nil)
+
+	store := dbm.NewMemDB()
+	blockIndexer := blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))
+	checkpoint := kvCheckpoint{db: store}
+
+	args := eventReIndexArgs{
+		startHeight:     1,
+		endHeight:       last,
+		blockIndexer:    blockIndexer,
+		txIndexer:       kv.NewTxIndex(store),
+		blockStore:      mockBlockStore,
+		stateStore:      mockStateStore,
+		workers:         1,
+		batchSize:       3,
+		checkpoint:      checkpoint,
+		checkpointStart: 1,
+	}
+
+	// The checkpoint is the end of the last batch re-indexed before the error.
+	err := eventReIndex(setupReIndexEventCmd(), args)
+	require.Error(t, err)
+	start, cpHeight, ok, err := checkpoint.load()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(1), start)
+	require.Equal(t, int64(6), cpHeight)
+
+	// The checkpoint is ignored for another start height.
+	from, err := resumeFromCheckpoint(checkpoint, 2, last)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), from)
+
+	// Resume concurrently from the checkpoint.
+	args.startHeight, err = resumeFromCheckpoint(checkpoint, 1, last)
+	require.NoError(t, err)
+	require.Equal(t, cpHeight+1, args.startHeight)
+	args.workers = 4
+	err = eventReIndex(setupReIndexEventCmd(), args)
+	require.NoError(t, err)
+
+	for h := int64(1); h <= last; h++ {
+		has, err := blockIndexer.Has(h)
+		require.NoError(t, err)
+		require.True(t, has, h)
+	}
+	_, _, ok, err = checkpoint.load()
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestSQLCheckpoint(t *testing.T) {
+	es, err := sqlite.NewEventSink(filepath.Join(t.TempDir(), "index.sqlite"), "test-chain")
+	require.NoError(t, err)
+	defer es.Stop()
+
+	checkpoint, err := newSQLCheckpoint(es.DB(), "test-chain", "?")
+	require.NoError(t, err)
+	_, _, ok, err := checkpoint.load()
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, checkpoint.save(1, 5))
+	require.NoError(t, checkpoint.save(1, 9))
+	start, height, ok, err := checkpoint.load()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(1), start)
+	require.Equal(t, int64(9), height)
+
+	require.NoError(t, checkpoint.clear())
+	_, _, ok, err = checkpoint.load()
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// The table is created in a database installed with an older schema.
+	_, err = es.DB().Exec(`DROP TABLE reindex_checkpoints;`)
+	require.NoError(t, err)
+	checkpoint, err = newSQLCheckpoint(es.DB(), "test-chain", "?")
+	require.NoError(t, err)
+	require.NoError(t, checkpoint.save(1, 5))
+	_, height, ok, err = checkpoint.load()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(5), height)
+}
+
+// orderedBlockIndexer records the heights indexed.
+type orderedBlockIndexer struct {
+	*blockidxkv.BlockerIndexer
+	mtx     sync.Mutex
+	heights []int64
+}
+
+func (idx *orderedBlockIndexer) Index(e types.EventDataNewBlockEvents) error {
+	idx.mtx.Lock()
+	idx.heights = append(idx.heights, e.Height)
+	idx.mtx.Unlock()
+	return idx.BlockerIndexer.Index(e)
+}
+
+func TestReIndexEventHeightOrder(t *testing.T) {
+	const last int64 = 30
+
+	// The same tx is included at every height.
+	tx := types.Tx("tx")
+	mockBlockStore := &mocks.BlockStore{}
+	mockBlockStore.
+		On("LoadBlock", mock.Anything).Return(&types.Block{Data: types.Data{Txs: types.Txs{tx}}}, &types.BlockMeta{})
+	mockStateStore := &mocks.Store{}
+	mockStateStore.
+		On("LoadFinalizeBlockResponse", mock.Anything).Return(&abcitypes.FinalizeBlockResponse{
+		TxResults: []*abcitypes.ExecTxResult{{Code: 0}},
+	}, nil)
+
+	store := dbm.NewMemDB()
+	blockIndexer := &orderedBlockIndexer{BlockerIndexer: blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))}
+	txIndexer := kv.NewTxIndex(store)
+	args := eventReIndexArgs{
+		startHeight:  1,
+		endHeight:    last,
+		blockIndexer: blockIndexer,
+		txIndexer:    txIndexer,
+		blockStore:   mockBlockStore,
+		stateStore:   mockStateStore,
+		workers:      8,
+		batchSize:    2,
+	}
+	require.NoError(t, eventReIndex(setupReIndexEventCmd(), args))
+
+	// The heights are indexed in order, the latest result of the tx last.
+	require.Len(t, blockIndexer.heights, int(last))
+	for i, h := range blockIndexer.heights {
+		require.Equal(t, int64(i+1), h)
+	}
+	res, err := txIndexer.Get(tx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, last, res.Height)
+}
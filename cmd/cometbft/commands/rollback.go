@@ -13,15 +13,19 @@ import (
 	"github.com/cometbft/cometbft/store"
 )
 
-var removeBlock = false
+var (
+	removeBlock    = false
+	rollbackHeight int64
+)
 
 func init() {
 	RollbackStateCmd.Flags().BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
+	RollbackStateCmd.Flags().Int64Var(&rollbackHeight, "to-height", 0, "roll back to the given height instead of by one height")
 }
 
 var RollbackStateCmd = &cobra.Command{
 	Use:   "rollback",
-	Short: "rollback CometBFT state by one height",
+	Short: "rollback CometBFT state by one height or to a given height",
 	Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when CometBFT has persisted an incorrect app hash and is thus unable to make
@@ -30,9 +34,22 @@ The application should also roll back to height n - 1. If the --hard flag is not
 no blocks will be removed so upon restarting CometBFT the transactions in block n will be
 re-executed against the application. Using --hard will also remove block n. This can
 be done multiple times.
+
+With --to-height h, the state is rolled back to height h at once, to recover from a
+divergence several blocks back. The states and ABCI responses of the heights above h
+are deleted, as well as the blocks above h + 1. Block h + 1 is kept and re-executed
+upon restarting, unless --hard is used. The blocks from h + 1 and the validator sets
+from h must still be retained, and the blocks deleted must not have been moved to the
+cold storage.
+
+The application must be rolled back to height h before CometBFT is restarted: on
+restart, the Info response must report h as last block height, along with the app
+hash of that height, otherwise the handshake fails. If interrupted, the command can
+be run again with the same height to complete the rollback. Events of the deleted
+heights are indexed again as the blocks are executed again.
 `,
 	RunE: func(_ *cobra.Command, _ []string) error {
-		height, hash, err := RollbackState(config, removeBlock)
+		height, hash, err := RollbackState(config, removeBlock, rollbackHeight)
 		if err != nil {
 			return fmt.Errorf("failed to rollback state: %w", err)
 		}
@@ -48,9 +65,10 @@ be done multiple times.
 }
 
 // RollbackState takes the state at the current height n and overwrites it with the state
-// at height n - 1. Note state here refers to CometBFT state not application state.
+// at height n - 1, or with the state at toHeight if not 0. Note state here refers to
+// CometBFT state not application state.
 // Returns the latest state height and app hash alongside an error if there was one.
-func RollbackState(config *cfg.Config, removeBlock bool) (int64, []byte, error) {
+func RollbackState(config *cfg.Config, removeBlock bool, toHeight int64) (int64, []byte, error) {
 	// use the parsed config to load the block and state store
 	blockStore, stateStore, err := loadStateAndBlockStore(config)
 	if err != nil {
@@ -61,8 +79,11 @@ func RollbackState(config *cfg.Config, removeBlock bool) (int64, []byte, error)
 		_ = stateStore.Close()
 	}()
 
-	// rollback the last state
-	return state.Rollback(blockStore, stateStore, removeBlock)
+	if toHeight == 0 {
+		// rollback the last state
+		return state.Rollback(blockStore, stateStore, removeBlock)
+	}
+	return state.RollbackToHeight(blockStore, stateStore, toHeight, removeBlock)
 }
 
 func loadStateAndBlockStore(config *cfg.Config) (*store.BlockStore, state.Store, error) {
@@ -77,7 +98,11 @@ func loadStateAndBlockStore(config *cfg.Config) (*store.BlockStore, state.Store,
 	if err != nil {
 		return nil, nil, err
 	}
-	blockStore := store.NewBlockStore(blockStoreDB, store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout))
+	blockStore, err := store.OpenBlockStore(blockStoreDB, store.WithStorageConfig(config.Storage))
+	if err != nil {
+		_ = blockStoreDB.Close()
+		return nil, nil, err
+	}
 
 	if !os.FileExists(filepath.Join(config.DBDir(), "state.db")) {
 		return nil, nil, fmt.Errorf("no statestore found in %v", config.DBDir())
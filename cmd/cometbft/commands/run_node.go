@@ -3,6 +3,7 @@ package commands
 import (
 	"encoding/hex"
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
@@ -10,6 +11,10 @@ import (
 	nm "github.com/cometbft/cometbft/node"
 )
 
+// HaltExitCode is the exit code of the start command when the node stops
+// after consensus halted at the configured halt height or time.
+const HaltExitCode = 3
+
 var genesisHash []byte
 
 // AddNodeFlags exposes some common configuration options on the command-line
@@ -66,6 +71,10 @@ func AddNodeFlags(cmd *cobra.Command) {
 		"consensus.create_empty_blocks",
 		config.Consensus.CreateEmptyBlocks,
 		"set this to false to only produce blocks when there are txs or when the AppHash changes")
+	cmd.Flags().Int64("consensus.halt_height", config.Consensus.HaltHeight,
+		"stop consensus after committing the block at this height, and exit")
+	cmd.Flags().Int64("consensus.halt_time", config.Consensus.HaltTime,
+		"stop consensus after committing the first block at or after this Unix time, and exit")
 	cmd.Flags().String(
 		"consensus.create_empty_blocks_interval",
 		config.Consensus.CreateEmptyBlocksInterval.String(),
@@ -114,6 +123,16 @@ func NewRunNodeCmd(nodeProvider nm.Provider) *cobra.Command {
 				}
 			})
 
+			// Stop once consensus halts, if ever.
+			go func() {
+				<-n.ConsensusHalted()
+				logger.Info("Consensus halted; stopping the node", "height", n.BlockStore().Height())
+				if err := n.Stop(); err != nil {
+					logger.Error("unable to stop the node", "error", err)
+				}
+				os.Exit(HaltExitCode)
+			}()
+
 			// Run forever.
 			select {}
 		},
@@ -0,0 +1,202 @@
+package commands
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/light"
+	nm "github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/statesync"
+	"github.com/cometbft/cometbft/store"
+)
+
+var (
+	snapshotDir        string
+	restoreTrustHeight int64
+	restoreTrustHash   string
+)
+
+func init() {
+	StateSyncRestoreCmd.Flags().StringVar(&snapshotDir, "snapshot-dir", "",
+		"directory of the snapshot to restore")
+	StateSyncRestoreCmd.Flags().Int64Var(&restoreTrustHeight, "trust-height", 0,
+		"trusted height, overriding statesync.trust_height")
+	StateSyncRestoreCmd.Flags().StringVar(&restoreTrustHash, "trust-hash", "",
+		"hash of the trusted header, overriding statesync.trust_hash")
+	_ = StateSyncRestoreCmd.MarkFlagRequired("snapshot-dir")
+
+	StateSyncCmd.AddCommand(StateSyncRestoreCmd)
+}
+
+// StateSyncCmd groups the state sync commands.
+var StateSyncCmd = &cobra.Command{
+	Use:   "statesync",
+	Short: "State sync related commands",
+}
+
+// StateSyncRestoreCmd restores an app snapshot stored locally and bootstraps
+// the stores of a new node at its height.
+var StateSyncRestoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore an app snapshot stored locally, without state syncing from peers",
+	Long: `
+restore feeds the chunks of an app snapshot stored in a local directory to the
+application, through the same OfferSnapshot and ApplySnapshotChunk calls as state
+sync, then bootstraps the state and block stores at the height of the snapshot.
+Once restored, the node is started as usual, and block syncs from that height.
+
+The directory contains a snapshot.json file describing the snapshot:
+
+  {"height": 1000, "format": 1, "chunks": 2, "hash": "<hex>", "metadata": "<base64>"}
+
+and a file per chunk, named after its index: 0, 1, and so on.
+
+The snapshot is verified from the trusted height and hash, given by
+--trust-height and --trust-hash or by the statesync section of the configuration.
+To restore it offline, the directory also contains a light_blocks.json file
+holding the light blocks at the height of the snapshot and the two following
+heights, one of which is the trusted header, and the consensus parameters of the
+height following the snapshot:
+
+  {"light_blocks": [<light block>, <light block>, <light block>],
+   "consensus_params": <consensus parameters>}
+
+each light block being made of the signed header of the /commit RPC endpoint
+and the validator set of the /validators RPC endpoint at its height:
+
+  {"signed_header": {"header": ..., "commit": ...},
+   "validator_set": {"validators": [...], "proposer": ...}}
+
+The light blocks are verified from the trusted header, by their hashes and
+commits. Without this file, the app hash of the snapshot is verified by a light
+client as with state sync, fetching the headers from the statesync.rpc_servers.
+
+The application, set by proxy_app, must be running, and the stores of the node
+must be empty.
+`,
+	Example: `
+	cometbft statesync restore --snapshot-dir ./snapshot --trust-height 900 --trust-hash <hex>
+	`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		if restoreTrustHeight != 0 {
+			config.StateSync.TrustHeight = restoreTrustHeight
+		}
+		if restoreTrustHash != "" {
+			config.StateSync.TrustHash = restoreTrustHash
+		}
+		height, err := RestoreSnapshot(context.Background(), config, snapshotDir, nil)
+		if err != nil {
+			return fmt.Errorf("failed to restore the snapshot: %w", err)
+		}
+		fmt.Printf("Restored the snapshot at height %d\n", height)
+		return nil
+	},
+}
+
+// RestoreSnapshot restores the app snapshot stored in dir and bootstraps the
+// empty stores of the node of config at its height, which it returns. The
+// snapshot is verified by stateProvider. If nil, it is verified offline
+// against the light blocks stored in dir, if any, see
+// statesync.NewLocalStateProvider, or else by a light client fetching the
+// headers from the state sync RPC servers.
+func RestoreSnapshot(ctx context.Context, config *cfg.Config, dir string, stateProvider statesync.StateProvider) (int64, error) {
+	dbType := dbm.BackendType(config.DBBackend)
+	blockStoreDB, err := dbm.NewDB("blockstore", dbType, config.DBDir())
+	if err != nil {
+		return 0, err
+	}
+	blockStore, err := store.OpenBlockStore(blockStoreDB, store.WithStorageConfig(config.Storage))
+	if err != nil {
+		_ = blockStoreDB.Close()
+		return 0, err
+	}
+	defer blockStore.Close()
+	if !blockStore.IsEmpty() {
+		return 0, errors.New("the block store is not empty")
+	}
+
+	stateDB, err := dbm.NewDB("state", dbType, config.DBDir())
+	if err != nil {
+		return 0, err
+	}
+	stateStore := state.NewStore(stateDB, state.StoreOptions{
+		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+		DBKeyLayout:          config.Storage.ExperimentalKeyLayout,
+	})
+	defer stateStore.Close()
+	if st, err := stateStore.Load(); err != nil {
+		return 0, err
+	} else if !st.IsEmpty() {
+		return 0, errors.New("the state store is not empty")
+	}
+
+	genState, _, err := nm.LoadStateFromDBOrGenesisDocProviderWithConfig(
+		stateDB, nm.DefaultGenesisDocProviderFunc(config), config.Storage.GenesisHash, config)
+	if err != nil {
+		return 0, err
+	}
+
+	if stateProvider == nil {
+		if _, err := hex.DecodeString(config.StateSync.TrustHash); err != nil || config.StateSync.TrustHash == "" {
+			return 0, fmt.Errorf("invalid trust hash %q", config.StateSync.TrustHash)
+		}
+		trustOptions := light.TrustOptions{
+			Period: config.StateSync.TrustPeriod,
+			Height: config.StateSync.TrustHeight,
+			Hash:   config.StateSync.TrustHashBytes(),
+		}
+		if statesync.HasLocalLightBlocks(dir) {
+			stateProvider, err = statesync.NewLocalStateProvider(
+				genState.ChainID, genState.Version, genState.InitialHeight, trustOptions, dir)
+			if err != nil {
+				return 0, fmt.Errorf("failed to verify the light blocks of the snapshot: %w", err)
+			}
+		} else {
+			stateProvider, err = statesync.NewLightClientStateProviderWithDBKeyVersion(
+				ctx,
+				genState.ChainID, genState.Version, genState.InitialHeight,
+				config.StateSync.RPCServers, trustOptions, logger.With("module", "light"),
+				config.Storage.ExperimentalKeyLayout)
+			if err != nil {
+				return 0, fmt.Errorf("failed to set up light client state provider: %w", err)
+			}
+		}
+	}
+
+	proxyApp := proxy.NewAppConns(proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
+		proxy.NopMetrics())
+	proxyApp.SetLogger(logger.With("module", "proxy"))
+	if err := proxyApp.Start(); err != nil {
+		return 0, fmt.Errorf("failed to connect to the app: %w", err)
+	}
+	defer func() {
+		_ = proxyApp.Stop()
+	}()
+
+	st, commit, err := statesync.RestoreLocalSnapshot(*config.StateSync, logger.With("module", "statesync"),
+		proxyApp.Snapshot(), proxyApp.Query(), stateProvider, dir)
+	if err != nil {
+		return 0, err
+	}
+
+	// As after state sync, the node block syncs from the height of the
+	// snapshot once started.
+	if err := stateStore.Bootstrap(st); err != nil {
+		return 0, err
+	}
+	if err := blockStore.SaveSeenCommit(st.LastBlockHeight, commit); err != nil {
+		return 0, err
+	}
+	if err := stateStore.SetOfflineStateSyncHeight(st.LastBlockHeight); err != nil {
+		return 0, err
+	}
+	return st.LastBlockHeight, nil
+}
@@ -0,0 +1,212 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	cmtstore "github.com/cometbft/cometbft/api/cometbft/store/v1"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+var exportFromHeight, exportToHeight int64
+
+func init() {
+	StoreExportCmd.Flags().Int64Var(&exportFromHeight, "from", 0,
+		"height of the first block to export (defaults to the base of the block store)")
+	StoreExportCmd.Flags().Int64Var(&exportToHeight, "to", 0,
+		"height of the last block to export (defaults to the height of the block store)")
+
+	StoreCmd.AddCommand(StoreExportCmd)
+	StoreCmd.AddCommand(StoreImportCmd)
+}
+
+// StoreCmd groups the commands operating on the block store.
+var StoreCmd = &cobra.Command{
+	Use:   "store",
+	Short: "export and import blocks in a portable archive format",
+}
+
+var StoreExportCmd = &cobra.Command{
+	Use:   "export [archive-file]",
+	Short: "export a range of blocks to an archive file",
+	Long: `
+Export writes the blocks of the block store from height --from to height --to,
+with their commits and the FinalizeBlock responses of the application, if
+retained, to an archive file. The archive is independent of the database
+backend and of the key layout of the stores, and ends with a checksum of its
+contents.
+
+When the stores still hold it, the state after the last exported block is
+included in the archive, so that a node can be started from the imported
+blocks. The node must be stopped while exporting.
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		blockStore, stateStore, err := loadStateAndBlockStore(config)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = blockStore.Close()
+			_ = stateStore.Close()
+		}()
+
+		from, to := exportFromHeight, exportToHeight
+		if from == 0 {
+			from = blockStore.Base()
+		}
+		if to == 0 {
+			to = blockStore.Height()
+		}
+
+		f, err := os.OpenFile(args[0], os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return err
+		}
+		w := bufio.NewWriter(f)
+		hasState, err := store.ExportArchive(w, blockStore, stateStore, from, to)
+		if err == nil {
+			err = w.Flush()
+		}
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			_ = os.Remove(args[0])
+			return fmt.Errorf("failed to export blocks: %w", err)
+		}
+
+		fmt.Printf("Exported blocks %d to %d to %s\n", from, to, args[0])
+		if !hasState {
+			fmt.Printf("The state at height %d could not be loaded and was not included\n", to)
+		}
+		return nil
+	},
+}
+
+var StoreImportCmd = &cobra.Command{
+	Use:   "import [archive-file]",
+	Short: "import the blocks of an archive file",
+	Long: `
+Import verifies the checksum of an archive written by "store export", then
+saves its blocks into the block store, checking each block against its commit
+and the previous block. The blocks must follow the blocks already stored, if
+any; blocks already stored are skipped, so that an interrupted import can be
+resumed.
+
+The commit of each block is verified against its validator set, trusted if
+stored in the state store, or chained from the previous block. For an archive
+starting at the initial height of the genesis file, its validators are trusted
+for the first block. The import stops at the first block without a trusted
+validator set: its commit is never saved unverified.
+
+If the archive holds the state after its last block and the state store has no
+later state, the state store is bootstrapped with it, once checked against the
+block and the genesis file. As the state store then
+lacks the validator sets of the previous heights, the imported blocks cannot be
+replayed: a node started on them requires an application restored to the
+height of the last block. The node must be stopped while importing.
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		header, footer, err := verifyArchiveFile(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid archive: %w", err)
+		}
+		fmt.Printf("Verified archive of %d blocks (%d to %d) of chain %s\n",
+			footer.NumBlocks, header.FromHeight, header.ToHeight, header.ChainId)
+
+		blockStore, stateStore, err := openStateAndBlockStore(config)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = blockStore.Close()
+			_ = stateStore.Close()
+		}()
+
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+		if err != nil {
+			return fmt.Errorf("loading genesis file: %w", err)
+		}
+		if genDoc.ChainID != header.ChainId {
+			return fmt.Errorf("archive of chain %s, but the genesis file is for chain %s", header.ChainId, genDoc.ChainID)
+		}
+		imported, bootstrapped, err := store.ImportArchive(bufio.NewReader(f), blockStore, stateStore,
+			genesisValidatorSet(genDoc, header.FromHeight), genDoc.InitialHeight)
+		if err != nil {
+			return fmt.Errorf("failed to import blocks (%d imported): %w", imported, err)
+		}
+
+		fmt.Printf("Imported %d blocks, the block store now holds blocks %d to %d\n",
+			imported, blockStore.Base(), blockStore.Height())
+		switch {
+		case bootstrapped:
+			fmt.Printf("Bootstrapped the state at height %d\n", header.ToHeight)
+		case footer.State != nil:
+			fmt.Println("The state of the archive was not used: the state store has a later state," +
+				" or the blocks could not be verified from a trusted validator set")
+		}
+		return nil
+	},
+}
+
+// genesisValidatorSet returns the validators of genDoc, if height is its
+// initial height and it has any.
+func genesisValidatorSet(genDoc *types.GenesisDoc, height int64) *types.ValidatorSet {
+	if height != genDoc.InitialHeight || len(genDoc.Validators) == 0 {
+		return nil
+	}
+	validators := make([]*types.Validator, len(genDoc.Validators))
+	for i, val := range genDoc.Validators {
+		validators[i] = types.NewValidator(val.PubKey, val.Power)
+	}
+	return types.NewValidatorSet(validators)
+}
+
+func verifyArchiveFile(path string) (*cmtstore.ArchiveHeader, *cmtstore.ArchiveFooter, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	return store.VerifyArchive(bufio.NewReader(f))
+}
+
+// openStateAndBlockStore opens the block and state stores of config, creating
+// them if they do not exist yet.
+func openStateAndBlockStore(config *cfg.Config) (*store.BlockStore, state.Store, error) {
+	blockStoreDB, err := cfg.DefaultDBProvider(&cfg.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return nil, nil, err
+	}
+	stateDB, err := cfg.DefaultDBProvider(&cfg.DBContext{ID: "state", Config: config})
+	if err != nil {
+		_ = blockStoreDB.Close()
+		return nil, nil, err
+	}
+	blockStore, err := store.OpenBlockStore(blockStoreDB, store.WithStorageConfig(config.Storage))
+	if err != nil {
+		_ = blockStoreDB.Close()
+		_ = stateDB.Close()
+		return nil, nil, err
+	}
+	stateStore := state.NewStore(stateDB, state.StoreOptions{
+		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+		DBKeyLayout:          config.Storage.ExperimentalKeyLayout,
+		Compress:             config.Storage.CompressionEnabled(),
+	})
+	return blockStore, stateStore, nil
+}
@@ -0,0 +1,486 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+// VerifyStoreCmd checks that the block store, the state store and the indexes
+// of a stopped node are mutually consistent.
+var VerifyStoreCmd = &cobra.Command{
+	Use:     "verify-store",
+	Aliases: []string{"verify_store"},
+	Short:   "Check that the block store, the state store and the indexes are mutually consistent",
+	Long: `
+verify-store is an offline tooling checking the stores of a node after a crash or
+a disk issue. For each retained height, it checks that:
+
+- the block meta and all the parts of the block are present, and the parts match
+  the part set header;
+- the block hashes to its block ID and its last block ID is the one of the block
+  below;
+- the last commit of the block verifies against the stored validator set of the
+  height below, and the seen commit of the latest block against its own;
+- the FinalizeBlock response of the block is present, unless pruned or
+  discarded, and has a result per tx;
+- the block events and the txs are indexed, unless pruned from the indexes.
+
+It also checks the base and height of the block store against the state. Every
+inconsistency found is reported along with its height. With --repair-index, the
+heights missing from the indexes are re-indexed from the stores.
+
+The node must be stopped while the stores are checked.
+	`,
+	Example: `
+	cometbft debug verify-store
+	cometbft debug verify-store --start-height 100 --end-height 200
+	cometbft debug verify-store --repair-index
+	`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		bs, ss, err := loadStateAndBlockStore(config)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = bs.Close()
+			_ = ss.Close()
+		}()
+
+		st, err := ss.Load()
+		if err != nil {
+			return err
+		}
+
+		vsArgs := verifyStoreArgs{
+			startHeight: verifyStartHeight,
+			endHeight:   verifyEndHeight,
+			blockStore:  bs,
+			stateStore:  ss,
+			state:       st,
+		}
+		if !strings.EqualFold(config.TxIndex.Indexer, "null") {
+			sinks, err := loadEventSinks(config, st.ChainID)
+			if err != nil {
+				return err
+			}
+			defer sinks.close()
+			vsArgs.blockIndexer = sinks.blockIndexer
+			vsArgs.txIndexer = sinks.txIndexer
+		}
+
+		found, err := verifyStore(cmd.Context(), vsArgs)
+		if err != nil {
+			return err
+		}
+		for _, inc := range found {
+			fmt.Println(inc)
+		}
+
+		if verifyRepairIndex {
+			var repaired int
+			found, repaired, err = repairIndexGaps(cmd.Context(), vsArgs, found)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("re-indexed %d heights\n", repaired)
+		}
+
+		if len(found) > 0 {
+			return fmt.Errorf("found %d store inconsistencies", len(found))
+		}
+		fmt.Println("no store inconsistencies found")
+		return nil
+	},
+}
+
+var (
+	verifyStartHeight int64
+	verifyEndHeight   int64
+	verifyRepairIndex bool
+)
+
+func init() {
+	VerifyStoreCmd.Flags().Int64Var(&verifyStartHeight, "start-height", 0, "the first height to check (defaults to the base of the block store)")
+	VerifyStoreCmd.Flags().Int64Var(&verifyEndHeight, "end-height", 0, "the last height to check (defaults to the height of the block store)")
+	VerifyStoreCmd.Flags().BoolVar(&verifyRepairIndex, "repair-index", false, "re-index the heights missing from the block and tx indexes")
+}
+
+type verifyStoreArgs struct {
+	startHeight  int64
+	endHeight    int64
+	blockStore   state.BlockStore
+	stateStore   state.Store
+	state        state.State
+	blockIndexer indexer.BlockIndexer
+	txIndexer    txindex.TxIndexer
+}
+
+// storeInconsistency is an inconsistency between the stores at height, or
+// between the stores as a whole if height is 0.
+type storeInconsistency struct {
+	height int64
+	msg    string
+	// indexGap is true if the height is missing from an index, which is
+	// repaired by re-indexing the height.
+	indexGap bool
+}
+
+func (inc storeInconsistency) String() string {
+	if inc.height == 0 {
+		return inc.msg
+	}
+	return fmt.Sprintf("height %d: %s", inc.height, inc.msg)
+}
+
+// storeVerifier accumulates the inconsistencies found while checking the
+// stores height by height.
+type storeVerifier struct {
+	verifyStoreArgs
+	// Heights below the retain heights are not expected in the ABCI
+	// responses and in the indexes.
+	abciResRetainHeight  int64
+	blockIdxRetainHeight int64
+	txIdxRetainHeight    int64
+
+	found []storeInconsistency
+}
+
+func (v *storeVerifier) report(height int64, format string, args ...any) {
+	v.found = append(v.found, storeInconsistency{height: height, msg: fmt.Sprintf(format, args...)})
+}
+
+func (v *storeVerifier) reportIndexGap(height int64, format string, args ...any) {
+	v.found = append(v.found, storeInconsistency{height: height, msg: fmt.Sprintf(format, args...), indexGap: true})
+}
+
+// verifyStore checks the stores at the heights from args.startHeight to
+// args.endHeight, which default to the base and the height of the block store,
+// and returns the inconsistencies found. The indexes are not checked if
+// args.blockIndexer and args.txIndexer are nil.
+func verifyStore(ctx context.Context, args verifyStoreArgs) ([]storeInconsistency, error) {
+	v := &storeVerifier{verifyStoreArgs: args}
+
+	var err error
+	if v.abciResRetainHeight, err = retainHeight(args.stateStore.GetABCIResRetainHeight); err != nil {
+		return nil, fmt.Errorf("loading the ABCI responses retain height: %w", err)
+	}
+	if args.blockIndexer != nil {
+		if v.blockIdxRetainHeight, err = retainHeight(args.blockIndexer.GetRetainHeight); err != nil {
+			return nil, fmt.Errorf("loading the block indexer retain height: %w", err)
+		}
+	}
+	if args.txIndexer != nil {
+		if v.txIdxRetainHeight, err = retainHeight(args.txIndexer.GetRetainHeight); err != nil {
+			return nil, fmt.Errorf("loading the tx indexer retain height: %w", err)
+		}
+	}
+
+	base, height := args.blockStore.Base(), args.blockStore.Height()
+	if !v.verifyBaseAndHeight(base, height) {
+		return v.found, nil
+	}
+
+	from, to := args.startHeight, args.endHeight
+	if from == 0 {
+		from = base
+	}
+	if to == 0 || to > height {
+		to = height
+	}
+	if from < base || from > to {
+		return nil, fmt.Errorf("%w (requested heights %d to %d, block store heights %d to %d)",
+			ErrHeightNotAvailable, from, to, base, height)
+	}
+
+	var prevMeta *types.BlockMeta
+	if from > base {
+		prevMeta = v.loadBlockMeta(from - 1)
+	}
+	for h := from; h <= to; h++ {
+		if err := ctx.Err(); err != nil {
+			return v.found, fmt.Errorf("store verification terminated at height %d: %w", h, err)
+		}
+		prevMeta = v.verifyHeight(h, prevMeta)
+	}
+	return v.found, nil
+}
+
+// verifyBaseAndHeight checks the base and the height of the block store
+// against each other and against the state. It returns false if the block
+// store is empty or its range of heights is invalid.
+func (v *storeVerifier) verifyBaseAndHeight(base, height int64) bool {
+	switch {
+	case base == 0 && height == 0:
+		if v.state.LastBlockHeight > 0 {
+			v.report(0, "empty block store, but the state is at height %d", v.state.LastBlockHeight)
+		}
+		return false
+	case base <= 0 || height <= 0 || base > height:
+		v.report(0, "invalid block store base %d and height %d", base, height)
+		return false
+	}
+
+	// The block is saved before the state is updated, so the state is at the
+	// height of the block store or just below.
+	switch lastHeight := v.state.LastBlockHeight; {
+	case lastHeight > height:
+		v.report(0, "state height %d is above the block store height %d", lastHeight, height)
+	case lastHeight < height-1:
+		v.report(0, "state height %d is more than one height below the block store height %d", lastHeight, height)
+	case lastHeight >= base:
+		if meta := v.loadBlockMeta(lastHeight); meta != nil && !meta.BlockID.Equals(v.state.LastBlockID) {
+			v.report(0, "state last block ID %v does not match the block ID %v at height %d",
+				v.state.LastBlockID, meta.BlockID, lastHeight)
+		}
+	}
+	return true
+}
+
+// verifyHeight checks the stores at height, where prevMeta is the block meta
+// at the height below, if any. It returns the block meta at height.
+func (v *storeVerifier) verifyHeight(height int64, prevMeta *types.BlockMeta) *types.BlockMeta {
+	meta := v.loadBlockMeta(height)
+	if meta == nil {
+		return nil
+	}
+	if meta.Header.Height != height {
+		v.report(height, "block meta is at height %d", meta.Header.Height)
+	}
+
+	block := v.loadBlock(height, meta)
+	if block == nil {
+		return meta
+	}
+
+	if prevMeta != nil && !block.LastBlockID.Equals(prevMeta.BlockID) {
+		v.report(height, "last block ID %v does not match the block ID %v at height %d",
+			block.LastBlockID, prevMeta.BlockID, height-1)
+	}
+
+	v.verifyCommits(height, block)
+
+	// The latest block may not have been executed yet.
+	if height > v.state.LastBlockHeight {
+		return meta
+	}
+	v.verifyFinalizeBlockResponse(height, block)
+	v.verifyIndexes(height, block)
+	return meta
+}
+
+// loadBlock loads the parts of the block at height and checks them against
+// meta, then checks the block they make up.
+func (v *storeVerifier) loadBlock(height int64, meta *types.BlockMeta) *types.Block {
+	psh := meta.BlockID.PartSetHeader
+	var (
+		buf      []byte
+		complete = true
+	)
+	for i := 0; i < int(psh.Total); i++ {
+		var part *types.Part
+		if err := recoverLoad(func() { part = v.blockStore.LoadBlockPart(height, i) }); err != nil {
+			v.report(height, "corrupted block part %d: %v", i, err)
+			complete = false
+			continue
+		}
+		if part == nil {
+			v.report(height, "missing block part %d of %d", i, psh.Total)
+			complete = false
+			continue
+		}
+		if err := part.Proof.Verify(psh.Hash, part.Bytes); err != nil {
+			v.report(height, "block part %d does not match the part set header: %v", i, err)
+			complete = false
+			continue
+		}
+		buf = append(buf, part.Bytes...)
+	}
+	if !complete {
+		return nil
+	}
+
+	pbb := new(cmtproto.Block)
+	if err := proto.Unmarshal(buf, pbb); err != nil {
+		v.report(height, "decoding the block: %v", err)
+		return nil
+	}
+	block, err := types.BlockFromProto(pbb)
+	if err != nil {
+		v.report(height, "decoding the block: %v", err)
+		return nil
+	}
+	if !bytes.Equal(block.Hash(), meta.BlockID.Hash) {
+		v.report(height, "block hash %X does not match the block ID %v", block.Hash(), meta.BlockID)
+	}
+	if err := block.ValidateBasic(); err != nil {
+		v.report(height, "invalid block: %v", err)
+	}
+	return block
+}
+
+// verifyCommits checks the last commit of the block at height against the
+// validator set of the height below, and the seen commit of the latest block
+// against its validator set.
+func (v *storeVerifier) verifyCommits(height int64, block *types.Block) {
+	chainID := v.state.ChainID
+
+	vals, err := v.stateStore.LoadValidators(height)
+	if err != nil {
+		v.report(height, "missing validator set: %v", err)
+	} else if !bytes.Equal(vals.Hash(), block.ValidatorsHash) {
+		v.report(height, "validator set hash %X does not match the block validators hash %X",
+			vals.Hash(), block.ValidatorsHash)
+	}
+
+	if height > v.state.InitialHeight {
+		lastVals, err := v.stateStore.LoadValidators(height - 1)
+		switch {
+		case err != nil && height == v.blockStore.Base():
+			// The validator set below the base may have been pruned.
+		case err != nil:
+			v.report(height, "missing validator set at height %d: %v", height-1, err)
+		default:
+			if err := lastVals.VerifyCommit(chainID, block.LastBlockID, height-1, block.LastCommit); err != nil {
+				v.report(height, "last commit does not verify against the validator set at height %d: %v", height-1, err)
+			}
+		}
+	}
+
+	if height != v.blockStore.Height() || vals == nil {
+		return
+	}
+	var seenCommit *types.Commit
+	if err := recoverLoad(func() { seenCommit = v.blockStore.LoadSeenCommit(height) }); err != nil {
+		v.report(height, "corrupted seen commit: %v", err)
+		return
+	}
+	if seenCommit == nil {
+		v.report(height, "missing seen commit")
+		return
+	}
+	if err := vals.VerifyCommit(chainID, seenCommit.BlockID, height, seenCommit); err != nil {
+		v.report(height, "seen commit does not verify against the validator set: %v", err)
+	} else if !bytes.Equal(seenCommit.BlockID.Hash, block.Hash()) {
+		v.report(height, "seen commit is for block %X", seenCommit.BlockID.Hash)
+	}
+}
+
+// verifyFinalizeBlockResponse checks the FinalizeBlock response of the block
+// at height is stored, unless discarded or pruned.
+func (v *storeVerifier) verifyFinalizeBlockResponse(height int64, block *types.Block) {
+	if height < v.abciResRetainHeight {
+		return
+	}
+	resp, err := v.stateStore.LoadFinalizeBlockResponse(height)
+	switch {
+	case errors.Is(err, state.ErrFinalizeBlockResponsesNotPersisted):
+	case err != nil:
+		v.report(height, "missing FinalizeBlock response: %v", err)
+	case len(resp.TxResults) != len(block.Txs):
+		v.report(height, "FinalizeBlock response has %d tx results for %d txs", len(resp.TxResults), len(block.Txs))
+	}
+}
+
+// verifyIndexes checks the events and the txs of the block at height are
+// indexed, unless pruned from the indexes.
+func (v *storeVerifier) verifyIndexes(height int64, block *types.Block) {
+	if v.blockIndexer != nil && height >= v.blockIdxRetainHeight {
+		has, err := v.blockIndexer.Has(height)
+		switch {
+		case err != nil:
+			v.report(height, "checking the block index: %v", err)
+		case !has:
+			v.reportIndexGap(height, "block events are not indexed")
+		}
+	}
+
+	if v.txIndexer != nil && height >= v.txIdxRetainHeight {
+		for i, tx := range block.Txs {
+			res, err := v.txIndexer.Get(tx.Hash())
+			switch {
+			case err != nil:
+				v.report(height, "checking the tx index of tx %d: %v", i, err)
+			case res == nil:
+				v.reportIndexGap(height, "tx %d (%X) is not indexed", i, tx.Hash())
+			}
+		}
+	}
+}
+
+// loadBlockMeta loads the block meta at height, reporting it if missing or
+// corrupted.
+func (v *storeVerifier) loadBlockMeta(height int64) *types.BlockMeta {
+	var meta *types.BlockMeta
+	if err := recoverLoad(func() { meta = v.blockStore.LoadBlockMeta(height) }); err != nil {
+		v.report(height, "corrupted block meta: %v", err)
+		return nil
+	}
+	if meta == nil {
+		v.report(height, "missing block meta")
+	}
+	return meta
+}
+
+// repairIndexGaps re-indexes the heights of the index gaps in found. It
+// returns the inconsistencies left and the number of heights re-indexed.
+func repairIndexGaps(ctx context.Context, args verifyStoreArgs, found []storeInconsistency) ([]storeInconsistency, int, error) {
+	riArgs := eventReIndexArgs{
+		blockIndexer: args.blockIndexer,
+		txIndexer:    args.txIndexer,
+		blockStore:   args.blockStore,
+		stateStore:   args.stateStore,
+	}
+
+	var (
+		left     []storeInconsistency
+		repaired = make(map[int64]bool)
+	)
+	for _, inc := range found {
+		if !inc.indexGap {
+			left = append(left, inc)
+			continue
+		}
+		if repaired[inc.height] {
+			continue
+		}
+		if err := reindexBatch(ctx, riArgs, firstReindexBatchTurn(inc.height), inc.height); err != nil {
+			return nil, 0, fmt.Errorf("re-indexing height %d: %w", inc.height, err)
+		}
+		repaired[inc.height] = true
+	}
+	return left, len(repaired), nil
+}
+
+// retainHeight returns the height returned by get, or 0 if no retain height is
+// set.
+func retainHeight(get func() (int64, error)) (int64, error) {
+	height, err := get()
+	if errors.Is(err, state.ErrKeyNotFound) {
+		return 0, nil
+	}
+	return height, err
+}
+
+// recoverLoad runs load, turning the panic of the stores on a corrupted entry
+// into an error.
+func recoverLoad(load func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	load()
+	return nil
+}
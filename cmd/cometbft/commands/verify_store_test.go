@@ -0,0 +1,131 @@
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/state"
+	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
+	"github.com/cometbft/cometbft/state/txindex/kv"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+// makeVerifyStoreArgs returns the stores of a chain of numBlocks blocks of 2
+// txs, signed by a single validator, with all the heights but skipIndex
+// indexed.
+func makeVerifyStoreArgs(t *testing.T, numBlocks, skipIndex int64) verifyStoreArgs {
+	t.Helper()
+	pv := types.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	genDoc := test.GenesisDoc(time.Now(), []*types.Validator{types.NewValidator(pubKey, 10)},
+		test.ConsensusParams(), test.DefaultTestChainID)
+
+	bs := store.NewBlockStore(dbm.NewMemDB())
+	ss := state.NewStore(dbm.NewMemDB(), state.StoreOptions{})
+	st, err := ss.LoadFromDBOrGenesisDoc(genDoc)
+	require.NoError(t, err)
+	require.NoError(t, ss.Save(st))
+
+	idxDB := dbm.NewMemDB()
+	args := verifyStoreArgs{
+		blockStore:   bs,
+		stateStore:   ss,
+		blockIndexer: blockidxkv.New(dbm.NewPrefixDB(idxDB, []byte("block_events"))),
+		txIndexer:    kv.NewTxIndex(idxDB),
+	}
+
+	lastCommit := new(types.Commit)
+	for h := int64(1); h <= numBlocks; h++ {
+		block := st.MakeBlock(h, test.MakeNTxs(h, 2), lastCommit, nil, st.Validators.GetProposer().Address)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+		lastCommit, err = test.MakeCommit(blockID, h, 0, st.Validators, []types.PrivValidator{pv}, st.ChainID, time.Now())
+		require.NoError(t, err)
+		bs.SaveBlock(block, partSet, lastCommit)
+
+		txResults := []*abci.ExecTxResult{{Code: abci.CodeTypeOK}, {Code: abci.CodeTypeOK}}
+		require.NoError(t, ss.SaveFinalizeBlockResponse(h, &abci.FinalizeBlockResponse{TxResults: txResults}))
+		st.LastBlockHeight = h
+		st.LastBlockID = blockID
+		st.LastValidators = st.Validators.Copy()
+		require.NoError(t, ss.Save(st))
+
+		if h != skipIndex {
+			riArgs := eventReIndexArgs{
+				blockIndexer: args.blockIndexer,
+				txIndexer:    args.txIndexer,
+				blockStore:   bs,
+				stateStore:   ss,
+			}
+			require.NoError(t, reindexBatch(context.Background(), riArgs, firstReindexBatchTurn(h), h))
+		}
+	}
+	args.state = st
+	return args
+}
+
+func TestVerifyStore(t *testing.T) {
+	args := makeVerifyStoreArgs(t, 4, 0)
+
+	found, err := verifyStore(context.Background(), args)
+	require.NoError(t, err)
+	assert.Empty(t, found)
+
+	// The state is ahead of the block store.
+	args.state.LastBlockHeight = 5
+	found, err = verifyStore(context.Background(), args)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.EqualValues(t, 0, found[0].height)
+	args.state.LastBlockHeight = 4
+
+	// The response of the FinalizeBlock at height 2 lost its tx results.
+	require.NoError(t, args.stateStore.SaveFinalizeBlockResponse(2, &abci.FinalizeBlockResponse{}))
+	found, err = verifyStore(context.Background(), args)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.EqualValues(t, 2, found[0].height)
+	assert.False(t, found[0].indexGap)
+
+	// Out of the requested heights.
+	args.startHeight = 3
+	found, err = verifyStore(context.Background(), args)
+	require.NoError(t, err)
+	assert.Empty(t, found)
+
+	args.startHeight = 5
+	_, err = verifyStore(context.Background(), args)
+	require.ErrorIs(t, err, ErrHeightNotAvailable)
+}
+
+func TestVerifyStoreRepairIndex(t *testing.T) {
+	args := makeVerifyStoreArgs(t, 4, 3)
+
+	found, err := verifyStore(context.Background(), args)
+	require.NoError(t, err)
+	// The block events and the two txs at height 3 are not indexed.
+	require.Len(t, found, 3)
+	for _, inc := range found {
+		assert.EqualValues(t, 3, inc.height)
+		assert.True(t, inc.indexGap)
+	}
+
+	left, repaired, err := repairIndexGaps(context.Background(), args, found)
+	require.NoError(t, err)
+	assert.Empty(t, left)
+	assert.Equal(t, 1, repaired)
+
+	found, err = verifyStore(context.Background(), args)
+	require.NoError(t, err)
+	assert.Empty(t, found)
+}
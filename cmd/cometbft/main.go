@@ -13,6 +13,7 @@ import (
 
 func main() {
 	rootCmd := cmd.RootCmd
+	debug.DebugCmd.AddCommand(cmd.VerifyStoreCmd)
 	rootCmd.AddCommand(
 		cmd.GenValidatorCmd,
 		cmd.InitFilesCmd,
@@ -27,7 +28,10 @@ func main() {
 		cmd.VersionCmd,
 		cmd.RollbackStateCmd,
 		cmd.CompactGoLevelDBCmd,
+		cmd.StoreCmd,
+		cmd.MigrateDBCmd,
 		cmd.InspectCmd,
+		cmd.StateSyncCmd,
 		debug.DebugCmd,
 		cli.NewCompletionCmd(rootCmd, true),
 	)
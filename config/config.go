@@ -133,6 +133,8 @@ func (cfg *Config) SetRoot(root string) *Config {
 	cfg.P2P.RootDir = root
 	cfg.Mempool.RootDir = root
 	cfg.Consensus.RootDir = root
+	cfg.TxIndex.RootDir = root
+	cfg.Storage.RootDir = root
 	return cfg
 }
 
@@ -551,6 +553,19 @@ type GRPCConfig struct {
 	// If no height is provided, the block results of the latest height are returned
 	BlockResultsService *GRPCBlockResultsServiceConfig `mapstructure:"block_results_service"`
 
+	// The gRPC validator set service provides the validator set for a given
+	// height. If no height is provided, the validator set of the latest height
+	// is returned.
+	ValidatorSetService *GRPCValidatorSetServiceConfig `mapstructure:"validator_set_service"`
+
+	// The gRPC consensus params service provides the consensus parameters for
+	// a given height. If no height is provided, the consensus parameters of the
+	// latest height are returned.
+	ConsensusParamsService *GRPCConsensusParamsServiceConfig `mapstructure:"consensus_params_service"`
+
+	// The gRPC ABCI query service queries the application.
+	ABCIQueryService *GRPCABCIQueryServiceConfig `mapstructure:"abci_query_service"`
+
 	// The "privileged" section provides configuration for the gRPC server
 	// dedicated to privileged clients.
 	Privileged *GRPCPrivilegedConfig `mapstructure:"privileged"`
@@ -558,21 +573,27 @@ type GRPCConfig struct {
 
 func DefaultGRPCConfig() *GRPCConfig {
 	return &GRPCConfig{
-		ListenAddress:       "",
-		VersionService:      DefaultGRPCVersionServiceConfig(),
-		BlockService:        DefaultGRPCBlockServiceConfig(),
-		BlockResultsService: DefaultGRPCBlockResultsServiceConfig(),
-		Privileged:          DefaultGRPCPrivilegedConfig(),
+		ListenAddress:          "",
+		VersionService:         DefaultGRPCVersionServiceConfig(),
+		BlockService:           DefaultGRPCBlockServiceConfig(),
+		BlockResultsService:    DefaultGRPCBlockResultsServiceConfig(),
+		ValidatorSetService:    DefaultGRPCValidatorSetServiceConfig(),
+		ConsensusParamsService: DefaultGRPCConsensusParamsServiceConfig(),
+		ABCIQueryService:       DefaultGRPCABCIQueryServiceConfig(),
+		Privileged:             DefaultGRPCPrivilegedConfig(),
 	}
 }
 
 func TestGRPCConfig() *GRPCConfig {
 	return &GRPCConfig{
-		ListenAddress:       "tcp://127.0.0.1:36670",
-		VersionService:      TestGRPCVersionServiceConfig(),
-		BlockService:        TestGRPCBlockServiceConfig(),
-		BlockResultsService: DefaultGRPCBlockResultsServiceConfig(),
-		Privileged:          TestGRPCPrivilegedConfig(),
+		ListenAddress:          "tcp://127.0.0.1:36670",
+		VersionService:         TestGRPCVersionServiceConfig(),
+		BlockService:           TestGRPCBlockServiceConfig(),
+		BlockResultsService:    DefaultGRPCBlockResultsServiceConfig(),
+		ValidatorSetService:    DefaultGRPCValidatorSetServiceConfig(),
+		ConsensusParamsService: DefaultGRPCConsensusParamsServiceConfig(),
+		ABCIQueryService:       DefaultGRPCABCIQueryServiceConfig(),
+		Privileged:             TestGRPCPrivilegedConfig(),
 	}
 }
 
@@ -631,6 +652,36 @@ func TestGRPCBlockServiceConfig() *GRPCBlockServiceConfig {
 	}
 }
 
+type GRPCValidatorSetServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+func DefaultGRPCValidatorSetServiceConfig() *GRPCValidatorSetServiceConfig {
+	return &GRPCValidatorSetServiceConfig{
+		Enabled: true,
+	}
+}
+
+type GRPCConsensusParamsServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+func DefaultGRPCConsensusParamsServiceConfig() *GRPCConsensusParamsServiceConfig {
+	return &GRPCConsensusParamsServiceConfig{
+		Enabled: true,
+	}
+}
+
+type GRPCABCIQueryServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+func DefaultGRPCABCIQueryServiceConfig() *GRPCABCIQueryServiceConfig {
+	return &GRPCABCIQueryServiceConfig{
+		Enabled: true,
+	}
+}
+
 // -----------------------------------------------------------------------------
 // GRPCPrivilegedConfig
 
@@ -644,12 +695,17 @@ type GRPCPrivilegedConfig struct {
 	// The gRPC pruning service provides control over the depth of block
 	// storage information that the node
 	PruningService *GRPCPruningServiceConfig `mapstructure:"pruning_service"`
+
+	// The gRPC halt service allows to stop consensus after committing a given
+	// height.
+	HaltService *GRPCHaltServiceConfig `mapstructure:"halt_service"`
 }
 
 func DefaultGRPCPrivilegedConfig() *GRPCPrivilegedConfig {
 	return &GRPCPrivilegedConfig{
 		ListenAddress:  "",
 		PruningService: DefaultGRPCPruningServiceConfig(),
+		HaltService:    DefaultGRPCHaltServiceConfig(),
 	}
 }
 
@@ -657,6 +713,7 @@ func TestGRPCPrivilegedConfig() *GRPCPrivilegedConfig {
 	return &GRPCPrivilegedConfig{
 		ListenAddress:  "tcp://127.0.0.1:36671",
 		PruningService: TestGRPCPruningServiceConfig(),
+		HaltService:    TestGRPCHaltServiceConfig(),
 	}
 }
 
@@ -676,6 +733,22 @@ func TestGRPCPruningServiceConfig() *GRPCPruningServiceConfig {
 	}
 }
 
+type GRPCHaltServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+func DefaultGRPCHaltServiceConfig() *GRPCHaltServiceConfig {
+	return &GRPCHaltServiceConfig{
+		Enabled: false,
+	}
+}
+
+func TestGRPCHaltServiceConfig() *GRPCHaltServiceConfig {
+	return &GRPCHaltServiceConfig{
+		Enabled: true,
+	}
+}
+
 // -----------------------------------------------------------------------------
 // P2PConfig
 
@@ -988,6 +1061,7 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 // StateSyncConfig defines the configuration for the CometBFT state sync service.
 type StateSyncConfig struct {
 	Enable              bool          `mapstructure:"enable"`
+	UseP2P              bool          `mapstructure:"use_p2p"`
 	TempDir             string        `mapstructure:"temp_dir"`
 	RPCServers          []string      `mapstructure:"rpc_servers"`
 	TrustPeriod         time.Duration `mapstructure:"trust_period"`
@@ -996,6 +1070,7 @@ type StateSyncConfig struct {
 	DiscoveryTime       time.Duration `mapstructure:"discovery_time"`
 	ChunkRequestTimeout time.Duration `mapstructure:"chunk_request_timeout"`
 	ChunkFetchers       int32         `mapstructure:"chunk_fetchers"`
+	BackfillBlocks      int64         `mapstructure:"backfill_blocks"`
 }
 
 func (cfg *StateSyncConfig) TrustHashBytes() []byte {
@@ -1025,17 +1100,21 @@ func TestStateSyncConfig() *StateSyncConfig {
 // ValidateBasic performs basic validation.
 func (cfg *StateSyncConfig) ValidateBasic() error {
 	if cfg.Enable {
-		if len(cfg.RPCServers) == 0 {
-			return cmterrors.ErrRequiredField{Field: "rpc_servers"}
-		}
+		// The RPC servers are not needed if the light blocks are fetched
+		// from peers.
+		if !cfg.UseP2P {
+			if len(cfg.RPCServers) == 0 {
+				return cmterrors.ErrRequiredField{Field: "rpc_servers"}
+			}
 
-		if len(cfg.RPCServers) < 2 {
-			return ErrNotEnoughRPCServers
-		}
+			if len(cfg.RPCServers) < 2 {
+				return ErrNotEnoughRPCServers
+			}
 
-		for _, server := range cfg.RPCServers {
-			if len(server) == 0 {
-				return ErrEmptyRPCServerEntry
+			for _, server := range cfg.RPCServers {
+				if len(server) == 0 {
+					return ErrEmptyRPCServerEntry
+				}
 			}
 		}
 
@@ -1067,6 +1146,10 @@ func (cfg *StateSyncConfig) ValidateBasic() error {
 		if cfg.ChunkFetchers <= 0 {
 			return cmterrors.ErrRequiredField{Field: "chunk_fetchers"}
 		}
+
+		if cfg.BackfillBlocks < 0 {
+			return cmterrors.ErrNegativeField{Field: "backfill_blocks"}
+		}
 	}
 
 	return nil
@@ -1135,6 +1218,20 @@ type ConsensusConfig struct {
 	PeerGossipIntraloopSleepDuration time.Duration `mapstructure:"peer_gossip_intraloop_sleep_duration"` // upper bound on randomly selected values
 
 	DoubleSignCheckHeight int64 `mapstructure:"double_sign_check_height"`
+
+	// Compact block relay: gossip proposal blocks as a header plus transaction
+	// hashes, letting peers rebuild them from their mempools.
+	CompactBlocks bool `mapstructure:"compact_blocks"`
+	// How long to wait for a peer to rebuild a compact block before falling
+	// back to gossiping the block parts.
+	CompactBlocksFallbackTimeout time.Duration `mapstructure:"compact_blocks_fallback_timeout"`
+
+	// Stop consensus after committing the block at this height, e.g. to
+	// upgrade the node. 0 disables it.
+	HaltHeight int64 `mapstructure:"halt_height"`
+	// Stop consensus after committing the first block whose time is equal to
+	// or later than this Unix time, in seconds. 0 disables it.
+	HaltTime int64 `mapstructure:"halt_time"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service.
@@ -1152,6 +1249,10 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		PeerQueryMaj23SleepDuration:      2000 * time.Millisecond,
 		PeerGossipIntraloopSleepDuration: 0 * time.Second,
 		DoubleSignCheckHeight:            int64(0),
+		CompactBlocks:                    false,
+		CompactBlocksFallbackTimeout:     300 * time.Millisecond,
+		HaltHeight:                       0,
+		HaltTime:                         0,
 	}
 }
 
@@ -1244,6 +1345,15 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.DoubleSignCheckHeight < 0 {
 		return cmterrors.ErrNegativeField{Field: "double_sign_check_height"}
 	}
+	if cfg.CompactBlocksFallbackTimeout < 0 {
+		return cmterrors.ErrNegativeField{Field: "compact_blocks_fallback_timeout"}
+	}
+	if cfg.HaltHeight < 0 {
+		return cmterrors.ErrNegativeField{Field: "halt_height"}
+	}
+	if cfg.HaltTime < 0 {
+		return cmterrors.ErrNegativeField{Field: "halt_time"}
+	}
 	return nil
 }
 
@@ -1253,6 +1363,8 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 // StorageConfig allows more fine-grained control over certain storage-related
 // behavior.
 type StorageConfig struct {
+	RootDir string `mapstructure:"home"`
+
 	// Set to false to ensure ABCI responses are persisted. ABCI responses are
 	// required for `/block_results` RPC queries, and to reindex events in the
 	// command-line tool.
@@ -1288,18 +1400,45 @@ type StorageConfig struct {
 	// Not that this is an experimental feature and switching back from v2 to v1
 	// is not supported by CometBFT.
 	ExperimentalKeyLayout string `mapstructure:"experimental_db_key_layout"`
+
+	// Cold storage tier - number of most recent blocks kept in the block
+	// store database. Older blocks are moved to compressed, immutable
+	// segment files of ColdStorageSegmentSize blocks in ColdStoragePath,
+	// from which they are still served. 0 by default (cold storage is
+	// disabled).
+	ColdStorageDepth int64 `mapstructure:"cold_storage_depth"`
+	// Path to the directory of the segment files, relative to the home
+	// directory if not absolute.
+	ColdStoragePath string `mapstructure:"cold_storage_path"`
+	// Number of blocks per segment file.
+	ColdStorageSegmentSize int64 `mapstructure:"cold_storage_segment_size"`
+
+	// Compression of the block parts and FinalizeBlock responses written to
+	// the databases - "none" or "zstd". Values are compressed individually
+	// and marked as such, so that compressed and uncompressed values can be
+	// loaded whatever this setting. "none" by default.
+	Compression string `mapstructure:"compression"`
+	// Recompress - if compression is enabled, rewrite in the background the
+	// block parts and FinalizeBlock responses stored uncompressed when the
+	// node starts. false by default.
+	Recompress bool `mapstructure:"recompress"`
 }
 
 // DefaultStorageConfig returns the default configuration options relating to
 // CometBFT storage optimization.
 func DefaultStorageConfig() *StorageConfig {
 	return &StorageConfig{
-		DiscardABCIResponses:  false,
-		Pruning:               DefaultPruningConfig(),
-		Compact:               false,
-		CompactionInterval:    1000,
-		GenesisHash:           "",
-		ExperimentalKeyLayout: "v1",
+		DiscardABCIResponses:   false,
+		Pruning:                DefaultPruningConfig(),
+		Compact:                false,
+		CompactionInterval:     1000,
+		GenesisHash:            "",
+		ExperimentalKeyLayout:  "v1",
+		ColdStorageDepth:       0,
+		ColdStoragePath:        filepath.Join(DefaultDataDir, "cold"),
+		ColdStorageSegmentSize: 10000,
+		Compression:            "none",
+		Recompress:             false,
 	}
 }
 
@@ -1320,9 +1459,32 @@ func (cfg *StorageConfig) ValidateBasic() error {
 	if cfg.ExperimentalKeyLayout != "v1" && cfg.ExperimentalKeyLayout != "v2" {
 		return fmt.Errorf("unsupported version of DB Key layout, expected v1 or v2, got %s", cfg.ExperimentalKeyLayout)
 	}
+	if cfg.ColdStorageDepth < 0 {
+		return cmterrors.ErrNegativeField{Field: "cold_storage_depth"}
+	}
+	if cfg.ColdStorageDepth > 0 && cfg.ColdStorageSegmentSize <= 0 {
+		return errors.New("cold_storage_segment_size must be positive when the cold storage is enabled")
+	}
+	switch cfg.Compression {
+	case "", "none", "zstd":
+	default:
+		return fmt.Errorf("unsupported compression, expected none or zstd, got %s", cfg.Compression)
+	}
 	return nil
 }
 
+// CompressionEnabled returns true if the values written to the databases are
+// compressed.
+func (cfg *StorageConfig) CompressionEnabled() bool {
+	return cfg.Compression == "zstd"
+}
+
+// ColdStorageDir returns the full path to the directory of the segment files
+// of the cold storage.
+func (cfg *StorageConfig) ColdStorageDir() string {
+	return rootify(cfg.ColdStoragePath, cfg.RootDir)
+}
+
 // -----------------------------------------------------------------------------
 // TxIndexConfig
 // Remember that Event has the following structure:
@@ -1337,6 +1499,8 @@ func (cfg *StorageConfig) ValidateBasic() error {
 // TxIndexConfig defines the configuration for the transaction indexer,
 // including composite keys to index.
 type TxIndexConfig struct {
+	RootDir string `mapstructure:"home"`
+
 	// What indexer to use for transactions
 	//
 	// Options:
@@ -1344,20 +1508,31 @@ type TxIndexConfig struct {
 	//   2) "kv" (default) - the simplest possible indexer,
 	//      backed by key-value storage (defaults to levelDB; see DBBackend).
 	//   3) "psql" - the indexer services backed by PostgreSQL.
+	//   4) "sqlite" - the indexer services backed by an embedded SQLite
+	//      database.
 	Indexer string `mapstructure:"indexer"`
 
 	// The PostgreSQL connection configuration, the connection format:
 	// postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 	PsqlConn string `mapstructure:"psql-conn"`
+
+	// The path of the SQLite database file, created if it does not exist.
+	SQLitePath string `mapstructure:"sqlite-path"`
 }
 
 // DefaultTxIndexConfig returns a default configuration for the transaction indexer.
 func DefaultTxIndexConfig() *TxIndexConfig {
 	return &TxIndexConfig{
-		Indexer: "kv",
+		Indexer:    "kv",
+		SQLitePath: filepath.Join(DefaultDataDir, "tx_index.sqlite"),
 	}
 }
 
+// SQLiteFile returns the full path to the SQLite database file.
+func (cfg *TxIndexConfig) SQLiteFile() string {
+	return rootify(cfg.SQLitePath, cfg.RootDir)
+}
+
 // TestTxIndexConfig returns a default configuration for the transaction indexer.
 func TestTxIndexConfig() *TxIndexConfig {
 	return DefaultTxIndexConfig()
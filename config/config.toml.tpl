@@ -222,6 +222,22 @@ enabled = {{ .GRPC.BlockService.Enabled }}
 [grpc.block_results_service]
 enabled = {{ .GRPC.BlockResultsService.Enabled }}
 
+# The gRPC validator set service returns the validator set for a given height. If
+# no height is given, it will return the validator set of the latest height.
+[grpc.validator_set_service]
+enabled = {{ .GRPC.ValidatorSetService.Enabled }}
+
+# The gRPC consensus params service returns the consensus parameters for a given
+# height. If no height is given, it will return the consensus parameters of the
+# latest height.
+[grpc.consensus_params_service]
+enabled = {{ .GRPC.ConsensusParamsService.Enabled }}
+
+# The gRPC ABCI query service queries the application, as the abci_query RPC
+# endpoint.
+[grpc.abci_query_service]
+enabled = {{ .GRPC.ABCIQueryService.Enabled }}
+
 #
 # Configuration for privileged gRPC endpoints, which should **never** be exposed
 # to the public internet.
@@ -243,6 +259,19 @@ laddr = "{{ .GRPC.Privileged.ListenAddress }}"
 # Disabled by default.
 enabled = {{ .GRPC.Privileged.PruningService.Enabled }}
 
+#
+# Configuration specifically for the gRPC halt service, which is considered a
+# privileged service.
+#
+[grpc.privileged.halt_service]
+
+# Only controls whether the halt service is accessible via the gRPC API - not
+# whether the consensus.halt_height and consensus.halt_time options are honored
+# by the node.
+#
+# Disabled by default.
+enabled = {{ .GRPC.Privileged.HaltService.Enabled }}
+
 #######################################################
 ###           P2P Configuration Options             ###
 #######################################################
@@ -399,9 +428,16 @@ experimental_max_gossip_connections_to_non_persistent_peers = {{ .Mempool.Experi
 # starting from the height of the snapshot.
 enable = {{ .StateSync.Enable }}
 
+# Fetch the light blocks and the consensus parameters needed for the light client verification
+# of the synced state machine from peers, over p2p, instead of the RPC servers below. Needs at
+# least 2 connected peers serving them, along with the trusted height, hash and period below.
+use_p2p = {{ .StateSync.UseP2P }}
+
 # RPC servers (comma-separated) for light client verification of the synced state machine and
 # retrieval of state data for node bootstrapping. Also needs a trusted height and corresponding
 # header hash obtained from a trusted source, and a period during which validators can be trusted.
+# Servers given as grpc://host:port serve the light blocks over the gRPC block and validator set
+# services instead, and the consensus parameters over the gRPC consensus params service.
 #
 # For Cosmos SDK-based chains, trust_period should usually be about 2/3 of the unbonding time (~2
 # weeks) during which they can be financially punished (slashed) for misbehavior.
@@ -424,6 +460,11 @@ chunk_request_timeout = "{{ .StateSync.ChunkRequestTimeout }}"
 # The number of concurrent chunk fetchers to run (default: 1).
 chunk_fetchers = "{{ .StateSync.ChunkFetchers }}"
 
+# The number of blocks preceding the height the node synced to, to fetch from
+# the block sync peers once the state is restored, so that the node can verify
+# evidence and serve the recent history (default: 0, no blocks fetched).
+backfill_blocks = {{ .StateSync.BackfillBlocks }}
+
 #######################################################
 ###       Block Sync Configuration Options          ###
 #######################################################
@@ -470,6 +511,24 @@ peer_gossip_sleep_duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer_gossip_intraloop_sleep_duration = "{{ .Consensus.PeerGossipIntraloopSleepDuration }}"
 peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 
+# Compact block relay: instead of gossiping every part of the proposal block,
+# send the block header and the hashes of its transactions, and let peers
+# rebuild the block from their mempools, requesting only the missing
+# transactions. Peers that cannot rebuild the block receive the block parts
+# after compact_blocks_fallback_timeout.
+# All validators must run a version supporting compact blocks before enabling it.
+compact_blocks = {{ .Consensus.CompactBlocks }}
+compact_blocks_fallback_timeout = "{{ .Consensus.CompactBlocksFallbackTimeout }}"
+
+# Stop consensus after committing the block at halt_height, or the first block
+# whose time is equal to or later than halt_time (a Unix time, in seconds), and
+# exit with code 3. Used to upgrade all the validators at the same height.
+# Block sync stops at halt_height too, and the node refuses to start once the
+# block at halt_height, or a block at or after halt_time, is committed. 0
+# disables the corresponding option.
+halt_height = {{ .Consensus.HaltHeight }}
+halt_time = {{ .Consensus.HaltTime }}
+
 #######################################################
 ###         Storage Configuration Options           ###
 #######################################################
@@ -487,7 +546,8 @@ discard_abci_responses = {{ .Storage.DiscardABCIResponses}}
 # Note that this is an experimental feature and switching back from v2 to v1
 # is not supported by CometBFT.
 # If the database was initially created with v1, it is necessary to migrate the DB
-# before switching to v2. The migration is not done automatically.
+# before switching to v2. The migration is not done automatically, see the
+# migrate-db command.
 # v1 - the legacy layout existing in Comet prior to v1.
 # v2 - Order preserving representation ordering entries by height.
 experimental_db_key_layout = "{{ .Storage.ExperimentalKeyLayout }}"
@@ -510,6 +570,30 @@ compaction_interval = "{{ .Storage.CompactionInterval }}"
 # the node is not able to boot.
 genesis_hash = "{{ .Storage.GenesisHash }}"
 
+# Number of most recent blocks kept in the block store database. Older blocks
+# are moved to compressed, immutable segment files of cold_storage_segment_size
+# blocks each in cold_storage_path, from which they are still served.
+# 0 by default (the cold storage is disabled).
+cold_storage_depth = {{ .Storage.ColdStorageDepth }}
+
+# Path to the directory of the segment files of the cold storage, relative to
+# the home directory if not absolute.
+cold_storage_path = "{{ js .Storage.ColdStoragePath }}"
+
+# Number of blocks per segment file of the cold storage.
+cold_storage_segment_size = {{ .Storage.ColdStorageSegmentSize }}
+
+# Compression of the block parts and FinalizeBlock responses written to the
+# databases. Values are compressed individually and marked as such, so that
+# values written with either setting are always loaded. Options:
+#   1) "none" (default) - values are stored uncompressed.
+#   2) "zstd" - values are compressed with zstd.
+compression = "{{ .Storage.Compression }}"
+
+# If compression is enabled, rewrite compressed, in the background, the block
+# parts and FinalizeBlock responses stored uncompressed when the node starts.
+recompress = {{ .Storage.Recompress }}
+
 [storage.pruning]
 
 # The time period between automated background pruning operations.
@@ -555,13 +639,17 @@ initial_block_results_retain_height = {{ .Storage.Pruning.DataCompanion.InitialB
 #   2) "kv" (default) - the simplest possible indexer, backed by key-value storage (defaults to levelDB; see DBBackend).
 # 		- When "kv" is chosen "tx.height" and "tx.hash" will always be indexed.
 #   3) "psql" - the indexer services backed by PostgreSQL.
-# When "kv" or "psql" is chosen "tx.height" and "tx.hash" will always be indexed.
+#   4) "sqlite" - the indexer services backed by an embedded SQLite database.
+# When "kv", "psql" or "sqlite" is chosen "tx.height" and "tx.hash" will always be indexed.
 indexer = "{{ .TxIndex.Indexer }}"
 
 # The PostgreSQL connection configuration, the connection format:
 #   postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 psql-conn = "{{ .TxIndex.PsqlConn }}"
 
+# The path of the SQLite database file, created if it does not exist.
+sqlite-path = "{{ js .TxIndex.SQLitePath }}"
+
 #######################################################
 ###       Instrumentation Configuration Options     ###
 #######################################################
@@ -135,6 +135,22 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 func TestStateSyncConfigValidateBasic(t *testing.T) {
 	cfg := config.TestStateSyncConfig()
 	require.NoError(t, cfg.ValidateBasic())
+
+	// the RPC servers are required, unless the light blocks are fetched
+	// from peers
+	cfg.Enable = true
+	cfg.TrustHeight = 1
+	cfg.TrustHash = "0123"
+	cfg.RPCServers = []string{"a"}
+	require.ErrorIs(t, cfg.ValidateBasic(), config.ErrNotEnoughRPCServers)
+	cfg.RPCServers = []string{"a", "b"}
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.RPCServers = nil
+	cfg.UseP2P = true
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.BackfillBlocks = -1
+	require.Error(t, cfg.ValidateBasic())
 }
 
 func TestBlockSyncConfigValidateBasic(t *testing.T) {
@@ -170,6 +186,9 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"PeerQueryMaj23SleepDuration":          {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = time.Second }, false},
 		"PeerQueryMaj23SleepDuration negative": {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = -1 }, true},
 		"DoubleSignCheckHeight negative":       {func(c *config.ConsensusConfig) { c.DoubleSignCheckHeight = -1 }, true},
+		"CompactBlocksFallback negative":       {func(c *config.ConsensusConfig) { c.CompactBlocksFallbackTimeout = -1 }, true},
+		"HaltHeight negative":                  {func(c *config.ConsensusConfig) { c.HaltHeight = -1 }, true},
+		"HaltTime negative":                    {func(c *config.ConsensusConfig) { c.HaltTime = -1 }, true},
 	}
 	for desc, tc := range testcases {
 		t.Run(desc, func(t *testing.T) {
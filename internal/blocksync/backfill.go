@@ -0,0 +1,236 @@
+package blocksync
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	bcproto "github.com/cometbft/cometbft/api/cometbft/blocksync/v1"
+	"github.com/cometbft/cometbft/p2p"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// backfillResponseTimeout is the time to wait for a peer to respond to a
+	// block request of the backfill, before asking another peer.
+	backfillResponseTimeout = 10 * time.Second
+	// backfillRetryInterval is the time to wait before asking the peers again
+	// for a block none of them provided.
+	backfillRetryInterval = time.Second
+	// maxBackfillAttempts is the number of times the peers are asked for a
+	// block before the backfill gives up.
+	maxBackfillAttempts = 3
+)
+
+// errNoBackfillPeer is returned when no peer provided a block, or its
+// validator set, to backfill.
+var errNoBackfillPeer = errors.New("no peer provided the block")
+
+// backfillKey identifies a block requested from a peer by the backfill.
+type backfillKey struct {
+	peerID p2p.ID
+	height int64
+}
+
+// ValidatorSetProvider provides the validator sets of the blocks fetched by
+// the backfill, e.g. from the light blocks served by the state sync peers.
+type ValidatorSetProvider interface {
+	// ValidatorSet returns the validator set of chainID at height, the hash of
+	// which must be hash.
+	ValidatorSet(ctx context.Context, chainID string, height int64, hash []byte) (*types.ValidatorSet, error)
+}
+
+// Backfill fetches from the peers the numBlocks blocks ending with the last
+// block of state, e.g. after state sync, and saves them in the store along
+// with their commits. The blocks are fetched from the top down, each one being
+// verified against the block ID of the block above it, starting from the
+// trusted last block ID of state. seenCommit is the commit for the last block
+// of state.
+//
+// The validator set of each block is fetched from vals, checked against the
+// validators hash of the block and the next validators hash of the block
+// below, and saved in the state store, so that the commits and the evidence
+// of the backfilled heights can be verified. The blocks fetched before an
+// error are kept.
+func (bcR *Reactor) Backfill(ctx context.Context, state sm.State, seenCommit *types.Commit, numBlocks int64,
+	vals ValidatorSetProvider,
+) error {
+	stopHeight := max(state.LastBlockHeight-numBlocks+1, state.InitialHeight)
+	blockID, commit := state.LastBlockID, seenCommit
+	// The validator set of the height above the block being backfilled.
+	nextVals := state.Validators
+	for height := state.LastBlockHeight; height >= stopHeight; height-- {
+		block, parts, err := bcR.fetchBackfillBlock(ctx, height, blockID)
+		if err != nil {
+			return fmt.Errorf("fetching block %d: %w", height, err)
+		}
+		if !bytes.Equal(block.NextValidatorsHash, nextVals.Hash()) {
+			return fmt.Errorf("block %d has next validators hash %X, expected %X",
+				height, block.NextValidatorsHash, nextVals.Hash())
+		}
+		blockVals, err := bcR.fetchBackfillValidators(ctx, vals, block)
+		if err != nil {
+			return fmt.Errorf("fetching the validator set of block %d: %w", height, err)
+		}
+
+		// The validator set is saved first, so that the stored blocks always
+		// have one.
+		if err := bcR.blockExec.Store().SaveValidatorSets(height, height, blockVals); err != nil {
+			return fmt.Errorf("saving the validator set of block %d: %w", height, err)
+		}
+		if bcR.store.Base() == 0 {
+			bcR.store.SaveBlock(block, parts, commit)
+		} else if err := bcR.store.BackfillBlock(block, parts, commit); err != nil {
+			return fmt.Errorf("saving block %d: %w", height, err)
+		}
+		bcR.Logger.Debug("Backfilled block", "height", height, "hash", block.Hash())
+		blockID, commit, nextVals = block.LastBlockID, block.LastCommit, blockVals
+	}
+	return nil
+}
+
+// fetchBackfillValidators fetches from vals the validator set of block,
+// retrying until it gets a set matching the validators hash of the block.
+func (bcR *Reactor) fetchBackfillValidators(ctx context.Context, vals ValidatorSetProvider, block *types.Block) (*types.ValidatorSet, error) {
+	for attempt := 1; ; attempt++ {
+		valSet, err := vals.ValidatorSet(ctx, block.ChainID, block.Height, block.ValidatorsHash)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		switch {
+		case err != nil:
+			bcR.Logger.Debug("Failed to fetch validator set to backfill", "height", block.Height, "err", err)
+		case !bytes.Equal(valSet.Hash(), block.ValidatorsHash):
+			bcR.Logger.Error("Got a validator set not matching the backfilled block", "height", block.Height,
+				"hash", valSet.Hash(), "expected", block.ValidatorsHash)
+		default:
+			return valSet, nil
+		}
+		if attempt == maxBackfillAttempts {
+			return nil, errNoBackfillPeer
+		}
+
+		select {
+		case <-time.After(backfillRetryInterval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// fetchBackfillBlock asks the peers, one after the other, for the block at
+// height until one of them provides a block with the given ID.
+func (bcR *Reactor) fetchBackfillBlock(ctx context.Context, height int64, blockID types.BlockID) (*types.Block, *types.PartSet, error) {
+	for attempt := 1; ; attempt++ {
+		for _, peer := range bcR.Switch.Peers().Copy() {
+			block, err := bcR.requestBackfillBlock(ctx, peer, height)
+			if ctx.Err() != nil {
+				return nil, nil, ctx.Err()
+			}
+			if err != nil {
+				bcR.Logger.Debug("Failed to fetch block to backfill", "peer", peer.ID(), "height", height, "err", err)
+				continue
+			}
+			if block == nil {
+				continue
+			}
+
+			parts, err := bcR.verifyBackfillBlock(block, blockID)
+			if err != nil {
+				bcR.Logger.Error("Peer sent us a block not matching the trusted chain", "peer", peer.ID(), "height", height, "err", err)
+				bcR.Switch.StopPeerForError(peer, err)
+				continue
+			}
+			return block, parts, nil
+		}
+		if attempt == maxBackfillAttempts {
+			return nil, nil, errNoBackfillPeer
+		}
+
+		select {
+		case <-time.After(backfillRetryInterval):
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	}
+}
+
+// verifyBackfillBlock checks that block has the expected ID and returns its
+// parts.
+func (*Reactor) verifyBackfillBlock(block *types.Block, blockID types.BlockID) (*types.PartSet, error) {
+	if err := block.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid block: %w", err)
+	}
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	if err != nil {
+		return nil, fmt.Errorf("making the block parts: %w", err)
+	}
+	if id := (types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}); !id.Equals(blockID) {
+		return nil, fmt.Errorf("expected block ID %v, got %v", blockID, id)
+	}
+	return parts, nil
+}
+
+// requestBackfillBlock requests the block at height from peer and waits for
+// the response. The block is nil if the peer does not have it.
+func (bcR *Reactor) requestBackfillBlock(ctx context.Context, peer p2p.Peer, height int64) (*types.Block, error) {
+	key := backfillKey{peerID: peer.ID(), height: height}
+	ch := make(chan *types.Block, 1)
+
+	bcR.backfillMtx.Lock()
+	bcR.backfillCalls[key] = ch
+	bcR.backfillMtx.Unlock()
+	defer func() {
+		bcR.backfillMtx.Lock()
+		delete(bcR.backfillCalls, key)
+		bcR.backfillMtx.Unlock()
+	}()
+
+	if !peer.Send(p2p.Envelope{ChannelID: BlocksyncChannel, Message: &bcproto.BlockRequest{Height: height}}) {
+		return nil, errors.New("failed to send the block request")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, backfillResponseTimeout)
+	defer cancel()
+	select {
+	case block, ok := <-ch:
+		if !ok {
+			return nil, errors.New("peer disconnected")
+		}
+		return block, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// respondBackfill hands block, received from peerID for height, to the
+// backfill if it requested it. It returns false if it did not.
+func (bcR *Reactor) respondBackfill(peerID p2p.ID, height int64, block *types.Block) bool {
+	key := backfillKey{peerID: peerID, height: height}
+
+	bcR.backfillMtx.Lock()
+	defer bcR.backfillMtx.Unlock()
+	ch, ok := bcR.backfillCalls[key]
+	if !ok {
+		return false
+	}
+	delete(bcR.backfillCalls, key)
+	ch <- block
+	close(ch)
+	return true
+}
+
+// removeBackfillPeer fails the backfill requests pending on peerID.
+func (bcR *Reactor) removeBackfillPeer(peerID p2p.ID) {
+	bcR.backfillMtx.Lock()
+	defer bcR.backfillMtx.Unlock()
+	for key, ch := range bcR.backfillCalls {
+		if key.peerID == peerID {
+			delete(bcR.backfillCalls, key)
+			close(ch)
+		}
+	}
+}
@@ -34,6 +34,9 @@ type consensusReactor interface {
 	// for when we switch from blocksync reactor and block sync to
 	// the consensus machine
 	SwitchToConsensus(state sm.State, skipWAL bool)
+	// GetHaltHeight returns the height after which consensus stops, or 0 if
+	// none, so that block sync stops there as well.
+	GetHaltHeight() int64
 }
 
 type mempoolReactor interface {
@@ -68,6 +71,10 @@ type Reactor struct {
 
 	switchToConsensusMs int
 
+	// blocks requested by Backfill
+	backfillMtx   sync.Mutex
+	backfillCalls map[backfillKey]chan *types.Block
+
 	metrics *Metrics
 }
 
@@ -112,6 +119,8 @@ func NewReactor(state sm.State, blockExec *sm.BlockExecutor, store *store.BlockS
 		requestsCh:   requestsCh,
 		errorsCh:     errorsCh,
 		metrics:      metrics,
+
+		backfillCalls: make(map[backfillKey]chan *types.Block),
 	}
 	bcR.BaseReactor = *p2p.NewBaseReactor("Reactor", bcR)
 	return bcR
@@ -199,6 +208,7 @@ func (bcR *Reactor) AddPeer(peer p2p.Peer) {
 // RemovePeer implements Reactor by removing peer from the pool.
 func (bcR *Reactor) RemovePeer(peer p2p.Peer, _ any) {
 	bcR.pool.RemovePeer(peer.ID())
+	bcR.removeBackfillPeer(peer.ID())
 }
 
 // respondToPeer loads a block and sends it to the requesting peer,
@@ -275,6 +285,9 @@ func (bcR *Reactor) Receive(e p2p.Envelope) {
 			}
 		}
 
+		if bcR.respondBackfill(e.Src.ID(), bi.Height, bi) {
+			return
+		}
 		if err := bcR.pool.AddBlock(e.Src.ID(), bi, extCommit, msg.Block.Size()); err != nil {
 			bcR.Logger.Error("failed to add block", "peer", e.Src, "err", err)
 		}
@@ -292,6 +305,9 @@ func (bcR *Reactor) Receive(e p2p.Envelope) {
 		bcR.pool.SetPeerRange(e.Src.ID(), msg.Base, msg.Height)
 	case *bcproto.NoBlockResponse:
 		bcR.Logger.Debug("Peer does not have requested block", "peer", e.Src, "height", msg.Height)
+		if bcR.respondBackfill(e.Src.ID(), msg.Height, nil) {
+			return
+		}
 		bcR.pool.RedoRequestFrom(msg.Height, e.Src.ID())
 	default:
 		bcR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
@@ -350,6 +366,10 @@ FOR_LOOP:
 			}
 
 		case <-didProcessCh:
+			if bcR.isHaltHeightReached(state, blocksSynced, stateSynced) {
+				break FOR_LOOP
+			}
+
 			// NOTE: It is a subtle mistake to process more than a single block
 			// at a time (e.g. 10) here, because we only TrySend 1 request per
 			// loop.  The ratio mismatch can result in starving of blocks, a
@@ -506,23 +526,44 @@ func (bcR *Reactor) isMissingExtension(state sm.State, blocksSynced uint64) bool
 func (bcR *Reactor) isCaughtUp(state sm.State, blocksSynced uint64, stateSynced bool) bool {
 	if isCaughtUp, height, _ := bcR.pool.IsCaughtUp(); isCaughtUp {
 		bcR.Logger.Info("Time to switch to consensus mode!", "height", height)
-		if err := bcR.pool.Stop(); err != nil {
-			bcR.Logger.Error("Error stopping pool", "err", err)
-		}
-		if memR, ok := bcR.Switch.Reactor("MEMPOOL").(mempoolReactor); ok {
-			memR.EnableInOutTxs()
-		}
-		if conR, ok := bcR.Switch.Reactor("CONSENSUS").(consensusReactor); ok {
-			conR.SwitchToConsensus(state, blocksSynced > 0 || stateSynced)
-		}
-		// else {
-		// should only happen during testing
-		// }
+		bcR.switchToConsensus(state, blocksSynced, stateSynced)
 		return true
 	}
 	return false
 }
 
+// isHaltHeightReached switches to consensus, which then halts, once the block
+// at the halt height of consensus, if any, is applied.
+func (bcR *Reactor) isHaltHeightReached(state sm.State, blocksSynced uint64, stateSynced bool) bool {
+	conR, ok := bcR.Switch.Reactor("CONSENSUS").(consensusReactor)
+	if !ok {
+		return false
+	}
+	haltHeight := conR.GetHaltHeight()
+	if haltHeight == 0 || state.LastBlockHeight < haltHeight {
+		return false
+	}
+	bcR.Logger.Info("Reached the halt height, switching to consensus mode to halt",
+		"height", state.LastBlockHeight, "halt_height", haltHeight)
+	bcR.switchToConsensus(state, blocksSynced, stateSynced)
+	return true
+}
+
+func (bcR *Reactor) switchToConsensus(state sm.State, blocksSynced uint64, stateSynced bool) {
+	if err := bcR.pool.Stop(); err != nil {
+		bcR.Logger.Error("Error stopping pool", "err", err)
+	}
+	if memR, ok := bcR.Switch.Reactor("MEMPOOL").(mempoolReactor); ok {
+		memR.EnableInOutTxs()
+	}
+	if conR, ok := bcR.Switch.Reactor("CONSENSUS").(consensusReactor); ok {
+		conR.SwitchToConsensus(state, blocksSynced > 0 || stateSynced)
+	}
+	// else {
+	// should only happen during testing
+	// }
+}
+
 func (bcR *Reactor) processBlock(first, second *types.Block, firstParts *types.PartSet, state sm.State, extCommit *types.ExtendedCommit) (sm.State, error) {
 	var (
 		chainID            = bcR.initialState.ChainID
@@ -1,6 +1,7 @@
 package blocksync
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"sort"
@@ -18,6 +19,7 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	mpmocks "github.com/cometbft/cometbft/mempool/mocks"
 	"github.com/cometbft/cometbft/p2p"
+	p2pmock "github.com/cometbft/cometbft/p2p/mock"
 	"github.com/cometbft/cometbft/proxy"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/store"
@@ -225,6 +227,53 @@ func TestNoBlockResponse(t *testing.T) {
 	}
 }
 
+type haltingConsensusReactor struct {
+	*p2pmock.Reactor
+
+	haltHeight int64
+	switched   chan int64
+}
+
+func (r *haltingConsensusReactor) SwitchToConsensus(state sm.State, _ bool) {
+	r.switched <- state.LastBlockHeight
+}
+
+func (r *haltingConsensusReactor) GetHaltHeight() int64 { return r.haltHeight }
+
+func TestHaltHeightStopsBlockSync(t *testing.T) {
+	config = test.ResetTestRoot("blocksync_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc()
+
+	reactorPairs := []ReactorPair{
+		newReactor(t, log.TestingLogger(), genDoc, privVals, 30),
+		newReactor(t, log.TestingLogger(), genDoc, privVals, 0),
+	}
+	conR := &haltingConsensusReactor{Reactor: p2pmock.NewReactor(), haltHeight: 10, switched: make(chan int64, 1)}
+
+	p2p.MakeConnectedSwitches(config.P2P, 2, func(i int, s *p2p.Switch) *p2p.Switch {
+		s.AddReactor("BLOCKSYNC", reactorPairs[i].reactor)
+		if i == 1 {
+			s.AddReactor("CONSENSUS", conR)
+		}
+		return s
+	}, p2p.Connect2Switches)
+	defer func() {
+		for _, r := range reactorPairs {
+			_ = r.reactor.Stop()
+			require.NoError(t, r.app.Stop())
+		}
+	}()
+
+	select {
+	case height := <-conR.switched:
+		assert.Equal(t, conR.haltHeight, height)
+	case <-time.After(30 * time.Second):
+		t.Fatal("block sync did not stop at the halt height")
+	}
+	assert.Equal(t, conR.haltHeight, reactorPairs[1].reactor.store.Height())
+}
+
 // NOTE: This is too hard to test without
 // an easy way to add test peer to switch
 // or without significant refactoring of the module.
@@ -379,3 +428,107 @@ func TestCheckSwitchToConsensusLastHeightZero(t *testing.T) {
 		assert.GreaterOrEqual(t, r.reactor.store.Height(), maxBlockHeight-maxDiff)
 	}
 }
+
+func TestReactorBackfill(t *testing.T) {
+	config = test.ResetTestRoot("blocksync_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc()
+
+	maxBlockHeight := int64(20)
+
+	reactorPairs := make([]ReactorPair, 2)
+	reactorPairs[0] = newReactor(t, log.TestingLogger(), genDoc, privVals, maxBlockHeight)
+	// The second node state synced to the last height of the first one: it
+	// does not sync blocks yet.
+	reactorPairs[1] = newReactor(t, log.TestingLogger(), genDoc, privVals, 0)
+	reactorPairs[1].reactor.blockSync = false
+
+	p2p.MakeConnectedSwitches(config.P2P, 2, func(i int, s *p2p.Switch) *p2p.Switch {
+		s.AddReactor("BLOCKSYNC", reactorPairs[i].reactor)
+		return s
+	}, p2p.Connect2Switches)
+
+	defer func() {
+		for _, r := range reactorPairs {
+			err := r.reactor.Stop()
+			require.NoError(t, err)
+			err = r.app.Stop()
+			require.NoError(t, err)
+		}
+	}()
+
+	state := reactorPairs[0].reactor.initialState
+	seenCommit := reactorPairs[0].reactor.store.LoadSeenCommit(maxBlockHeight)
+	bcR := reactorPairs[1].reactor
+
+	vals := storeValidatorSets{reactorPairs[0].reactor.blockExec.Store()}
+
+	// Validator sets not matching the blocks are rejected.
+	otherVals, _ := types.RandValidatorSet(1, 10)
+	err := bcR.Backfill(context.Background(), state, seenCommit, 5, fixedValidatorSet{otherVals})
+	require.Error(t, err)
+	assert.Zero(t, bcR.store.Base())
+
+	err = bcR.Backfill(context.Background(), state, seenCommit, 5, vals)
+	require.NoError(t, err)
+	assert.EqualValues(t, maxBlockHeight-4, bcR.store.Base())
+	assert.EqualValues(t, maxBlockHeight, bcR.store.Height())
+	for h := maxBlockHeight - 4; h <= maxBlockHeight; h++ {
+		block, _ := bcR.store.LoadBlock(h)
+		require.NotNil(t, block)
+		expected, _ := reactorPairs[0].reactor.store.LoadBlock(h)
+		assert.Equal(t, expected.Hash(), block.Hash())
+		valSet, err := bcR.blockExec.Store().LoadValidators(h)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(block.ValidatorsHash), valSet.Hash())
+	}
+	assert.Equal(t, seenCommit.Hash(), bcR.store.LoadSeenCommit(maxBlockHeight).Hash())
+
+	// The backfill does not go below the initial height.
+	state = stateAt(t, reactorPairs[0].reactor, maxBlockHeight-5)
+	seenCommit = reactorPairs[0].reactor.store.LoadBlockCommit(maxBlockHeight - 5)
+	err = bcR.Backfill(context.Background(), state, seenCommit, 100, vals)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, bcR.store.Base())
+	_, err = bcR.blockExec.Store().LoadValidators(1)
+	require.NoError(t, err)
+
+	// A block not matching the trusted chain is rejected.
+	block, _ := bcR.store.LoadBlock(maxBlockHeight)
+	_, err = bcR.verifyBackfillBlock(block, state.LastBlockID)
+	require.Error(t, err)
+	_, err = bcR.verifyBackfillBlock(block, seenCommit.BlockID)
+	require.Error(t, err)
+	_, err = bcR.verifyBackfillBlock(block, reactorPairs[0].reactor.store.LoadSeenCommit(maxBlockHeight).BlockID)
+	require.NoError(t, err)
+}
+
+// storeValidatorSets provides the validator sets stored in a state store.
+type storeValidatorSets struct {
+	sm.Store
+}
+
+func (s storeValidatorSets) ValidatorSet(_ context.Context, _ string, height int64, _ []byte) (*types.ValidatorSet, error) {
+	return s.LoadValidators(height)
+}
+
+// fixedValidatorSet provides the same validator set for every height.
+type fixedValidatorSet struct {
+	vals *types.ValidatorSet
+}
+
+func (f fixedValidatorSet) ValidatorSet(context.Context, string, int64, []byte) (*types.ValidatorSet, error) {
+	return f.vals, nil
+}
+
+// stateAt returns the state of bcR as if its last block was the block at
+// height in its store.
+func stateAt(t *testing.T, bcR *Reactor, height int64) sm.State {
+	t.Helper()
+	state := bcR.initialState.Copy()
+	meta := bcR.store.LoadBlockMeta(height)
+	require.NotNil(t, meta)
+	state.LastBlockHeight = height
+	state.LastBlockID = meta.BlockID
+	return state
+}
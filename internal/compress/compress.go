@@ -0,0 +1,59 @@
+// Package compress compresses the values stored in the databases.
+//
+// A compressed value is the zstd frame of the original value prefixed by a
+// marker byte. Values written by the stores are protobuf messages, whose first
+// byte is the tag of a field with a number lower than 16, which is never the
+// marker: compressed and uncompressed values can thus be stored side by side
+// and told apart when loaded.
+package compress
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// marker prefixes compressed values.
+const marker = 0xff
+
+var (
+	initOnce sync.Once
+	encoder  *zstd.Encoder
+	decoder  *zstd.Decoder
+)
+
+func initCoders() {
+	var err error
+	if encoder, err = zstd.NewWriter(nil); err != nil {
+		panic(err)
+	}
+	if decoder, err = zstd.NewReader(nil); err != nil {
+		panic(err)
+	}
+}
+
+// Compress returns the compressed form of bz.
+func Compress(bz []byte) []byte {
+	initOnce.Do(initCoders)
+	return encoder.EncodeAll(bz, []byte{marker})
+}
+
+// Decompress returns the original value of bz, which is returned unchanged if
+// not compressed.
+func Decompress(bz []byte) ([]byte, error) {
+	if !IsCompressed(bz) {
+		return bz, nil
+	}
+	initOnce.Do(initCoders)
+	res, err := decoder.DecodeAll(bz[1:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("decompressing value: %w", err)
+	}
+	return res, nil
+}
+
+// IsCompressed returns true if bz is a compressed value.
+func IsCompressed(bz []byte) bool {
+	return len(bz) > 0 && bz[0] == marker
+}
@@ -0,0 +1,33 @@
+package compress
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompress(t *testing.T) {
+	for _, bz := range [][]byte{
+		{},
+		{0x0a, 0x01, 0x02},
+		bytes.Repeat([]byte("event"), 1000),
+	} {
+		compressed := Compress(bz)
+		assert.True(t, IsCompressed(compressed))
+		res, err := Decompress(compressed)
+		require.NoError(t, err)
+		assert.Equal(t, len(bz), len(res))
+		assert.True(t, bytes.Equal(bz, res))
+
+		// Uncompressed values are returned unchanged.
+		assert.False(t, IsCompressed(bz))
+		res, err = Decompress(bz)
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(bz, res))
+	}
+
+	_, err := Decompress([]byte{marker, 0x01})
+	require.Error(t, err)
+}
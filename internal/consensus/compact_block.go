@@ -0,0 +1,383 @@
+package consensus
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	cmtcons "github.com/cometbft/cometbft/api/cometbft/consensus/v1"
+	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+const (
+	// compactBlockTxsMaxBytes bounds the size of the transactions sent in a
+	// single CompactBlockTxs message, so that it fits in maxMsgSize.
+	compactBlockTxsMaxBytes = maxMsgSize - 1024
+	// compactBlockTxOverhead is an upper bound on the encoding overhead of a
+	// transaction and its index in a CompactBlockTxs message.
+	compactBlockTxOverhead = 16
+)
+
+// interface to the mempool, used to rebuild compact blocks.
+type txFetcher interface {
+	GetTxByHash(hash []byte) types.Tx
+}
+
+var (
+	errCompactBlockTxMismatch   = errors.New("transaction does not match the hash in the compact block")
+	errCompactBlockTxOutOfRange = errors.New("transaction index out of range")
+)
+
+// makeCompactBlockMessage returns the compact form of the given block, which
+// is the proposal block for the given round.
+func makeCompactBlockMessage(block *types.Block, round int32, psh types.PartSetHeader) *CompactBlockMessage {
+	txHashes := make([][]byte, len(block.Txs))
+	for i, tx := range block.Txs {
+		txHashes[i] = tx.Hash()
+	}
+	return &CompactBlockMessage{
+		Height:             block.Height,
+		Round:              round,
+		BlockPartSetHeader: psh,
+		Header:             block.Header,
+		TxHashes:           txHashes,
+		Evidence:           block.Evidence,
+		LastCommit:         block.LastCommit,
+	}
+}
+
+func compactBlockToProto(msg *CompactBlockMessage) (*cmtcons.CompactBlock, error) {
+	evidence, err := msg.Evidence.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return &cmtcons.CompactBlock{
+		Height:             msg.Height,
+		Round:              msg.Round,
+		BlockPartSetHeader: msg.BlockPartSetHeader.ToProto(),
+		Header:             *msg.Header.ToProto(),
+		TxHashes:           msg.TxHashes,
+		Evidence:           *evidence,
+		LastCommit:         msg.LastCommit.ToProto(),
+	}, nil
+}
+
+// compactBlockBuilder rebuilds a proposal block from a compact block, the
+// local mempool and the transactions requested from the peer that sent it.
+type compactBlockBuilder struct {
+	msg    *CompactBlockMessage
+	peerID p2p.ID
+
+	txs     types.Txs // nil entries are missing
+	missing int
+	done    bool // rebuilt or given up on
+}
+
+func newCompactBlockBuilder(msg *CompactBlockMessage, peerID p2p.ID, mempool txFetcher) *compactBlockBuilder {
+	b := &compactBlockBuilder{
+		msg:    msg,
+		peerID: peerID,
+		txs:    make(types.Txs, len(msg.TxHashes)),
+	}
+	for i, hash := range msg.TxHashes {
+		if mempool != nil {
+			b.txs[i] = mempool.GetTxByHash(hash)
+		}
+		if b.txs[i] == nil {
+			b.missing++
+		}
+	}
+	return b
+}
+
+// isFor returns true if the builder is rebuilding the compact block for the
+// given height and round.
+func (b *compactBlockBuilder) isFor(height int64, round int32) bool {
+	return b.msg.Height == height && b.msg.Round == round
+}
+
+// compactBlockMatchesProposal returns true if msg announces the block of the proposal of
+// rs, so that only one compact block per proposal is rebuilt.
+func compactBlockMatchesProposal(msg *CompactBlockMessage, rs *cstypes.RoundState) bool {
+	return rs.Height == msg.Height && rs.Proposal != nil && rs.Proposal.Round == msg.Round &&
+		rs.Proposal.BlockID.PartSetHeader.Equals(msg.BlockPartSetHeader)
+}
+
+// compactBlockTxsRequestMatchesProposal returns true if a request for the
+// transactions of a compact block is for our proposal block, i.e. for the
+// height and round of the proposal, and the block is the proposed one.
+// Otherwise the requested indexes could refer to the transactions of another
+// block than the one the peer is rebuilding.
+func compactBlockTxsRequestMatchesProposal(msg *CompactBlockTxsRequestMessage, rs *cstypes.RoundState) bool {
+	return rs.Height == msg.Height && rs.Proposal != nil && rs.Proposal.Round == msg.Round &&
+		rs.ProposalBlock != nil && rs.ProposalBlockParts != nil &&
+		rs.ProposalBlockParts.HasHeader(rs.Proposal.BlockID.PartSetHeader)
+}
+
+// missingIndexes returns the indexes of the transactions still missing.
+func (b *compactBlockBuilder) missingIndexes() []int32 {
+	indexes := make([]int32, 0, b.missing)
+	for i, tx := range b.txs {
+		if tx == nil {
+			indexes = append(indexes, int32(i))
+		}
+	}
+	return indexes
+}
+
+// addTx adds the transaction at the given index, checking it against the
+// hash in the compact block. Returns true if the transaction was missing.
+func (b *compactBlockBuilder) addTx(index int32, tx types.Tx) (bool, error) {
+	if int(index) >= len(b.txs) {
+		return false, errCompactBlockTxOutOfRange
+	}
+	if !bytes.Equal(tx.Hash(), b.msg.TxHashes[index]) {
+		return false, errCompactBlockTxMismatch
+	}
+	if b.txs[index] != nil {
+		return false, nil
+	}
+	b.txs[index] = tx
+	b.missing--
+	return true, nil
+}
+
+func (b *compactBlockBuilder) isComplete() bool {
+	return b.missing == 0
+}
+
+// partSet returns the parts of the rebuilt block. It fails if the block does
+// not match the PartSetHeader announced in the compact block.
+func (b *compactBlockBuilder) partSet() (*types.PartSet, error) {
+	if !b.isComplete() {
+		return nil, fmt.Errorf("%d transactions are missing", b.missing)
+	}
+	block := &types.Block{
+		Header:     b.msg.Header,
+		Data:       types.Data{Txs: b.txs},
+		Evidence:   b.msg.Evidence,
+		LastCommit: b.msg.LastCommit,
+	}
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	if err != nil {
+		return nil, err
+	}
+	if !parts.HasHeader(b.msg.BlockPartSetHeader) {
+		return nil, fmt.Errorf("rebuilt block has part set header %v, expected %v",
+			parts.Header(), b.msg.BlockPartSetHeader)
+	}
+	return parts, nil
+}
+
+// -----------------------------------------------------------------------------
+// Reactor compact block relay
+
+// sendCompactBlock sends the compact form of our proposal block to the peer,
+// if the peer has the proposal but none of its parts.
+// Returns true if the compact block was sent.
+func (conR *Reactor) sendCompactBlock(
+	logger log.Logger,
+	rs *cstypes.RoundState,
+	ps *PeerState,
+	prs *cstypes.PeerRoundState,
+) bool {
+	if rs.Height != prs.Height || rs.Round != prs.Round || !prs.Proposal {
+		return false
+	}
+	if rs.ProposalBlock == nil || rs.ProposalBlockParts == nil || !rs.ProposalBlockParts.IsComplete() {
+		return false
+	}
+	if !rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) || !prs.ProposalBlockParts.IsEmpty() {
+		return false
+	}
+	if ps.HasCompactBlock(rs.Height, rs.Round) {
+		return false
+	}
+
+	msg := makeCompactBlockMessage(rs.ProposalBlock, rs.Round, rs.ProposalBlockParts.Header())
+	pb, err := compactBlockToProto(msg)
+	if err != nil {
+		logger.Error("Could not convert compact block to proto", "height", rs.Height, "err", err)
+		return false
+	}
+	if pb.Size() > maxMsgSize {
+		// Too many transactions for a single message, stick to block parts.
+		ps.SetHasCompactBlock(rs.Height, rs.Round, time.Time{})
+		return false
+	}
+
+	logger.Debug("Sending compact block", "height", rs.Height, "round", rs.Round, "txs", len(msg.TxHashes))
+	if ps.peer.Send(p2p.Envelope{ChannelID: DataChannel, Message: pb}) {
+		ps.SetHasCompactBlock(rs.Height, rs.Round, cmttime.Now())
+		return true
+	}
+	return false
+}
+
+// handleCompactBlock starts rebuilding the proposal block from a compact
+// block, requesting the transactions missing from the mempool from the peer
+// that sent it. Compact blocks are ignored unless they match the proposal:
+// otherwise a peer could announce another block for the round, and keep the
+// proposal block from being rebuilt from the compact blocks of other peers.
+func (conR *Reactor) handleCompactBlock(msg *CompactBlockMessage, src p2p.Peer) {
+	rs := conR.getRoundState()
+	if !compactBlockMatchesProposal(msg, rs) {
+		conR.Logger.Debug("Ignoring compact block not matching the proposal",
+			"height", msg.Height, "round", msg.Round, "peer", src.ID())
+		return
+	}
+	if rs.ProposalBlockParts != nil && rs.ProposalBlockParts.HasHeader(msg.BlockPartSetHeader) &&
+		rs.ProposalBlockParts.IsComplete() {
+		return
+	}
+
+	conR.compactMtx.Lock()
+	if b := conR.compactBlock; b != nil && b.isFor(msg.Height, msg.Round) {
+		// Already rebuilding it from another peer.
+		conR.compactMtx.Unlock()
+		return
+	}
+	b := newCompactBlockBuilder(msg, src.ID(), conR.mempool)
+	conR.compactBlock = b
+	if !b.isComplete() {
+		indexes := b.missingIndexes()
+		conR.compactMtx.Unlock()
+
+		conR.Logger.Debug("Requesting compact block txs", "height", msg.Height, "round", msg.Round,
+			"missing", len(indexes), "total", len(msg.TxHashes), "peer", src.ID())
+		conR.Metrics.CompactBlockTxsRequested.Add(float64(len(indexes)))
+		src.TrySend(p2p.Envelope{
+			ChannelID: DataChannel,
+			Message: &cmtcons.CompactBlockTxsRequest{
+				Height:  msg.Height,
+				Round:   msg.Round,
+				Indexes: indexes,
+			},
+		})
+		return
+	}
+	b.done = true
+	conR.compactMtx.Unlock()
+
+	conR.finishCompactBlock(b)
+}
+
+// handleCompactBlockTxsRequest replies with the requested transactions of our
+// proposal block, as many as fit in a single message. Requests for another
+// height or round than the one of the proposal are ignored.
+func (conR *Reactor) handleCompactBlockTxsRequest(msg *CompactBlockTxsRequestMessage, src p2p.Peer) {
+	rs := conR.getRoundState()
+	if !compactBlockTxsRequestMatchesProposal(msg, rs) {
+		conR.Logger.Debug("Ignoring compact block txs request not matching the proposal",
+			"height", msg.Height, "round", msg.Round, "peer", src.ID())
+		return
+	}
+
+	txs := rs.ProposalBlock.Txs
+	resp := &cmtcons.CompactBlockTxs{
+		Height: msg.Height,
+		Round:  msg.Round,
+	}
+	size := 0
+	for _, index := range msg.Indexes {
+		if int(index) >= len(txs) {
+			continue
+		}
+		tx := txs[index]
+		size += len(tx) + compactBlockTxOverhead
+		if size > compactBlockTxsMaxBytes {
+			break
+		}
+		resp.Indexes = append(resp.Indexes, index)
+		resp.Txs = append(resp.Txs, tx)
+	}
+	if len(resp.Txs) == 0 {
+		return
+	}
+	src.TrySend(p2p.Envelope{
+		ChannelID: DataChannel,
+		Message:   resp,
+	})
+}
+
+// handleCompactBlockTxs adds the received transactions to the compact block
+// being rebuilt, requesting the remaining ones if any.
+func (conR *Reactor) handleCompactBlockTxs(msg *CompactBlockTxsMessage, src p2p.Peer) {
+	conR.compactMtx.Lock()
+	b := conR.compactBlock
+	if b == nil || b.done || !b.isFor(msg.Height, msg.Round) || b.peerID != src.ID() {
+		conR.compactMtx.Unlock()
+		return
+	}
+
+	added := 0
+	for i, index := range msg.Indexes {
+		ok, err := b.addTx(index, msg.Txs[i])
+		if err != nil {
+			b.done = true
+			conR.compactMtx.Unlock()
+			conR.Logger.Info("Giving up on compact block, falling back to block parts",
+				"height", msg.Height, "round", msg.Round, "peer", src.ID(), "err", err)
+			conR.Metrics.CompactBlocksReceived.With("status", "failed").Add(1)
+			return
+		}
+		if ok {
+			added++
+		}
+	}
+
+	if !b.isComplete() {
+		if added == 0 {
+			b.done = true
+			conR.compactMtx.Unlock()
+			conR.Logger.Info("Giving up on compact block, falling back to block parts",
+				"height", msg.Height, "round", msg.Round, "peer", src.ID(), "err", "peer sent no missing txs")
+			conR.Metrics.CompactBlocksReceived.With("status", "failed").Add(1)
+			return
+		}
+		indexes := b.missingIndexes()
+		conR.compactMtx.Unlock()
+		src.TrySend(p2p.Envelope{
+			ChannelID: DataChannel,
+			Message: &cmtcons.CompactBlockTxsRequest{
+				Height:  msg.Height,
+				Round:   msg.Round,
+				Indexes: indexes,
+			},
+		})
+		return
+	}
+	b.done = true
+	conR.compactMtx.Unlock()
+
+	conR.finishCompactBlock(b)
+}
+
+// finishCompactBlock feeds the parts of the rebuilt block to the consensus
+// state, as if they had been received from the peer that sent the compact
+// block. The consensus state validates them against the proposal as usual.
+func (conR *Reactor) finishCompactBlock(b *compactBlockBuilder) {
+	parts, err := b.partSet()
+	if err != nil {
+		conR.Logger.Info("Could not rebuild compact block, falling back to block parts",
+			"height", b.msg.Height, "round", b.msg.Round, "peer", b.peerID, "err", err)
+		conR.Metrics.CompactBlocksReceived.With("status", "failed").Add(1)
+		return
+	}
+
+	conR.Logger.Debug("Rebuilt compact block", "height", b.msg.Height, "round", b.msg.Round,
+		"parts", parts.Total(), "peer", b.peerID)
+	conR.Metrics.CompactBlocksReceived.With("status", "rebuilt").Add(1)
+	for i := 0; i < int(parts.Total()); i++ {
+		conR.conS.peerMsgQueue <- msgInfo{&BlockPartMessage{
+			Height: b.msg.Height,
+			Round:  b.msg.Round,
+			Part:   parts.GetPart(i),
+		}, b.peerID, time.Time{}}
+	}
+}
@@ -0,0 +1,144 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// txMap is a txFetcher backed by a map.
+type txMap map[string]types.Tx
+
+func newTxMap(txs ...types.Tx) txMap {
+	m := make(txMap, len(txs))
+	for _, tx := range txs {
+		m[string(tx.Hash())] = tx
+	}
+	return m
+}
+
+func (m txMap) GetTxByHash(hash []byte) types.Tx {
+	return m[string(hash)]
+}
+
+func makeCompactBlockTestMessage(t *testing.T, txs types.Txs) *CompactBlockMessage {
+	t.Helper()
+	block := types.MakeBlock(1, txs, &types.Commit{}, nil)
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	return makeCompactBlockMessage(block, 0, parts.Header())
+}
+
+func TestCompactBlockBuilderFromMempool(t *testing.T) {
+	txs := types.Txs{types.Tx("tx1"), types.Tx("tx2"), types.Tx("tx3")}
+	msg := makeCompactBlockTestMessage(t, txs)
+
+	b := newCompactBlockBuilder(msg, "peer", newTxMap(txs...))
+	require.True(t, b.isComplete())
+	assert.Empty(t, b.missingIndexes())
+
+	parts, err := b.partSet()
+	require.NoError(t, err)
+	assert.True(t, parts.HasHeader(msg.BlockPartSetHeader))
+	assert.True(t, parts.IsComplete())
+}
+
+func TestCompactBlockBuilderMissingTxs(t *testing.T) {
+	txs := types.Txs{types.Tx("tx1"), types.Tx("tx2"), types.Tx("tx3")}
+	msg := makeCompactBlockTestMessage(t, txs)
+
+	b := newCompactBlockBuilder(msg, "peer", newTxMap(txs[0], txs[2]))
+	require.False(t, b.isComplete())
+	assert.Equal(t, []int32{1}, b.missingIndexes())
+	_, err := b.partSet()
+	require.Error(t, err)
+
+	_, err = b.addTx(1, types.Tx("other"))
+	require.ErrorIs(t, err, errCompactBlockTxMismatch)
+	_, err = b.addTx(3, txs[1])
+	require.ErrorIs(t, err, errCompactBlockTxOutOfRange)
+
+	added, err := b.addTx(1, txs[1])
+	require.NoError(t, err)
+	assert.True(t, added)
+	added, err = b.addTx(1, txs[1])
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	require.True(t, b.isComplete())
+	parts, err := b.partSet()
+	require.NoError(t, err)
+	assert.True(t, parts.HasHeader(msg.BlockPartSetHeader))
+}
+
+func TestCompactBlockBuilderNoMempool(t *testing.T) {
+	txs := types.Txs{types.Tx("tx1"), types.Tx("tx2")}
+	msg := makeCompactBlockTestMessage(t, txs)
+
+	b := newCompactBlockBuilder(msg, "peer", nil)
+	assert.Equal(t, []int32{0, 1}, b.missingIndexes())
+}
+
+func TestCompactBlockBuilderHeaderMismatch(t *testing.T) {
+	txs := types.Txs{types.Tx("tx1"), types.Tx("tx2")}
+	msg := makeCompactBlockTestMessage(t, txs)
+	msg.BlockPartSetHeader.Hash = tmhash.Sum([]byte("other"))
+
+	b := newCompactBlockBuilder(msg, "peer", newTxMap(txs...))
+	require.True(t, b.isComplete())
+	_, err := b.partSet()
+	require.Error(t, err)
+}
+
+func TestCompactBlockMatchesProposal(t *testing.T) {
+	msg := makeCompactBlockTestMessage(t, types.Txs{types.Tx("tx1")})
+	blockID := types.BlockID{Hash: msg.Header.Hash(), PartSetHeader: msg.BlockPartSetHeader}
+	rs := &cstypes.RoundState{Height: 1}
+	assert.False(t, compactBlockMatchesProposal(msg, rs), "no proposal")
+
+	rs.Proposal = types.NewProposal(1, 0, -1, blockID, msg.Header.Time)
+	assert.True(t, compactBlockMatchesProposal(msg, rs))
+
+	other := *msg
+	other.Round = 1
+	assert.False(t, compactBlockMatchesProposal(&other, rs), "other round")
+	other = *msg
+	other.BlockPartSetHeader.Hash = tmhash.Sum([]byte("other"))
+	assert.False(t, compactBlockMatchesProposal(&other, rs), "other block")
+	other = *msg
+	other.Height = 2
+	assert.False(t, compactBlockMatchesProposal(&other, rs), "other height")
+}
+
+func TestCompactBlockTxsRequestMatchesProposal(t *testing.T) {
+	block := types.MakeBlock(1, types.Txs{types.Tx("tx1")}, &types.Commit{}, nil)
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+
+	msg := &CompactBlockTxsRequestMessage{Height: 1, Round: 1, Indexes: []int32{0}}
+	rs := &cstypes.RoundState{Height: 1, Round: 1, ProposalBlock: block, ProposalBlockParts: parts}
+	assert.False(t, compactBlockTxsRequestMatchesProposal(msg, rs), "no proposal")
+
+	rs.Proposal = types.NewProposal(1, 1, -1, blockID, block.Time)
+	assert.True(t, compactBlockTxsRequestMatchesProposal(msg, rs))
+
+	other := *msg
+	other.Round = 0
+	assert.False(t, compactBlockTxsRequestMatchesProposal(&other, rs), "other round")
+	other = *msg
+	other.Height = 2
+	assert.False(t, compactBlockTxsRequestMatchesProposal(&other, rs), "other height")
+
+	// The proposal block of a previous round, while the proposal of the
+	// current round is still missing.
+	otherID := blockID
+	otherID.PartSetHeader.Hash = tmhash.Sum([]byte("other"))
+	rs.Proposal = types.NewProposal(1, 1, -1, otherID, block.Time)
+	assert.False(t, compactBlockTxsRequestMatchesProposal(msg, rs), "other block")
+}
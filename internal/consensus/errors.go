@@ -3,6 +3,7 @@ package consensus
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 var (
@@ -43,3 +44,23 @@ func (e ErrDenyMessageOverflow) Error() string {
 func (e ErrDenyMessageOverflow) Unwrap() error {
 	return e.Err
 }
+
+type ErrHaltHeightCommitted struct {
+	HaltHeight      int64
+	CommittedHeight int64
+}
+
+func (e ErrHaltHeightCommitted) Error() string {
+	return fmt.Sprintf("halt height %d is already committed (last committed height: %d)",
+		e.HaltHeight, e.CommittedHeight)
+}
+
+type ErrHaltTimeCommitted struct {
+	HaltTime      time.Time
+	LastBlockTime time.Time
+}
+
+func (e ErrHaltTimeCommitted) Error() string {
+	return fmt.Sprintf("halt time %v is already reached by a committed block (last block time: %v)",
+		e.HaltTime, e.LastBlockTime)
+}
@@ -206,6 +206,32 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 
 			Buckets: []float64{-1.5, -1.0, -0.5, -0.2, 0, 0.2, 0.5, 1.0, 1.5, 2.0, 2.5, 4.0, 8.0},
 		}, append(labels, "is_timely")).With(labelsAndValues...),
+		CompactBlocksReceived: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_blocks_received",
+			Help:      "CompactBlocksReceived is the number of compact blocks this node tried to rebuild a proposal block from. The metric is annotated by the outcome, either 'rebuilt' or 'failed', in which case the node falls back to receiving block parts.",
+		}, append(labels, "status")).With(labelsAndValues...),
+		CompactBlockTxsRequested: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_block_txs_requested",
+			Help:      "Number of transactions missing from the mempool that had to be requested from peers to rebuild compact blocks.",
+		}, labels).With(labelsAndValues...),
+		VoteDelaySeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "vote_delay_seconds",
+			Help:      "Interval in seconds between the start of the round and the reception of a vote for it.",
+
+			Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2, 4, 8, 16},
+		}, append(labels, "validator_address", "vote_type")).With(labelsAndValues...),
+		ValidatorMissedSignatures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "validator_missed_signatures",
+			Help:      "Number of commits each validator did not sign.",
+		}, append(labels, "validator_address")).With(labelsAndValues...),
 	}
 }
 
@@ -242,5 +268,9 @@ func NopMetrics() *Metrics {
 		RoundVotingPowerPercent:     discard.NewGauge(),
 		LateVotes:                   discard.NewCounter(),
 		ProposalTimestampDifference: discard.NewHistogram(),
+		CompactBlocksReceived:       discard.NewCounter(),
+		CompactBlockTxsRequested:    discard.NewCounter(),
+		VoteDelaySeconds:            discard.NewHistogram(),
+		ValidatorMissedSignatures:   discard.NewCounter(),
 	}
 }
@@ -140,6 +140,31 @@ type Metrics struct {
 	// parameter SynchronyParams.MessageDelay, used by the PBTS algorithm.
 	// metrics:Difference in seconds between the local time when a proposal message is received and the timestamp in the proposal message.
 	ProposalTimestampDifference metrics.Histogram `metrics_bucketsizes:"-1.5, -1.0, -0.5, -0.2, 0, 0.2, 0.5, 1.0, 1.5, 2.0, 2.5, 4.0, 8.0" metrics_labels:"is_timely"`
+
+	// CompactBlocksReceived is the number of compact blocks this node tried to
+	// rebuild a proposal block from. The metric is annotated by the outcome,
+	// either 'rebuilt' or 'failed', in which case the node falls back to
+	// receiving block parts.
+	CompactBlocksReceived metrics.Counter `metrics_labels:"status"`
+
+	// Number of transactions missing from the mempool that had to be requested
+	// from peers to rebuild compact blocks.
+	CompactBlockTxsRequested metrics.Counter
+
+	// VoteDelaySeconds is the interval in seconds between the start of the
+	// current round and the reception of a vote for that round. The metric is
+	// labeled by the address of the validator that cast the vote, and by vote
+	// type.
+	// metrics:Interval in seconds between the start of the round and the reception of a vote for it.
+	VoteDelaySeconds metrics.Histogram `metrics_bucketsizes:"0.05, 0.1, 0.25, 0.5, 1, 2, 4, 8, 16" metrics_labels:"validator_address, vote_type"`
+	// roundStart is the time the round roundStartRound of the height
+	// roundStartHeight started at.
+	roundStart       time.Time
+	roundStartHeight int64
+	roundStartRound  int32
+
+	// Number of commits each validator did not sign.
+	ValidatorMissedSignatures metrics.Counter `metrics_labels:"validator_address"`
 }
 
 func (m *Metrics) MarkProposalProcessed(accepted bool) {
@@ -176,6 +201,27 @@ func (m *Metrics) MarkRound(r int32, st time.Time) {
 	m.RoundVotingPowerPercent.With("vote_type", pcn).Set(0)
 }
 
+// MarkRoundStart records the start of the given round, from which the delays
+// of its votes are measured.
+func (m *Metrics) MarkRoundStart(height int64, round int32) {
+	m.roundStart = cmttime.Now()
+	m.roundStartHeight = height
+	m.roundStartRound = round
+}
+
+// MarkVoteDelay observes the delay of the vote since the start of its round.
+// Votes received before their round started, e.g. while waiting for the
+// commit timeout of the previous height, are skipped.
+func (m *Metrics) MarkVoteDelay(vote *types.Vote) {
+	if m.roundStart.IsZero() || vote.Height != m.roundStartHeight || vote.Round != m.roundStartRound {
+		return
+	}
+	m.VoteDelaySeconds.With(
+		"validator_address", vote.ValidatorAddress.String(),
+		"vote_type", types.SignedMsgTypeToShortString(vote.Type),
+	).Observe(cmttime.Since(m.roundStart).Seconds())
+}
+
 func (m *Metrics) MarkLateVote(vt types.SignedMsgType) {
 	n := types.SignedMsgTypeToShortString(vt)
 	m.LateVotes.With("vote_type", n).Add(1)
@@ -0,0 +1,45 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cometbft/cometbft/types"
+)
+
+// countingHistogram counts the observations of all its label values.
+type countingHistogram struct {
+	count *int
+}
+
+func (h countingHistogram) With(...string) metrics.Histogram { return h }
+func (h countingHistogram) Observe(float64)                  { *h.count++ }
+
+func TestMetricsMarkVoteDelay(t *testing.T) {
+	m := NopMetrics()
+	var observed int
+	m.VoteDelaySeconds = countingHistogram{count: &observed}
+	vote := func(height int64, round int32) *types.Vote {
+		return &types.Vote{Type: types.PrevoteType, Height: height, Round: round}
+	}
+
+	// No round started yet.
+	m.MarkVoteDelay(vote(1, 0))
+	assert.Zero(t, observed)
+
+	m.MarkRoundStart(1, 0)
+	m.MarkVoteDelay(vote(1, 0))
+	assert.Equal(t, 1, observed)
+
+	// A vote of the next height received before its round started, e.g.
+	// during the commit timeout, is skipped.
+	m.MarkVoteDelay(vote(2, 0))
+	m.MarkVoteDelay(vote(1, 1))
+	assert.Equal(t, 1, observed)
+
+	m.MarkRoundStart(2, 0)
+	m.MarkVoteDelay(vote(2, 0))
+	assert.Equal(t, 2, observed)
+}
@@ -118,6 +118,32 @@ func MsgToWrappedProto(msg Message) (cmtcons.Message, error) {
 
 		pb.Sum = &cmtcons.Message_VoteSetBits{VoteSetBits: vsb}
 
+	case *CompactBlockMessage:
+		cb, err := compactBlockToProto(msg)
+		if err != nil {
+			return pb, cmterrors.ErrMsgToProto{MessageName: "CompactBlock", Err: err}
+		}
+		pb.Sum = &cmtcons.Message_CompactBlock{CompactBlock: cb}
+
+	case *CompactBlockTxsRequestMessage:
+		pb.Sum = &cmtcons.Message_CompactBlockTxsRequest{CompactBlockTxsRequest: &cmtcons.CompactBlockTxsRequest{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+		}}
+
+	case *CompactBlockTxsMessage:
+		txs := make([][]byte, len(msg.Txs))
+		for i, tx := range msg.Txs {
+			txs[i] = tx
+		}
+		pb.Sum = &cmtcons.Message_CompactBlockTxs{CompactBlockTxs: &cmtcons.CompactBlockTxs{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+			Txs:     txs,
+		}}
+
 	default:
 		return pb, ErrConsensusMessageNotRecognized{msg}
 	}
@@ -239,6 +265,52 @@ func MsgFromProto(p proto.Message) (Message, error) {
 			BlockID: *bi,
 			Votes:   bits,
 		}
+	case *cmtcons.CompactBlock:
+		psh, err := types.PartSetHeaderFromProto(&msg.BlockPartSetHeader)
+		if err != nil {
+			return nil, cmterrors.ErrMsgFromProto{MessageName: "CompactBlock", Err: err}
+		}
+		header, err := types.HeaderFromProto(&msg.Header)
+		if err != nil {
+			return nil, cmterrors.ErrMsgFromProto{MessageName: "CompactBlock", Err: err}
+		}
+		var evidence types.EvidenceData
+		if err := evidence.FromProto(&msg.Evidence); err != nil {
+			return nil, cmterrors.ErrMsgFromProto{MessageName: "CompactBlock", Err: err}
+		}
+		var lastCommit *types.Commit
+		if msg.LastCommit != nil {
+			lastCommit, err = types.CommitFromProto(msg.LastCommit)
+			if err != nil {
+				return nil, cmterrors.ErrMsgFromProto{MessageName: "CompactBlock", Err: err}
+			}
+		}
+		pb = &CompactBlockMessage{
+			Height:             msg.Height,
+			Round:              msg.Round,
+			BlockPartSetHeader: *psh,
+			Header:             header,
+			TxHashes:           msg.TxHashes,
+			Evidence:           evidence,
+			LastCommit:         lastCommit,
+		}
+	case *cmtcons.CompactBlockTxsRequest:
+		pb = &CompactBlockTxsRequestMessage{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+		}
+	case *cmtcons.CompactBlockTxs:
+		txs := make(types.Txs, len(msg.Txs))
+		for i, tx := range msg.Txs {
+			txs[i] = tx
+		}
+		pb = &CompactBlockTxsMessage{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+			Txs:     txs,
+		}
 	default:
 		return nil, ErrConsensusMessageNotRecognized{msg}
 	}
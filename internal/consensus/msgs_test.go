@@ -187,6 +187,34 @@ func TestMsgToProto(t *testing.T) {
 
 			false,
 		},
+		{
+			"successful CompactBlockTxsRequest", &CompactBlockTxsRequestMessage{
+				Height:  1,
+				Round:   1,
+				Indexes: []int32{0, 3},
+			}, &cmtcons.CompactBlockTxsRequest{
+				Height:  1,
+				Round:   1,
+				Indexes: []int32{0, 3},
+			},
+
+			false,
+		},
+		{
+			"successful CompactBlockTxs", &CompactBlockTxsMessage{
+				Height:  1,
+				Round:   1,
+				Indexes: []int32{3},
+				Txs:     types.Txs{types.Tx("tx")},
+			}, &cmtcons.CompactBlockTxs{
+				Height:  1,
+				Round:   1,
+				Indexes: []int32{3},
+				Txs:     [][]byte{[]byte("tx")},
+			},
+
+			false,
+		},
 		{"failure", nil, &cmtcons.Message{}, true},
 	}
 	for _, tt := range testsCases {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	cmtcons "github.com/cometbft/cometbft/api/cometbft/consensus/v1"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/internal/bits"
 	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
 	cmtevents "github.com/cometbft/cometbft/internal/events"
@@ -49,6 +50,11 @@ type Reactor struct {
 	rsMtx cmtsync.Mutex
 	rs    *cstypes.RoundState
 
+	// compact block relay
+	mempool      txFetcher
+	compactMtx   cmtsync.Mutex
+	compactBlock *compactBlockBuilder // compact block being rebuilt, if any
+
 	Metrics *Metrics
 }
 
@@ -149,6 +155,11 @@ conR:
 	}
 }
 
+// GetHaltHeight returns the height after which consensus stops, or 0 if none.
+func (conR *Reactor) GetHaltHeight() int64 {
+	return conR.conS.GetHaltHeight()
+}
+
 // GetChannels implements Reactor.
 func (*Reactor) GetChannels() []*p2p.ChannelDescriptor {
 	// TODO optimize
@@ -339,6 +350,14 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 			ps.SetHasProposalBlockPart(msg.Height, msg.Round, int(msg.Part.Index))
 			conR.Metrics.BlockParts.With("peer_id", string(e.Src.ID())).Add(1)
 			conR.conS.peerMsgQueue <- msgInfo{msg, e.Src.ID(), time.Time{}}
+		case *CompactBlockMessage:
+			// The peer has the whole block, no need to send it a compact block.
+			ps.SetHasCompactBlock(msg.Height, msg.Round, time.Time{})
+			conR.handleCompactBlock(msg, e.Src)
+		case *CompactBlockTxsRequestMessage:
+			conR.handleCompactBlockTxsRequest(msg, e.Src)
+		case *CompactBlockTxsMessage:
+			conR.handleCompactBlockTxs(msg, e.Src)
 		default:
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
@@ -588,12 +607,25 @@ OUTER_LOOP:
 		rs := conR.getRoundState()
 		prs := ps.GetRoundState()
 
+		// --------------------
+		// Send compact block?
+		// (If compact blocks are enabled, height and round match, we have the
+		// complete proposal block and the peer has the proposal but no parts)
+		// --------------------
+
+		if conR.conS.config.CompactBlocks && conR.sendCompactBlock(logger, rs, ps, prs) {
+			continue OUTER_LOOP
+		}
+
 		// --------------------
 		// Send block part?
 		// (Note these can match on hash so round doesn't matter)
 		// --------------------
 
-		if part, continueLoop := pickPartToSend(logger, conR.conS.blockStore, rs, ps, prs, rng); part != nil {
+		if ps.CompactBlockPending(prs.Height, prs.Round, conR.conS.config.CompactBlocksFallbackTimeout) {
+			// The peer is rebuilding the block from the compact block we sent,
+			// hold off sending it parts until the fallback timeout expires.
+		} else if part, continueLoop := pickPartToSend(logger, conR.conS.blockStore, rs, ps, prs, rng); part != nil {
 			// part is not nil: we either succeed in sending it,
 			// or we were instructed not to sleep (busy-waiting)
 			if ps.SendPartSetHasPart(part, prs) || continueLoop {
@@ -1047,6 +1079,11 @@ func ReactorMetrics(metrics *Metrics) ReactorOption {
 	return func(conR *Reactor) { conR.Metrics = metrics }
 }
 
+// ReactorMempool sets the mempool used to rebuild compact blocks.
+func ReactorMempool(mempool txFetcher) ReactorOption {
+	return func(conR *Reactor) { conR.mempool = mempool }
+}
+
 // -----------------------------------------------------------------------------
 
 // PeerState contains the known state of a peer, including its connection and
@@ -1060,6 +1097,12 @@ type PeerState struct {
 	mtx   sync.Mutex             // NOTE: Modify below using setters, never directly.
 	PRS   cstypes.PeerRoundState `json:"round_state"` // Exposed.
 	Stats *peerStateStats        `json:"stats"`       // Exposed.
+
+	// Height and round of the last compact block sent to or received from the
+	// peer, and when it was sent.
+	compactBlockHeight int64
+	compactBlockRound  int32
+	compactBlockSentAt time.Time
 }
 
 // peerStateStats holds internal statistics for a peer.
@@ -1184,6 +1227,39 @@ func (ps *PeerState) setHasProposalBlockPart(height int64, round int32, index in
 	ps.PRS.ProposalBlockParts.SetIndex(index, true)
 }
 
+// SetHasCompactBlock marks the peer as having the compact block for the given
+// height and round. sentAt is the time we sent it the compact block, or zero
+// if we did not.
+func (ps *PeerState) SetHasCompactBlock(height int64, round int32, sentAt time.Time) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.compactBlockHeight = height
+	ps.compactBlockRound = round
+	ps.compactBlockSentAt = sentAt
+}
+
+// HasCompactBlock returns true if the peer has the compact block for the given
+// height and round.
+func (ps *PeerState) HasCompactBlock(height int64, round int32) bool {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	return ps.compactBlockHeight == height && ps.compactBlockRound == round
+}
+
+// CompactBlockPending returns true if we sent the peer the compact block for
+// the given height and round less than timeout ago.
+func (ps *PeerState) CompactBlockPending(height int64, round int32, timeout time.Duration) bool {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.compactBlockHeight != height || ps.compactBlockRound != round || ps.compactBlockSentAt.IsZero() {
+		return false
+	}
+	return cmttime.Since(ps.compactBlockSentAt) < timeout
+}
+
 // SendPartSetHasPart sends the part to the peer.
 // Returns true and marks the peer as having the part if the part was sent.
 func (ps *PeerState) SendPartSetHasPart(part *types.Part, prs *cstypes.PeerRoundState) bool {
@@ -1642,6 +1718,9 @@ func init() {
 	cmtjson.RegisterType(&HasProposalBlockPartMessage{}, "tendermint/HasProposalBlockPart")
 	cmtjson.RegisterType(&VoteSetMaj23Message{}, "tendermint/VoteSetMaj23")
 	cmtjson.RegisterType(&VoteSetBitsMessage{}, "tendermint/VoteSetBits")
+	cmtjson.RegisterType(&CompactBlockMessage{}, "tendermint/CompactBlock")
+	cmtjson.RegisterType(&CompactBlockTxsRequestMessage{}, "tendermint/CompactBlockTxsRequest")
+	cmtjson.RegisterType(&CompactBlockTxsMessage{}, "tendermint/CompactBlockTxs")
 }
 
 // -------------------------------------
@@ -1974,8 +2053,140 @@ func (m *HasProposalBlockPartMessage) String() string {
 	return fmt.Sprintf("[HasProposalBlockPart PI:%v HR:{%v/%02d}]", m.Index, m.Height, m.Round)
 }
 
+// -------------------------------------
+
+// CompactBlockMessage is sent in place of the proposal block parts when compact
+// block relay is enabled. It carries everything needed to rebuild the proposal
+// block, except the transactions, which are referenced by their hashes.
+type CompactBlockMessage struct {
+	Height             int64
+	Round              int32
+	BlockPartSetHeader types.PartSetHeader
+	Header             types.Header
+	TxHashes           [][]byte
+	Evidence           types.EvidenceData
+	LastCommit         *types.Commit
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockMessage) ValidateBasic() error {
+	if m.Height < 1 {
+		return cmterrors.ErrInvalidField{Field: "Height", Reason: "( < 1 )"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if err := m.BlockPartSetHeader.ValidateBasic(); err != nil {
+		return cmterrors.ErrWrongField{Field: "BlockPartSetHeader", Err: err}
+	}
+	if m.BlockPartSetHeader.IsZero() {
+		return cmterrors.ErrRequiredField{Field: "BlockPartSetHeader"}
+	}
+	if err := m.Header.ValidateBasic(); err != nil {
+		return cmterrors.ErrWrongField{Field: "Header", Err: err}
+	}
+	if m.Header.Height != m.Height {
+		return cmterrors.ErrInvalidField{
+			Field:  "Header",
+			Reason: fmt.Sprintf("height %v does not match message height %v", m.Header.Height, m.Height),
+		}
+	}
+	for i, hash := range m.TxHashes {
+		if len(hash) != tmhash.Size {
+			return cmterrors.ErrInvalidField{
+				Field:  "TxHashes",
+				Reason: fmt.Sprintf("hash #%d has size %d, expected %d", i, len(hash), tmhash.Size),
+			}
+		}
+	}
+	if m.LastCommit != nil {
+		if err := m.LastCommit.ValidateBasic(); err != nil {
+			return cmterrors.ErrWrongField{Field: "LastCommit", Err: err}
+		}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockMessage) String() string {
+	return fmt.Sprintf("[CompactBlock H:%v R:%v BP:%v Txs:%v]",
+		m.Height, m.Round, m.BlockPartSetHeader, len(m.TxHashes))
+}
+
+// -------------------------------------
+
+// CompactBlockTxsRequestMessage is sent to request the transactions of a
+// compact block that are missing from the local mempool.
+type CompactBlockTxsRequestMessage struct {
+	Height  int64
+	Round   int32
+	Indexes []int32
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockTxsRequestMessage) ValidateBasic() error {
+	if m.Height < 1 {
+		return cmterrors.ErrInvalidField{Field: "Height", Reason: "( < 1 )"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if len(m.Indexes) == 0 {
+		return cmterrors.ErrRequiredField{Field: "Indexes"}
+	}
+	for _, index := range m.Indexes {
+		if index < 0 {
+			return cmterrors.ErrNegativeField{Field: "Indexes"}
+		}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockTxsRequestMessage) String() string {
+	return fmt.Sprintf("[CompactBlockTxsRequest H:%v R:%v Txs:%v]", m.Height, m.Round, len(m.Indexes))
+}
+
+// -------------------------------------
+
+// CompactBlockTxsMessage is sent in response to a CompactBlockTxsRequestMessage.
+// Txs[i] is the transaction at position Indexes[i] in the block.
+type CompactBlockTxsMessage struct {
+	Height  int64
+	Round   int32
+	Indexes []int32
+	Txs     types.Txs
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockTxsMessage) ValidateBasic() error {
+	if m.Height < 1 {
+		return cmterrors.ErrInvalidField{Field: "Height", Reason: "( < 1 )"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if len(m.Indexes) != len(m.Txs) {
+		return fmt.Errorf("number of indexes %d not equal to number of txs %d", len(m.Indexes), len(m.Txs))
+	}
+	for _, index := range m.Indexes {
+		if index < 0 {
+			return cmterrors.ErrNegativeField{Field: "Indexes"}
+		}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockTxsMessage) String() string {
+	return fmt.Sprintf("[CompactBlockTxs H:%v R:%v Txs:%v]", m.Height, m.Round, len(m.Txs))
+}
+
 var (
 	_ types.Wrapper = &cmtcons.BlockPart{}
+	_ types.Wrapper = &cmtcons.CompactBlock{}
+	_ types.Wrapper = &cmtcons.CompactBlockTxs{}
+	_ types.Wrapper = &cmtcons.CompactBlockTxsRequest{}
 	_ types.Wrapper = &cmtcons.HasVote{}
 	_ types.Wrapper = &cmtcons.HasProposalBlockPart{}
 	_ types.Wrapper = &cmtcons.NewRoundStep{}
@@ -55,7 +55,7 @@ func startConsensusNet(t *testing.T, css []*State, n int) (
 	for i := 0; i < n; i++ {
 		// logger, err := cmtflags.ParseLogLevel("consensus:info,*:error", logger, "info")
 		// if err != nil {	t.Fatal(err)}
-		reactors[i] = NewReactor(css[i], true) // so we dont start the consensus states
+		reactors[i] = NewReactor(css[i], true, ReactorMempool(assertMempool(css[i].txNotifier))) // so we dont start the consensus states
 		reactors[i].SetLogger(css[i].Logger)
 
 		// eventBus is already started with the cs
@@ -120,6 +120,49 @@ func TestReactorBasic(t *testing.T) {
 	})
 }
 
+// Ensure a testnet makes blocks with compact block relay enabled, including
+// when some nodes are missing transactions from their mempools.
+func TestReactorCompactBlocks(t *testing.T) {
+	n := 4
+	css, cleanup := randConsensusNet(t, n, "consensus_reactor_compact_blocks_test", newMockTickerFunc(true), newKVStore,
+		func(c *cfg.Config) { c.Consensus.CompactBlocks = true })
+	defer cleanup()
+	reactors, blocksSubs, eventBuses := startConsensusNet(t, css, n)
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+
+	activeVals := make(map[string]struct{})
+	for i := 0; i < n; i++ {
+		pubKey, err := css[i].privValidator.GetPubKey()
+		require.NoError(t, err)
+		activeVals[string(pubKey.Address())] = struct{}{}
+	}
+
+	// wait till everyone makes the first new block
+	timeoutWaitGroup(n, func(j int) {
+		<-blocksSubs[j].Out()
+	})
+
+	// the last node does not have the txs in its mempool and must request
+	// them when rebuilding compact blocks
+	txs := kvstore.NewRandomTxs(10)
+	for j := 0; j < n-1; j++ {
+		for _, tx := range txs {
+			reqRes, err := assertMempool(css[j].txNotifier).CheckTx(tx, "")
+			require.NoError(t, err)
+			require.False(t, reqRes.Response.GetCheckTx().IsErr())
+		}
+	}
+	waitForAndValidateBlockWithTx(t, n, activeVals, blocksSubs, css, txs...)
+
+	rebuilt := false
+	for _, r := range reactors {
+		r.compactMtx.Lock()
+		rebuilt = rebuilt || r.compactBlock != nil
+		r.compactMtx.Unlock()
+	}
+	assert.True(t, rebuilt, "expected compact blocks to be rebuilt")
+}
+
 // Ensure we can process blocks with evidence.
 func TestReactorWithEvidence(t *testing.T) {
 	nValidators := 4
@@ -1113,3 +1156,51 @@ func TestMarshalJSONPeerState(t *testing.T) {
 			"block_parts":"0"}
 		}`, string(data))
 }
+
+func TestCompactBlockMessageValidateBasic(t *testing.T) {
+	block := types.MakeBlock(1, types.Txs{types.Tx("tx")}, &types.Commit{}, nil)
+	block.ProposerAddress = tmhash.SumTruncated([]byte("proposer"))
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		malleateFn func(*CompactBlockMessage)
+		expErr     string
+	}{
+		{func(*CompactBlockMessage) {}, ""},
+		{func(msg *CompactBlockMessage) { msg.Height = 0 }, "invalid field Height"},
+		{func(msg *CompactBlockMessage) { msg.Round = -1 }, "Round can't be negative"},
+		{func(msg *CompactBlockMessage) { msg.BlockPartSetHeader = types.PartSetHeader{} }, "BlockPartSetHeader is required"},
+		{func(msg *CompactBlockMessage) { msg.Header.Height = 2 }, "does not match message height"},
+		{func(msg *CompactBlockMessage) { msg.TxHashes[0] = []byte("short") }, "invalid field TxHashes"},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("#%d", i), func(t *testing.T) {
+			msg := makeCompactBlockMessage(block, 0, parts.Header())
+			tc.malleateFn(msg)
+			err := msg.ValidateBasic()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else if assert.Error(t, err) { //nolint:testifylint // require.Error doesn't work with the conditional here
+				assert.Contains(t, err.Error(), tc.expErr)
+			}
+		})
+	}
+}
+
+func TestCompactBlockTxsMessagesValidateBasic(t *testing.T) {
+	req := &CompactBlockTxsRequestMessage{Height: 1, Round: 0, Indexes: []int32{0, 2}}
+	require.NoError(t, req.ValidateBasic())
+	req.Indexes = nil
+	require.Error(t, req.ValidateBasic())
+	req.Indexes = []int32{-1}
+	require.Error(t, req.ValidateBasic())
+
+	resp := &CompactBlockTxsMessage{Height: 1, Round: 0, Indexes: []int32{0}, Txs: types.Txs{types.Tx("tx")}}
+	require.NoError(t, resp.ValidateBasic())
+	resp.Indexes = []int32{0, 1}
+	require.Error(t, resp.ValidateBasic())
+	resp.Height = 0
+	require.Error(t, resp.ValidateBasic())
+}
@@ -1183,6 +1183,10 @@ func (*mockBlockStore) SaveBlockWithExtendedCommit(*types.Block, *types.PartSet,
 func (*mockBlockStore) SaveBlock(*types.Block, *types.PartSet, *types.Commit) {
 }
 
+func (*mockBlockStore) BackfillBlock(*types.Block, *types.PartSet, *types.Commit) error {
+	return nil
+}
+
 func (bs *mockBlockStore) LoadBlockCommit(height int64) *types.Commit {
 	return bs.extCommits[height-1].ToCommit()
 }
@@ -1208,6 +1212,7 @@ func (bs *mockBlockStore) PruneBlocks(height int64, _ sm.State) (uint64, int64,
 }
 
 func (*mockBlockStore) DeleteLatestBlock() error { return nil }
+func (*mockBlockStore) ColdHeight() int64        { return 0 }
 func (*mockBlockStore) Close() error             { return nil }
 
 // ---------------------------------------
@@ -141,6 +141,12 @@ type State struct {
 	// a buffer to store the concatenated proposal block parts (serialization format)
 	// should only be accessed under the cs.mtx lock
 	serializedBlockBuffer []byte
+
+	// consensus stops after committing the block at haltHeight, or the first
+	// block not before haltTime, and closes halted
+	haltHeight int64
+	haltTime   time.Time
+	halted     chan struct{}
 }
 
 // StateOption sets an optional parameter on the State.
@@ -166,12 +172,17 @@ func NewState(
 		timeoutTicker:    NewTimeoutTicker(),
 		statsMsgQueue:    make(chan msgInfo, msgQueueSize),
 		done:             make(chan struct{}),
+		halted:           make(chan struct{}),
+		haltHeight:       config.HaltHeight,
 		doWALCatchup:     true,
 		wal:              nilWAL{},
 		evpool:           evpool,
 		evsw:             cmtevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
 	}
+	if config.HaltTime > 0 {
+		cs.haltTime = time.Unix(config.HaltTime, 0)
+	}
 	for _, option := range options {
 		option(cs)
 	}
@@ -297,6 +308,62 @@ func (cs *State) SetTimeoutTicker(timeoutTicker TimeoutTicker) {
 	cs.mtx.Unlock()
 }
 
+// SetHaltHeight makes consensus stop after committing the block at the given
+// height, overriding the halt_height configuration. 0 disables it. It returns
+// ErrHaltHeightCommitted if the block at that height is already committed.
+func (cs *State) SetHaltHeight(height int64) error {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	if height != 0 && height < cs.Height {
+		return ErrHaltHeightCommitted{HaltHeight: height, CommittedHeight: cs.Height - 1}
+	}
+	cs.haltHeight = height
+	return nil
+}
+
+// GetHaltHeight returns the height after which consensus stops, or 0 if none.
+func (cs *State) GetHaltHeight() int64 {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	return cs.haltHeight
+}
+
+// Halted returns a channel that is closed once consensus has stopped after
+// committing the block at the halt height or time. The block, the state and
+// the WAL are then persisted and the application has committed the block, so
+// the node can safely be stopped, e.g. to be upgraded.
+func (cs *State) Halted() <-chan struct{} {
+	return cs.halted
+}
+
+// haltReached returns true if consensus must stop after committing the block
+// at height, with the given time.
+func (cs *State) haltReached(height int64, blockTime time.Time) bool {
+	return (cs.haltHeight > 0 && height >= cs.haltHeight) ||
+		(!cs.haltTime.IsZero() && !blockTime.Before(cs.haltTime))
+}
+
+// halt stops consensus once the last block is committed: it syncs the WAL and
+// closes halted, so that the next height is not started.
+func (cs *State) halt() {
+	if err := cs.wal.FlushAndSync(); err != nil {
+		cs.Logger.Error("failed flushing WAL to disk", "err", err)
+	}
+	cs.Logger.Info("halting consensus", "height", cs.state.LastBlockHeight,
+		"halt_height", cs.haltHeight, "halt_time", cs.haltTime)
+	close(cs.halted)
+}
+
+func (cs *State) isHalted() bool {
+	select {
+	case <-cs.halted:
+		return true
+	default:
+		return false
+	}
+}
+
 // LoadCommit loads the commit for a given height.
 func (cs *State) LoadCommit(height int64) *types.Commit {
 	cs.mtx.RLock()
@@ -390,8 +457,19 @@ func (cs *State) OnStart() error {
 		return err
 	}
 
+	// The halt height or time may have been passed by block sync or state
+	// sync, in which case consensus does not start.
+	halt := cs.state.LastBlockHeight > 0 && !cs.isHalted() &&
+		cs.haltReached(cs.state.LastBlockHeight, cs.state.LastBlockTime)
+	if halt {
+		cs.halt()
+	}
+
 	// now start the receiveRoutine
 	go cs.receiveRoutine(0)
+	if halt {
+		return nil
+	}
 
 	// schedule the first round!
 	// use GetRoundState so we don't race the receiveRoutine for access
@@ -639,6 +717,19 @@ func (cs *State) votesFromSeenCommit(state sm.State) (*types.VoteSet, error) {
 	return vs, nil
 }
 
+// selectProposer returns validators with the proposer of round chosen by the
+// proposer selection of the consensus params. validators is not modified.
+func selectProposer(state sm.State, validators *types.ValidatorSet, round int32) *types.ValidatorSet {
+	selector := state.ConsensusParams.Validator.ProposerSelector()
+	proposer := selector.SelectProposer(validators, state.ProposerSeed, round)
+	if proposer == nil || bytes.Equal(proposer.Address, validators.GetProposer().Address) {
+		return validators
+	}
+	validators = validators.Copy()
+	validators.Proposer = proposer
+	return validators
+}
+
 // Updates State and increments height to match that of state.
 // The round becomes 0 and cs.Step becomes cstypes.RoundStepNewHeight.
 func (cs *State) updateToState(state sm.State) {
@@ -732,7 +823,7 @@ func (cs *State) updateToState(state sm.State) {
 		cs.StartTime = cs.CommitTime.Add(timeoutCommit)
 	}
 
-	cs.Validators = validators
+	cs.Validators = selectProposer(state, validators, 0)
 	cs.Proposal = nil
 	cs.ProposalReceiveTime = time.Time{}
 	cs.ProposalBlock = nil
@@ -823,6 +914,20 @@ func (cs *State) receiveRoutine(maxSteps int) {
 			}
 		}
 
+		if cs.isHalted() {
+			// drop everything until we are stopped
+			select {
+			case <-cs.txNotifier.TxsAvailable():
+			case <-cs.peerMsgQueue:
+			case <-cs.internalMsgQueue:
+			case <-cs.timeoutTicker.Chan():
+			case <-cs.Quit():
+				onExit(cs)
+				return
+			}
+			continue
+		}
+
 		rs := cs.RoundState
 		var mi msgInfo
 
@@ -1063,6 +1168,11 @@ func (cs *State) enterNewRound(height int64, round int32) {
 		return
 	}
 
+	if cs.isHalted() {
+		logger.Debug("entering new round after consensus halted")
+		return
+	}
+
 	if now := cmttime.Now(); cs.StartTime.After(now) {
 		logger.Debug("need to set a buffer and log message here for sanity", "start_time", cs.StartTime, "now", now)
 	}
@@ -1074,12 +1184,16 @@ func (cs *State) enterNewRound(height int64, round int32) {
 	if cs.Round < round {
 		validators = validators.Copy()
 		validators.IncrementProposerPriority(cmtmath.SafeSubInt32(round, cs.Round))
+		validators = selectProposer(cs.state, validators, round)
 	}
 
 	// Setup new round
 	// we don't fire newStep for this step,
 	// but we fire an event, so update the round step first
 	cs.updateRoundStep(round, cstypes.RoundStepNewRound)
+	if !cs.replayMode {
+		cs.metrics.MarkRoundStart(height, round)
+	}
 	cs.Validators = validators
 	// If round == 0, we've already reset these upon new height, and meanwhile
 	// we might have received a proposal for round 0.
@@ -1937,6 +2051,14 @@ func (cs *State) finalizeCommit(height int64) {
 		logger.Error("failed to get private validator pubkey", "err", err)
 	}
 
+	if cs.haltReached(height, block.Time) {
+		// The block and the state are saved, and the application committed
+		// the block: make sure the WAL is on disk too, and do not start the
+		// next height.
+		cs.halt()
+		return
+	}
+
 	// cs.StartTime is already set.
 	// Schedule Round0 to start soon.
 	cs.scheduleRound0(&cs.RoundState)
@@ -1985,6 +2107,7 @@ func (cs *State) recordMetrics(height int64, block *types.Block) {
 			if commitSig.BlockIDFlag == types.BlockIDFlagAbsent {
 				missingValidators++
 				missingValidatorsPower += val.VotingPower
+				cs.metrics.ValidatorMissedSignatures.With("validator_address", val.Address.String()).Add(1)
 			}
 
 			if bytes.Equal(val.Address, address) {
@@ -2396,6 +2519,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		vals := cs.state.Validators
 		_, val := vals.GetByIndex(vote.ValidatorIndex)
 		cs.metrics.MarkVoteReceived(vote.Type, val.VotingPower, vals.TotalVotingPower())
+		cs.metrics.MarkVoteDelay(vote)
 	}
 
 	if err := cs.eventBus.PublishEventVote(types.EventDataVote{Vote: vote}); err != nil {
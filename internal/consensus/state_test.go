@@ -142,6 +142,36 @@ func TestStateProposerSelection2(t *testing.T) {
 	}
 }
 
+// with verifiable-random proposer selection, the proposer of each round is
+// drawn from the proposer seed of the state.
+func TestStateProposerSelectionVerifiableRandom(t *testing.T) {
+	c := test.ConsensusParams()
+	c.Validator.ProposerSelection = types.ProposerSelectionVerifiableRandom
+	cs1, vss := randStateWithAppImpl(4, kvstore.NewInMemoryApplication(), c)
+	height, chainID := cs1.Height, cs1.state.ChainID
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+
+	startTestRound(cs1, height, 0)
+	ensureNewRound(newRoundCh, height, 0)
+
+	// everyone just votes nil, moving to the next round
+	for round := int32(0); round < 4; round++ {
+		vals := cs1.state.Validators.Copy()
+		if round > 0 {
+			vals.IncrementProposerPriority(round)
+		}
+		expected := types.VerifiableRandomSelector{}.SelectProposer(vals, cs1.state.ProposerSeed, round)
+
+		prop := cs1.GetRoundState().Validators.GetProposer()
+		require.Truef(t, bytes.Equal(prop.Address, expected.Address),
+			"expected proposer %X at round %d. Got %X", expected.Address, round, prop.Address)
+
+		signAddVotes(cs1, types.PrecommitType, chainID, types.BlockID{}, true, vss[1:]...)
+		ensureNewRound(newRoundCh, height, round+1)
+		incrementRound(vss[1:]...)
+	}
+}
+
 // a non-validator should timeout into the prevote round.
 func TestStateEnterProposeNoPrivValidator(t *testing.T) {
 	cs, _ := randState(1)
@@ -430,6 +460,60 @@ func TestStateFullRound2(t *testing.T) {
 	ensureNewBlock(newBlockCh, height)
 }
 
+// consensus should stop after committing the block at the halt height.
+func TestStateHaltHeight(t *testing.T) {
+	cs1, vss := randState(2)
+	vs2 := vss[1]
+	height, round, chainID := cs1.Height, cs1.Round, cs1.state.ChainID
+
+	require.NoError(t, cs1.SetHaltHeight(height))
+	assert.Equal(t, height, cs1.GetHaltHeight())
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensurePrevote(voteCh, height, round)
+
+	rs := cs1.GetRoundState()
+	blockID := types.BlockID{Hash: rs.ProposalBlock.Hash(), PartSetHeader: rs.ProposalBlockParts.Header()}
+	signAddVotes(cs1, types.PrevoteType, chainID, blockID, false, vs2)
+	ensurePrevote(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+	signAddVotes(cs1, types.PrecommitType, chainID, blockID, true, vs2)
+	ensurePrecommit(voteCh, height, round)
+	ensureNewBlock(newBlockCh, height)
+
+	select {
+	case <-cs1.Halted():
+	case <-time.After(ensureTimeout):
+		t.Fatal("consensus did not halt")
+	}
+	assert.Equal(t, height, cs1.GetState().LastBlockHeight)
+	ensureNoNewEventOnChannel(newRoundCh)
+
+	// the halt height cannot be set to a committed height
+	require.ErrorAs(t, cs1.SetHaltHeight(height), &ErrHaltHeightCommitted{})
+
+	// consensus halts when started past the halt height, e.g. after block sync
+	csConfig := *cs1.config
+	csConfig.HaltHeight = height
+	cs2 := NewState(&csConfig, cs1.GetState(), cs1.blockExec, cs1.blockStore, cs1.txNotifier, cs1.evpool)
+	cs2.SetLogger(cs1.Logger)
+	cs2.doWALCatchup = false
+	require.NoError(t, cs2.Start())
+	defer func() { _ = cs2.Stop() }()
+	select {
+	case <-cs2.Halted():
+	default:
+		t.Fatal("consensus did not halt")
+	}
+	assert.Equal(t, height+1, cs2.GetRoundState().Height)
+	assert.Equal(t, cstypes.RoundStepNewHeight, cs2.GetRoundState().Step)
+}
+
 // ------------------------------------------------------------------------------------------
 // LockSuite
 
@@ -58,7 +58,10 @@ func WALGenerateNBlocks(t *testing.T, wr io.Writer, numBlocks int, config *cfg.C
 		t.Error(err)
 	}
 
-	blockStore := store.NewBlockStore(blockStoreDB)
+	blockStore, err := store.OpenBlockStore(blockStoreDB, store.WithStorageConfig(config.Storage))
+	if err != nil {
+		return fmt.Errorf("failed to open the block store: %w", err)
+	}
 
 	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app), proxy.NopMetrics())
 	proxyApp.SetLogger(logger.With("module", "proxy"))
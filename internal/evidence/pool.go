@@ -2,7 +2,10 @@ package evidence
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -685,6 +688,102 @@ func (v2Layout) CalcKeyPending(evidence types.Evidence) []byte {
 
 var _ KeyLayout = (*v2Layout)(nil)
 
+// evidenceKey identifies an entry of the evidence store independently of the
+// key layout.
+type evidenceKey struct {
+	committed bool
+	height    int64
+	hash      []byte
+}
+
+func (v1LegacyLayout) calcKey(k evidenceKey) []byte {
+	base := baseKeyPending
+	if k.committed {
+		base = baseKeyCommitted
+	}
+	return append([]byte{base}, []byte(fmt.Sprintf("%s/%X", bE(k.height), k.hash))...)
+}
+
+func (v1LegacyLayout) parseKey(key []byte) (evidenceKey, bool) {
+	if len(key) == 0 || (key[0] != baseKeyCommitted && key[0] != baseKeyPending) {
+		return evidenceKey{}, false
+	}
+	height, hash, ok := strings.Cut(string(key[1:]), "/")
+	if !ok {
+		return evidenceKey{}, false
+	}
+	k := evidenceKey{committed: key[0] == baseKeyCommitted}
+	var err error
+	if k.height, err = strconv.ParseInt(height, 16, 64); err != nil {
+		return evidenceKey{}, false
+	}
+	if k.hash, err = hex.DecodeString(hash); err != nil {
+		return evidenceKey{}, false
+	}
+	return k, true
+}
+
+func (v2Layout) calcKey(k evidenceKey) []byte {
+	prefix := prefixPending
+	if k.committed {
+		prefix = prefixCommitted
+	}
+	key, err := orderedcode.Append(nil, prefix, k.height, string(k.hash))
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func (v2Layout) parseKey(key []byte) (evidenceKey, bool) {
+	var (
+		prefix, height int64
+		hash           string
+	)
+	rest, err := orderedcode.Parse(string(key), &prefix, &height, &hash)
+	if err != nil || rest != "" || (prefix != prefixCommitted && prefix != prefixPending) {
+		return evidenceKey{}, false
+	}
+	return evidenceKey{committed: prefix == prefixCommitted, height: height, hash: []byte(hash)}, true
+}
+
+// migratableKeyLayout is a key layout of the evidence store.
+type migratableKeyLayout interface {
+	calcKey(k evidenceKey) []byte
+	parseKey(key []byte) (evidenceKey, bool)
+}
+
+func newKeyLayout(version string) (migratableKeyLayout, error) {
+	switch version {
+	case "v1", "":
+		return v1LegacyLayout{}, nil
+	case "v2":
+		return v2Layout{}, nil
+	default:
+		return nil, fmt.Errorf("unknown key layout version %q", version)
+	}
+}
+
+// MigrateKey returns the key of the evidence stored under key in the from key
+// layout, in the to key layout. Keys that do not depend on the key layout are
+// returned unchanged.
+func MigrateKey(key []byte, from, to string) ([]byte, error) {
+	fromLayout, err := newKeyLayout(from)
+	if err != nil {
+		return nil, err
+	}
+	toLayout, err := newKeyLayout(to)
+	if err != nil {
+		return nil, err
+	}
+	k, ok := fromLayout.parseKey(key)
+	// Keys parsed by chance, e.g. a key of another layout, are not rewritten.
+	if !ok || !bytes.Equal(fromLayout.calcKey(k), key) {
+		return key, nil
+	}
+	return toLayout.calcKey(k), nil
+}
+
 // -------- Util ---------
 // big endian padded hex.
 func bE(h int64) string {
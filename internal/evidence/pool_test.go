@@ -1,6 +1,7 @@
 package evidence_test
 
 import (
+	"fmt"
 	"os"
 	"testing"
 	"time"
@@ -471,3 +472,21 @@ func createState(height int64, valSet *types.ValidatorSet) sm.State {
 		ConsensusParams: *types.DefaultConsensusParams(),
 	}
 }
+
+func TestMigrateKey(t *testing.T) {
+	ev, err := types.NewMockDuplicateVoteEvidence(12, defaultEvidenceTime, evidenceChainID)
+	require.NoError(t, err)
+	for _, base := range []byte{0x00, 0x01} {
+		v1Key := append([]byte{base}, []byte(fmt.Sprintf("%016X/%X", ev.Height(), ev.Hash()))...)
+		v2Key, err := evidence.MigrateKey(v1Key, "v1", "v2")
+		require.NoError(t, err)
+		assert.NotEqual(t, v1Key, v2Key)
+		key, err := evidence.MigrateKey(v2Key, "v2", "v1")
+		require.NoError(t, err)
+		assert.Equal(t, v1Key, key)
+	}
+
+	key, err := evidence.MigrateKey([]byte("version"), "v1", "v2")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("version"), key)
+}
@@ -73,7 +73,11 @@ func NewFromConfig(cfg *config.Config) (*Inspector, error) {
 	if err != nil {
 		return nil, err
 	}
-	bs := store.NewBlockStore(bsDB, store.WithDBKeyLayout(cfg.Storage.ExperimentalKeyLayout))
+	bs, err := store.OpenBlockStore(bsDB, store.WithStorageConfig(cfg.Storage))
+	if err != nil {
+		_ = bsDB.Close()
+		return nil, err
+	}
 	sDB, err := config.DefaultDBProvider(&config.DBContext{ID: "state", Config: cfg})
 	if err != nil {
 		return nil, err
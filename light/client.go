@@ -22,6 +22,7 @@ type mode byte
 const (
 	sequential mode = iota + 1
 	skipping
+	hintedSkipping
 
 	defaultPruningSize      = 1000
 	defaultMaxRetryAttempts = 10
@@ -69,6 +70,22 @@ func SkippingVerification(trustLevel cmtmath.Fraction) Option {
 	}
 }
 
+// HintedSkippingVerification option configures the light client to skip blocks
+// as SkippingVerification does, but, when the primary implements
+// provider.ValidatorSetChangesProvider, to pick the blocks to verify in between
+// among the heights at which the validator set changed, instead of bisecting.
+// This saves round trips on chains whose validator set changes often.
+//
+// The heights are only hints: every light block is verified as with
+// SkippingVerification, and bisection is used when they are missing or of no
+// help.
+func HintedSkippingVerification(trustLevel cmtmath.Fraction) Option {
+	return func(c *Client) {
+		c.verificationMode = hintedSkipping
+		c.trustLevel = trustLevel
+	}
+}
+
 // PruningSize option sets the maximum amount of light blocks that the light
 // client stores. When Prune() is run, all light blocks that are earlier than
 // the h amount of light blocks will be removed from the store.
@@ -565,7 +582,7 @@ func (c *Client) verifyLightBlock(ctx context.Context, newLightBlock *types.Ligh
 	switch c.verificationMode {
 	case sequential:
 		verifyFunc = c.verifySequential
-	case skipping:
+	case skipping, hintedSkipping:
 		verifyFunc = c.verifySkippingAgainstPrimary
 	default:
 		panic(fmt.Sprintf("Unknown verification mode: %b", c.verificationMode))
@@ -702,6 +719,9 @@ func (c *Client) verifySequential(
 // requested from source is kept such that when a verification is made, and the
 // light client tries again to verify the new light block in the middle, the light
 // client does not need to ask for all the same light blocks again.
+//
+// With HintedSkippingVerification, the light block in the middle is picked
+// among the heights at which the validator set changed, see pickPivotHeight.
 func (c *Client) verifySkipping(
 	ctx context.Context,
 	source provider.Provider,
@@ -715,6 +735,9 @@ func (c *Client) verifySkipping(
 
 		verifiedBlock = trustedBlock
 		trace         = []*types.LightBlock{trustedBlock}
+
+		hints        []int64
+		hintsFetched = c.verificationMode != hintedSkipping
 	)
 
 	for {
@@ -745,8 +768,12 @@ func (c *Client) verifySkipping(
 		case ErrNewValSetCantBeTrusted:
 			// do add another header to the end of the cache
 			if depth == len(blockCache)-1 {
-				pivotHeight := verifiedBlock.Height + (blockCache[depth].Height-verifiedBlock.
-					Height)*verifySkippingNumerator/verifySkippingDenominator
+				// The hints are only fetched once skipping to the new light block failed.
+				if !hintsFetched {
+					hints = c.validatorSetChangeHints(ctx, source, trustedBlock.Height+1, newLightBlock.Height)
+					hintsFetched = true
+				}
+				pivotHeight := pickPivotHeight(hints, verifiedBlock.Height, blockCache[depth].Height)
 				interimBlock, providerErr := source.LightBlock(ctx, pivotHeight)
 				switch providerErr {
 				case nil:
@@ -771,6 +798,47 @@ func (c *Client) verifySkipping(
 	}
 }
 
+// validatorSetChangeHints returns the heights within [from, to] at which the
+// validator set changed according to source, or nil if source cannot tell.
+func (c *Client) validatorSetChangeHints(ctx context.Context, source provider.Provider, from, to int64) []int64 {
+	vp, ok := source.(provider.ValidatorSetChangesProvider)
+	if !ok {
+		return nil
+	}
+	heights, err := vp.ValidatorSetChanges(ctx, from, to)
+	if err != nil {
+		c.logger.Info("Can't get the validator set changes, bisecting instead",
+			"from", from, "to", to, "source", source, "err", err)
+		return nil
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	c.logger.Debug("Got the validator set changes", "from", from, "to", to, "heights", heights)
+	return heights
+}
+
+// pickPivotHeight returns the height of the light block to verify between the
+// verified light block and the one whose validator set can't be trusted from
+// it yet.
+//
+// As the validator set only changes at the heights given by hints, the
+// middle change in between is picked. Without any, but a change at
+// untrustedHeight, the block before it is picked: its validator set is the
+// one trusted by the verified block, and untrustedHeight is adjacent to it.
+// Otherwise, the hints are of no help and the range is bisected.
+func pickPivotHeight(hints []int64, verifiedHeight, untrustedHeight int64) int64 {
+	// The validator set at verifiedHeight+1 is already trusted, being the next
+	// validator set of the verified block.
+	lo := sort.Search(len(hints), func(i int) bool { return hints[i] > verifiedHeight+1 })
+	hi := sort.Search(len(hints), func(i int) bool { return hints[i] >= untrustedHeight })
+	switch {
+	case lo < hi:
+		return hints[(lo+hi)/2]
+	case hi < len(hints) && hints[hi] == untrustedHeight && untrustedHeight-1 > verifiedHeight:
+		return untrustedHeight - 1
+	}
+	return verifiedHeight + (untrustedHeight-verifiedHeight)*verifySkippingNumerator/verifySkippingDenominator
+}
+
 // verifySkippingAgainstPrimary does verifySkipping plus it compares new header with
 // witnesses and replaces primary if it sends the light client an invalid header.
 func (c *Client) verifySkippingAgainstPrimary(
@@ -842,6 +910,18 @@ func (c *Client) FirstTrustedHeight() (int64, error) {
 	return c.trustedStore.FirstLightBlockHeight()
 }
 
+// DivergenceReports returns the divergence reports saved by the detector, or
+// ErrDivergenceReportsNotSupported if the trusted store does not persist them.
+//
+// Safe for concurrent use by multiple goroutines.
+func (c *Client) DivergenceReports() ([]*store.DivergenceReport, error) {
+	ds, ok := c.trustedStore.(store.DivergenceStore)
+	if !ok {
+		return nil, ErrDivergenceReportsNotSupported
+	}
+	return ds.DivergenceReports()
+}
+
 // ChainID returns the chain ID the light client was configured with.
 //
 // Safe for concurrent use by multiple goroutines.
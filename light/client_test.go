@@ -402,6 +402,89 @@ func TestClientLargeBisectionVerification(t *testing.T) {
 	assert.Equal(t, h, h2)
 }
 
+// countingProvider counts the light blocks requested from the provider.
+type countingProvider struct {
+	provider.Provider
+	lightBlocks int
+}
+
+func (p *countingProvider) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	p.lightBlocks++
+	return p.Provider.LightBlock(ctx, height)
+}
+
+// hintingProvider also provides the validator set changes.
+type hintingProvider struct {
+	*countingProvider
+	changes provider.ValidatorSetChangesProvider
+}
+
+func (p *hintingProvider) ValidatorSetChanges(ctx context.Context, from, to int64) ([]int64, error) {
+	return p.changes.ValidatorSetChanges(ctx, from, to)
+}
+
+func TestClient_HintedSkippingVerification(t *testing.T) {
+	// The validator set is entirely replaced every 20 blocks, so that no block
+	// can be skipped to across a change.
+	const latestHeight = 100
+	var (
+		keySets    = make([]privKeys, latestHeight/20+1)
+		headers    = make(map[int64]*types.SignedHeader, latestHeight)
+		validators = make(map[int64]*types.ValidatorSet, latestHeight)
+		lastHeader *types.SignedHeader
+	)
+	for i := range keySets {
+		keySets[i] = genPrivKeys(4)
+	}
+	for height := int64(1); height <= latestHeight; height++ {
+		keys, nextKeys := keySets[height/20], keySets[(height+1)/20]
+		lastBlockID := types.BlockID{}
+		if lastHeader != nil {
+			lastBlockID.Hash = lastHeader.Hash()
+		}
+		headers[height] = keys.GenSignedHeaderLastBlockID(chainID, height, bTime.Add(time.Duration(height)*time.Minute),
+			nil, keys.ToValidators(2, 0), nextKeys.ToValidators(2, 0), hash("app_hash"), hash("cons_hash"),
+			hash("results_hash"), 0, len(keys), lastBlockID)
+		validators[height] = keys.ToValidators(2, 0)
+		lastHeader = headers[height]
+	}
+
+	verify := func(primary provider.Provider, option light.Option) {
+		witness := mockp.New(chainID, headers, validators)
+		c, err := light.NewClient(
+			ctx,
+			chainID,
+			light.TrustOptions{
+				Period: 4 * time.Hour,
+				Height: 1,
+				Hash:   headers[1].Hash(),
+			},
+			primary,
+			[]provider.Provider{witness},
+			dbs.New(dbm.NewMemDB(), chainID),
+			option,
+		)
+		require.NoError(t, err)
+		lb, err := c.VerifyLightBlockAtHeight(ctx, latestHeight, bTime.Add(2*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, headers[latestHeight].Hash(), lb.Hash())
+	}
+
+	bisecting := &countingProvider{Provider: mockp.New(chainID, headers, validators)}
+	verify(bisecting, light.SkippingVerification(light.DefaultTrustLevel))
+
+	mock := mockp.New(chainID, headers, validators)
+	hinted := &hintingProvider{countingProvider: &countingProvider{Provider: mock}, changes: mock}
+	verify(hinted, light.HintedSkippingVerification(light.DefaultTrustLevel))
+
+	assert.Less(t, hinted.lightBlocks, bisecting.lightBlocks)
+
+	// Without the capability, the hinted verification bisects.
+	unhinted := &countingProvider{Provider: mockp.New(chainID, headers, validators)}
+	verify(unhinted, light.HintedSkippingVerification(light.DefaultTrustLevel))
+	assert.Equal(t, bisecting.lightBlocks, unhinted.lightBlocks)
+}
+
 func TestClientBisectionBetweenTrustedHeaders(t *testing.T) {
 	c, err := light.NewClient(
 		ctx,
@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/cometbft/cometbft/light/provider"
+	"github.com/cometbft/cometbft/light/store"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -258,6 +260,15 @@ func (c *Client) handleConflictingHeaders(
 	)
 	if err != nil {
 		c.logger.Info("Error validating primary's divergent header", "primary", c.primary, "err", err)
+		c.saveDivergenceReport(&store.DivergenceReport{
+			Time:                   now,
+			Height:                 primaryBlock.Height,
+			Primary:                fmt.Sprint(c.primary),
+			Witness:                fmt.Sprint(supportingWitness),
+			PrimaryBlock:           primaryBlock,
+			WitnessBlock:           witnessTrace[len(witnessTrace)-1],
+			EvidenceAgainstPrimary: evidenceAgainstPrimary,
+		})
 		return ErrLightClientAttack
 	}
 
@@ -267,10 +278,34 @@ func (c *Client) handleConflictingHeaders(
 	c.logger.Error("Sending evidence against witness by primary", "ev", evidenceAgainstWitness,
 		"primary", c.primary, "witness", supportingWitness)
 	c.sendEvidence(ctx, evidenceAgainstWitness, c.primary)
+	c.saveDivergenceReport(&store.DivergenceReport{
+		Time:                   now,
+		Height:                 primaryBlock.Height,
+		Primary:                fmt.Sprint(c.primary),
+		Witness:                fmt.Sprint(supportingWitness),
+		PrimaryBlock:           primaryBlock,
+		WitnessBlock:           witnessBlock,
+		EvidenceAgainstPrimary: evidenceAgainstPrimary,
+		EvidenceAgainstWitness: evidenceAgainstWitness,
+	})
 	// We return the error and don't process anymore witnesses
 	return ErrLightClientAttack
 }
 
+// saveDivergenceReport persists the report if the trusted store supports it.
+// Failing to do so is logged, but does not prevent the client from halting.
+func (c *Client) saveDivergenceReport(r *store.DivergenceReport) {
+	ds, ok := c.trustedStore.(store.DivergenceStore)
+	if !ok {
+		return
+	}
+	if err := ds.SaveDivergenceReport(r); err != nil {
+		c.logger.Error("Failed to save divergence report", "height", r.Height, "err", err)
+		return
+	}
+	c.logger.Info("Saved divergence report", "id", r.ID, "height", r.Height)
+}
+
 // examineConflictingHeaderAgainstTrace takes a trace from one provider and a divergent header that
 // it has received from another and performs verifySkipping at the heights of each of the intermediate
 // headers in the trace until it reaches the divergentHeader. 1 of 2 things can happen.
@@ -86,6 +86,16 @@ func TestLightClientAttackEvidence_Lunatic(t *testing.T) {
 		CommonHeight: 4,
 	}
 	assert.True(t, primary.HasEvidence(evAgainstWitness))
+
+	// Check the divergence was persisted along with the evidence.
+	reports, err := c.DivergenceReports()
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.EqualValues(t, 10, reports[0].Height)
+	assert.NotEmpty(t, reports[0].Primary)
+	assert.NotEmpty(t, reports[0].Witness)
+	assert.Equal(t, primaryHeaders[10].Hash(), reports[0].EvidenceAgainstPrimary.ConflictingBlock.Hash())
+	assert.Equal(t, witnessHeaders[7].Hash(), reports[0].EvidenceAgainstWitness.ConflictingBlock.Hash())
 }
 
 func TestLightClientAttackEvidence_Equivocation(t *testing.T) {
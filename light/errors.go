@@ -38,6 +38,10 @@ Check logs for full evidence and trace`)
 	ErrRemoveStoredBlocksRefused = errors.New("refused to remove the stored light blocks despite hashes mismatch")
 	ErrNoHeadersExist            = errors.New("no headers exist")
 	ErrNilHeader                 = errors.New("nil header")
+
+	// ErrDivergenceReportsNotSupported is returned when the trusted store does
+	// not implement store.DivergenceStore.
+	ErrDivergenceReportsNotSupported = errors.New("the trusted store does not persist divergence reports")
 )
 
 // ErrOldHeaderExpired means the old (trusted) header has expired according to
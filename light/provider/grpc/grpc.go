@@ -0,0 +1,225 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/light/provider"
+	grpcclient "github.com/cometbft/cometbft/rpc/grpc/client"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Scheme is the scheme of the addresses of the nodes light blocks are fetched
+// from over gRPC, e.g. "grpc://127.0.0.1:26670".
+const Scheme = "grpc"
+
+var (
+	maxRetryAttempts = 5
+	timeout          = 5 * time.Second
+
+	// ErrReportEvidenceNotSupported is returned by ReportEvidence, as evidence
+	// cannot be submitted over the gRPC API.
+	ErrReportEvidenceNotSupported = errors.New("reporting evidence is not supported over gRPC")
+)
+
+// Client is the subset of the gRPC client used by the provider.
+type Client interface {
+	grpcclient.BlockServiceClient
+	grpcclient.ValidatorSetServiceClient
+}
+
+// grpc provider uses the block and validator set services of a node's gRPC
+// server to obtain the necessary information.
+//
+// As the commit of a block is only stored in the next block, the latest light
+// block it provides is the one before the latest block of the node.
+type grpc struct {
+	chainID string
+	remote  string
+	client  Client
+}
+
+var _ provider.ValidatorSetChangesProvider = (*grpc)(nil)
+
+// New creates a gRPC provider, connecting without transport security to
+// remote, with or without the grpc:// scheme. The 5s timeout is used for all
+// requests.
+func New(chainID, remote string) (provider.Provider, error) {
+	remote = strings.TrimPrefix(remote, Scheme+"://")
+	client, err := grpcclient.New(context.Background(), remote, grpcclient.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	return NewWithClient(chainID, remote, client), nil
+}
+
+// NewWithClient allows you to provide a custom client, remote being only used
+// to describe the provider.
+func NewWithClient(chainID, remote string, client Client) provider.Provider {
+	return &grpc{
+		chainID: chainID,
+		remote:  remote,
+		client:  client,
+	}
+}
+
+// ChainID returns a chainID this provider was configured with.
+func (p *grpc) ChainID() string {
+	return p.chainID
+}
+
+func (p *grpc) String() string {
+	return fmt.Sprintf("grpc{%s}", p.remote)
+}
+
+// LightBlock fetches a LightBlock at the given height and checks the
+// chainID matches.
+func (p *grpc) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	if height < 0 {
+		return nil, provider.ErrBadLightBlock{Reason: provider.ErrNegativeHeight{Height: height}}
+	}
+
+	if height == 0 {
+		latest, err := retry(ctx, func(ctx context.Context) (*grpcclient.ValidatorSet, error) {
+			return p.client.GetValidatorSetByHeight(ctx, 0)
+		})
+		if err != nil {
+			return nil, err
+		}
+		// The commit of the latest block is not part of any block yet.
+		height = latest.Height - 1
+		if height < 1 {
+			return nil, provider.ErrHeightTooHigh
+		}
+	}
+
+	sh, err := p.signedHeader(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	vs, err := retry(ctx, func(ctx context.Context) (*grpcclient.ValidatorSet, error) {
+		return p.client.GetValidatorSetByHeight(ctx, height)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if vs.Height != height {
+		return nil, provider.ErrBadLightBlock{
+			Reason: fmt.Errorf("validator set height %d responded doesn't match height %d requested", vs.Height, height),
+		}
+	}
+
+	lb := &types.LightBlock{
+		SignedHeader: sh,
+		ValidatorSet: vs.ValidatorSet,
+	}
+	if err := lb.ValidateBasic(p.chainID); err != nil {
+		return nil, provider.ErrBadLightBlock{Reason: err}
+	}
+	return lb, nil
+}
+
+// ValidatorSetChanges implements provider.ValidatorSetChangesProvider, using
+// the GetChanges endpoint of the ValidatorSetService. Ranges truncated by the
+// node are requested again from where they stopped.
+func (p *grpc) ValidatorSetChanges(ctx context.Context, from, to int64) ([]int64, error) {
+	heights := make([]int64, 0)
+	for from <= to {
+		changes, err := retry(ctx, func(ctx context.Context) (*grpcclient.ValidatorSetChanges, error) {
+			return p.client.GetValidatorSetChanges(ctx, from, to)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if changes.LastHeight < from || changes.LastHeight > to {
+			return nil, fmt.Errorf("last height %d responded is outside of the range [%d, %d] requested",
+				changes.LastHeight, from, to)
+		}
+		heights = append(heights, changes.Heights...)
+		from = changes.LastHeight + 1
+	}
+	return heights, nil
+}
+
+// ReportEvidence returns ErrReportEvidenceNotSupported, as the gRPC API has no
+// endpoint to submit evidence.
+func (*grpc) ReportEvidence(context.Context, types.Evidence) error {
+	return ErrReportEvidenceNotSupported
+}
+
+// signedHeader returns the header of the block at height, signed by the last
+// commit of the next block, without fetching the blocks themselves.
+func (p *grpc) signedHeader(ctx context.Context, height int64) (*types.SignedHeader, error) {
+	sh, err := retry(ctx, func(ctx context.Context) (*types.SignedHeader, error) {
+		return p.client.GetSignedHeader(ctx, height)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if sh.Header == nil || sh.Commit == nil {
+		return nil, provider.ErrBadLightBlock{Reason: errors.New("signed header responded is missing its header or commit")}
+	}
+	if sh.Height != height {
+		return nil, provider.ErrBadLightBlock{
+			Reason: fmt.Errorf("height %d responded doesn't match height %d requested", sh.Height, height),
+		}
+	}
+	return sh, nil
+}
+
+// retry calls f with a timeout, retrying with exponential backoff while the
+// node is unavailable, and maps the errors of the gRPC services to the
+// provider errors.
+func retry[T any](ctx context.Context, f func(context.Context) (T, error)) (T, error) {
+	var zero T
+	for attempt := 1; attempt <= maxRetryAttempts; attempt++ {
+		callCtx, cancel := context.WithTimeout(ctx, timeout)
+		res, err := f(callCtx)
+		cancel()
+		if err == nil {
+			return res, nil
+		}
+
+		st, _ := status.FromError(err)
+		switch {
+		case st.Code() == codes.InvalidArgument && strings.Contains(st.Message(), "higher than latest height"):
+			return zero, provider.ErrHeightTooHigh
+
+		case st.Code() == codes.InvalidArgument && strings.Contains(st.Message(), "below base height"),
+			st.Code() == codes.NotFound:
+			return zero, provider.ErrLightBlockNotFound
+
+		// the context was canceled by the caller.
+		case ctx.Err() != nil:
+			return zero, ctx.Err()
+
+		case attempt == maxRetryAttempts:
+			return zero, provider.ErrNoResponse
+
+		case st.Code() == codes.Unavailable, st.Code() == codes.DeadlineExceeded:
+			// we wait and try again with exponential backoff
+			time.Sleep(backoffTimeout(uint16(attempt)))
+			continue
+
+		default:
+			return zero, err
+		}
+	}
+	return zero, provider.ErrNoResponse
+}
+
+// exponential backoff (with jitter)
+// 0.5s -> 2s -> 4.5s -> 8s -> 12.5 with 1s variation.
+func backoffTimeout(attempt uint16) time.Duration {
+	//nolint:gosec // G404: Use of weak random number generator
+	return time.Duration(500*attempt*attempt)*time.Millisecond + time.Duration(rand.Intn(1000))*time.Millisecond
+}
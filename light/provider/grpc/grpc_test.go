@@ -0,0 +1,170 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/light/provider"
+	lightgrpc "github.com/cometbft/cometbft/light/provider/grpc"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	grpcclient "github.com/cometbft/cometbft/rpc/grpc/client"
+	rpctest "github.com/cometbft/cometbft/rpc/test"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestNewProvider(t *testing.T) {
+	c, err := lightgrpc.New("chain-test", "grpc://192.168.0.1:26670")
+	require.NoError(t, err)
+	require.Equal(t, "grpc{192.168.0.1:26670}", fmt.Sprintf("%s", c))
+
+	c, err = lightgrpc.New("chain-test", "192.168.0.1:26670")
+	require.NoError(t, err)
+	require.Equal(t, "grpc{192.168.0.1:26670}", fmt.Sprintf("%s", c))
+}
+
+func TestProvider(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	node := rpctest.StartCometBFT(app, rpctest.RecreateConfig)
+
+	cfg := rpctest.GetConfig()
+	defer os.RemoveAll(cfg.RootDir)
+	defer rpctest.StopCometBFT(node)
+	genDoc, err := types.GenesisDocFromFile(cfg.GenesisFile())
+	require.NoError(t, err)
+	chainID := genDoc.ChainID
+
+	c, err := rpchttp.New(cfg.RPC.ListenAddress)
+	require.NoError(t, err)
+	// let it produce some blocks
+	err = rpcclient.WaitForHeight(c, 10, nil)
+	require.NoError(t, err)
+
+	grpcAddr := "grpc://" + strings.TrimPrefix(cfg.GRPC.ListenAddress, "tcp://")
+	p, err := lightgrpc.New(chainID, grpcAddr)
+	require.NoError(t, err)
+
+	// let's get the highest block, the one before the latest block
+	lb, err := p.LightBlock(context.Background(), 0)
+	require.NoError(t, err)
+	require.NotNil(t, lb)
+	assert.GreaterOrEqual(t, lb.Height, int64(9))
+	require.NoError(t, lb.ValidateBasic(chainID))
+
+	// historical queries
+	lower := lb.Height - 3
+	lb, err = p.LightBlock(context.Background(), lower)
+	require.NoError(t, err)
+	assert.Equal(t, lower, lb.Height)
+	require.NoError(t, lb.ValidateBasic(chainID))
+
+	// the light block at the same height fetched over JSON-RPC is the same
+	commit, err := c.Commit(context.Background(), &lower)
+	require.NoError(t, err)
+	assert.Equal(t, commit.SignedHeader.Hash(), lb.Hash())
+	assert.Equal(t, commit.SignedHeader.Commit.Hash(), lb.Commit.Hash())
+
+	// fetching future heights should return the appropriate error
+	_, err = p.LightBlock(context.Background(), lb.Height+100000)
+	assert.Equal(t, provider.ErrHeightTooHigh, err)
+
+	_, err = p.LightBlock(context.Background(), -1)
+	assert.ErrorAs(t, err, &provider.ErrBadLightBlock{})
+
+	// the validator set of the kvstore app does not change
+	heights, err := p.(provider.ValidatorSetChangesProvider).ValidatorSetChanges(context.Background(), 1, lower)
+	require.NoError(t, err)
+	assert.Empty(t, heights)
+
+	err = p.ReportEvidence(context.Background(), nil)
+	assert.Equal(t, lightgrpc.ErrReportEvidenceNotSupported, err)
+}
+
+// errClient is a client failing all the requests with err.
+type errClient struct {
+	err error
+}
+
+func (c errClient) GetBlockByHeight(context.Context, int64) (*grpcclient.Block, error) {
+	return nil, c.err
+}
+
+func (c errClient) GetSignedHeader(context.Context, int64) (*types.SignedHeader, error) {
+	return nil, c.err
+}
+
+func (c errClient) GetLatestHeight(context.Context, ...grpcclient.GetLatestHeightOption) (<-chan grpcclient.LatestHeightResult, error) {
+	return nil, c.err
+}
+
+func (c errClient) GetValidatorSetByHeight(context.Context, int64) (*grpcclient.ValidatorSet, error) {
+	return nil, c.err
+}
+
+func (c errClient) GetValidatorSetChanges(context.Context, int64, int64) (*grpcclient.ValidatorSetChanges, error) {
+	return nil, c.err
+}
+
+func TestProvider_Errors(t *testing.T) {
+	testCases := []struct {
+		err         error
+		expectedErr error
+	}{
+		{status.Error(codes.InvalidArgument, "Requested height 10 is higher than latest height 5"), provider.ErrHeightTooHigh},
+		{status.Error(codes.InvalidArgument, "Requested height 1 is below base height 5"), provider.ErrLightBlockNotFound},
+		{status.Error(codes.NotFound, "Block not found for height 1"), provider.ErrLightBlockNotFound},
+	}
+	for _, tc := range testCases {
+		p := lightgrpc.NewWithClient("chain-test", "test", errClient{err: tc.err})
+		_, err := p.LightBlock(context.Background(), 1)
+		assert.Equal(t, tc.expectedErr, err, tc.err.Error())
+	}
+
+	// Other errors are returned as is.
+	internalErr := status.Error(codes.Internal, "Internal server error")
+	p := lightgrpc.NewWithClient("chain-test", "test", errClient{err: internalErr})
+	_, err := p.LightBlock(context.Background(), 0)
+	assert.Equal(t, internalErr, err)
+}
+
+// pagingClient returns the validator set changes, every 3 heights, 10 heights
+// at a time.
+type pagingClient struct {
+	errClient
+}
+
+func (pagingClient) GetValidatorSetChanges(_ context.Context, from, to int64) (*grpcclient.ValidatorSetChanges, error) {
+	last := min(to, from+9)
+	changes := &grpcclient.ValidatorSetChanges{LastHeight: last}
+	for h := from; h <= last; h++ {
+		if h%3 == 0 {
+			changes.Heights = append(changes.Heights, h)
+		}
+	}
+	return changes, nil
+}
+
+func TestProvider_ValidatorSetChanges(t *testing.T) {
+	p := lightgrpc.NewWithClient("chain-test", "test", pagingClient{})
+	vp, ok := p.(provider.ValidatorSetChangesProvider)
+	require.True(t, ok)
+
+	heights, err := vp.ValidatorSetChanges(context.Background(), 5, 25)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{6, 9, 12, 15, 18, 21, 24}, heights)
+
+	p = lightgrpc.NewWithClient("chain-test", "test", errClient{
+		err: status.Error(codes.InvalidArgument, "Requested height 25 is higher than latest height 20"),
+	})
+	_, err = p.(provider.ValidatorSetChangesProvider).ValidatorSetChanges(context.Background(), 5, 25)
+	assert.Equal(t, provider.ErrHeightTooHigh, err)
+}
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -22,7 +23,10 @@ type Mock struct {
 	latestHeight     int64
 }
 
-var _ provider.Provider = (*Mock)(nil)
+var (
+	_ provider.Provider                    = (*Mock)(nil)
+	_ provider.ValidatorSetChangesProvider = (*Mock)(nil)
+)
 
 // New creates a mock provider with the given set of headers and validator
 // sets.
@@ -102,6 +106,29 @@ func (p *Mock) LightBlock(ctx context.Context, height int64) (*types.LightBlock,
 	return lb, nil
 }
 
+// ValidatorSetChanges compares the hashes of the validator sets of the
+// consecutive heights within [from, to].
+func (p *Mock) ValidatorSetChanges(_ context.Context, from, to int64) ([]int64, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if to > p.latestHeight {
+		return nil, provider.ErrHeightTooHigh
+	}
+
+	heights := make([]int64, 0)
+	for h := max(from, 2); h <= to; h++ {
+		prev, cur := p.vals[h-1], p.vals[h]
+		if prev == nil || cur == nil {
+			return nil, provider.ErrLightBlockNotFound
+		}
+		if !bytes.Equal(prev.Hash(), cur.Hash()) {
+			heights = append(heights, h)
+		}
+	}
+	return heights, nil
+}
+
 func (p *Mock) ReportEvidence(_ context.Context, ev types.Evidence) error {
 	p.evidenceToReport[string(ev.Hash())] = ev
 	return nil
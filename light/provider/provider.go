@@ -27,3 +27,15 @@ type Provider interface {
 	// ReportEvidence reports an evidence of misbehavior.
 	ReportEvidence(ctx context.Context, ev types.Evidence) error
 }
+
+// ValidatorSetChangesProvider is an optional capability of a Provider, which
+// tells at which heights the validator set changed. The light client only uses
+// these heights as hints to pick the light blocks to verify, so they need not
+// be trusted.
+type ValidatorSetChangesProvider interface {
+	// ValidatorSetChanges returns, in ascending order, the heights h within
+	// [from, to] whose validator set differs from the one of h-1.
+	//
+	// If to is higher than the latest height, ErrHeightTooHigh is returned.
+	ValidatorSetChanges(ctx context.Context, from, to int64) ([]int64, error)
+}
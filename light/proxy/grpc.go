@@ -0,0 +1,167 @@
+package proxy
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abciquerysvc "github.com/cometbft/cometbft/api/cometbft/services/abci_query/v1"
+	blocksvc "github.com/cometbft/cometbft/api/cometbft/services/block/v1"
+	brs "github.com/cometbft/cometbft/api/cometbft/services/block_results/v1"
+	"github.com/cometbft/cometbft/libs/log"
+	lrpc "github.com/cometbft/cometbft/light/rpc"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// latestHeightInterval is the interval at which the light client is updated
+// to stream the latest verified heights.
+const latestHeightInterval = time.Second
+
+// NewGRPCServer returns a gRPC server serving the block, block results and
+// ABCI query services, whose responses are verified by the light client as the
+// ones of the JSON-RPC proxy. The proofs of the ABCI queries are always
+// requested and verified.
+func (p *Proxy) NewGRPCServer(opts ...grpc.ServerOption) *grpc.Server {
+	server := grpc.NewServer(opts...)
+	logger := p.Logger.With("protocol", "grpc")
+	blocksvc.RegisterBlockServiceServer(server, &blockService{client: p.Client, lc: p.lc, logger: logger})
+	brs.RegisterBlockResultsServiceServer(server, &blockResultsService{client: p.Client})
+	abciquerysvc.RegisterABCIQueryServiceServer(server, &abciQueryService{client: p.Client})
+	return server
+}
+
+// verificationError returns the gRPC error of a request that could not be
+// served or verified.
+func verificationError(what string, err error) error {
+	return status.Errorf(codes.Unavailable, "Failed to fetch verified %s: %v", what, err)
+}
+
+type blockService struct {
+	client *lrpc.Client
+	lc     lrpc.LightClient
+	logger log.Logger
+}
+
+// GetByHeight implements v1.BlockServiceServer GetByHeight method.
+func (s *blockService) GetByHeight(ctx context.Context, req *blocksvc.GetByHeightRequest) (*blocksvc.GetByHeightResponse, error) {
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Height cannot be zero or negative")
+	}
+	res, err := s.client.Block(ctx, &req.Height)
+	if err != nil {
+		return nil, verificationError("block", err)
+	}
+	block, err := res.Block.ToProto()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to convert block: %v", err)
+	}
+	blockID := res.BlockID.ToProto()
+	return &blocksvc.GetByHeightResponse{
+		BlockId: &blockID,
+		Block:   block,
+	}, nil
+}
+
+// GetSignedHeader implements v1.BlockServiceServer GetSignedHeader method.
+func (s *blockService) GetSignedHeader(ctx context.Context, req *blocksvc.GetSignedHeaderRequest) (*blocksvc.GetSignedHeaderResponse, error) {
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Height cannot be zero or negative")
+	}
+	res, err := s.client.Commit(ctx, &req.Height)
+	if err != nil {
+		return nil, verificationError("signed header", err)
+	}
+	return &blocksvc.GetSignedHeaderResponse{
+		SignedHeader: res.SignedHeader.ToProto(),
+	}, nil
+}
+
+// GetLatestHeight implements v1.BlockServiceServer GetLatestHeight method,
+// streaming the latest height verified by the light client.
+func (s *blockService) GetLatestHeight(_ *blocksvc.GetLatestHeightRequest, stream blocksvc.BlockService_GetLatestHeightServer) error {
+	logger := s.logger.With("endpoint", "GetLatestHeight")
+	ctx := stream.Context()
+	ticker := time.NewTicker(latestHeightInterval)
+	defer ticker.Stop()
+
+	var lastHeight int64
+	for {
+		// Update returns nil if the light client is already up to date.
+		if _, err := s.lc.Update(ctx, cmttime.Now()); err != nil {
+			logger.Error("Failed to update light client", "err", err)
+		} else if lb, err := s.lc.TrustedLightBlock(0); err == nil && lb.Height > lastHeight {
+			if err := stream.Send(&blocksvc.GetLatestHeightResponse{Height: lb.Height}); err != nil {
+				logger.Error("Failed to stream latest height", "err", err, "height", lb.Height)
+				return status.Error(codes.Unavailable, "Cannot send stream response")
+			}
+			lastHeight = lb.Height
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.Error(codes.Canceled, "Stream canceled")
+		case <-ticker.C:
+		}
+	}
+}
+
+type blockResultsService struct {
+	client *lrpc.Client
+}
+
+// GetBlockResults implements v1.BlockResultsServiceServer GetBlockResults
+// method. If no height is provided, the block results of the block preceding
+// the latest one are returned, as the results of the latest block cannot be
+// verified yet. Only the transaction results are verified.
+func (s *blockResultsService) GetBlockResults(ctx context.Context, req *brs.GetBlockResultsRequest) (*brs.GetBlockResultsResponse, error) {
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Height cannot be negative")
+	}
+	var height *int64
+	if req.Height > 0 {
+		height = &req.Height
+	}
+	res, err := s.client.BlockResults(ctx, height)
+	if err != nil {
+		return nil, verificationError("block results", err)
+	}
+
+	resp := &brs.GetBlockResultsResponse{
+		Height:                res.Height,
+		TxResults:             res.TxResults,
+		ConsensusParamUpdates: res.ConsensusParamUpdates,
+		AppHash:               res.AppHash,
+	}
+	for i := range res.FinalizeBlockEvents {
+		resp.FinalizeBlockEvents = append(resp.FinalizeBlockEvents, &res.FinalizeBlockEvents[i])
+	}
+	for i := range res.ValidatorUpdates {
+		resp.ValidatorUpdates = append(resp.ValidatorUpdates, &res.ValidatorUpdates[i])
+	}
+	return resp, nil
+}
+
+type abciQueryService struct {
+	client *lrpc.Client
+}
+
+// Query implements v1.ABCIQueryServiceServer Query method. The proof is always
+// requested, and the value, or its absence, verified against the app hash of
+// the trusted header.
+func (s *abciQueryService) Query(ctx context.Context, req *abciquerysvc.QueryRequest) (*abciquerysvc.QueryResponse, error) {
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Height cannot be negative")
+	}
+	res, err := s.client.ABCIQueryWithOptions(ctx, req.Path, req.Data, rpcclient.ABCIQueryOptions{
+		Height: req.Height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, verificationError("query response", err)
+	}
+	return &abciquerysvc.QueryResponse{Response: &res.Response}, nil
+}
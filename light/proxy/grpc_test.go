@@ -0,0 +1,120 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	abciquerysvc "github.com/cometbft/cometbft/api/cometbft/services/abci_query/v1"
+	blocksvc "github.com/cometbft/cometbft/api/cometbft/services/block/v1"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/internal/test"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/libs/log"
+	lrpc "github.com/cometbft/cometbft/light/rpc"
+	lcmock "github.com/cometbft/cometbft/light/rpc/mocks"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpcmock "github.com/cometbft/cometbft/rpc/client/mocks"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// valueProof returns the proof of key and value in a tree of a single leaf,
+// and its root hash.
+func valueProof(key, value []byte) (*cmtcrypto.ProofOps, []byte) {
+	vhash := sha256.Sum256(value)
+	leaf := binary.AppendUvarint(nil, uint64(len(key)))
+	leaf = append(leaf, key...)
+	leaf = binary.AppendUvarint(leaf, uint64(len(vhash)))
+	leaf = append(leaf, vhash[:]...)
+	root, proofs := merkle.ProofsFromByteSlices([][]byte{leaf})
+	op := merkle.NewValueOp(key, proofs[0]).ProofOp()
+	return &cmtcrypto.ProofOps{Ops: []cmtcrypto.ProofOp{op}}, root
+}
+
+func TestGRPCProxy_ABCIQuery(t *testing.T) {
+	key, value := []byte("key"), []byte("value")
+	proofOps, appHash := valueProof(key, value)
+
+	next := &rpcmock.Client{}
+	lc := &lcmock.LightClient{}
+	client := lrpc.NewClient(next, lc, lrpc.KeyPathFn(func(_ string, key []byte) (merkle.KeyPath, error) {
+		return merkle.KeyPath{}.AppendKey(key, merkle.KeyEncodingURL), nil
+	}))
+	service := &abciQueryService{client: client}
+
+	// The proof is requested even if not asked for.
+	next.On("ABCIQueryWithOptions", mock.Anything, "/key", cmtbytes.HexBytes(key),
+		rpcclient.ABCIQueryOptions{Height: 0, Prove: true}).Return(&ctypes.ResultABCIQuery{
+		Response: abci.QueryResponse{Key: key, Value: value, ProofOps: proofOps, Height: 5},
+	}, nil)
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(6), mock.Anything).Return(&types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: &types.Header{AppHash: appHash}},
+	}, nil)
+
+	res, err := service.Query(context.Background(), &abciquerysvc.QueryRequest{Path: "/key", Data: key})
+	require.NoError(t, err)
+	assert.Equal(t, value, res.Response.Value)
+
+	// A value not matching the proof is rejected.
+	next.On("ABCIQueryWithOptions", mock.Anything, "/key", cmtbytes.HexBytes(key),
+		rpcclient.ABCIQueryOptions{Height: 4, Prove: true}).Return(&ctypes.ResultABCIQuery{
+		Response: abci.QueryResponse{Key: key, Value: []byte("other"), ProofOps: proofOps, Height: 5},
+	}, nil)
+	_, err = service.Query(context.Background(), &abciquerysvc.QueryRequest{Path: "/key", Data: key, Height: 4})
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestGRPCProxy_GetBlockByHeight(t *testing.T) {
+	block := types.MakeBlock(1, []types.Tx{types.Tx("tx")}, &types.Commit{}, nil)
+	header := test.MakeHeader(t, &types.Header{
+		Height:         1,
+		Time:           cmttime.Now(),
+		DataHash:       block.DataHash,
+		LastCommitHash: block.LastCommitHash,
+		EvidenceHash:   block.EvidenceHash,
+		LastBlockID:    types.BlockID{},
+	})
+	block.Header = *header
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: types.PartSetHeader{Total: 1, Hash: test.RandomHash()}}
+
+	next := &rpcmock.Client{}
+	lc := &lcmock.LightClient{}
+	service := &blockService{client: lrpc.NewClient(next, lc), lc: lc, logger: log.NewNopLogger()}
+
+	height := int64(1)
+	next.On("Block", mock.Anything, &height).Return(&ctypes.ResultBlock{BlockID: blockID, Block: block}, nil)
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(1), mock.Anything).Return(&types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: header},
+	}, nil).Once()
+
+	res, err := service.GetByHeight(context.Background(), &blocksvc.GetByHeightRequest{Height: 1})
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(block.Hash(), res.BlockId.Hash))
+	assert.Equal(t, int64(1), res.Block.Header.Height)
+
+	// A block not matching the trusted header is rejected.
+	otherHeader := *header
+	otherHeader.AppHash = test.RandomHash()
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(1), mock.Anything).Return(&types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: &otherHeader},
+	}, nil).Once()
+	_, err = service.GetByHeight(context.Background(), &blocksvc.GetByHeightRequest{Height: 1})
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = service.GetByHeight(context.Background(), &blocksvc.GetByHeightRequest{Height: 0})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -20,6 +20,8 @@ type Proxy struct {
 	Client   *lrpc.Client
 	Logger   log.Logger
 	Listener net.Listener
+
+	lc lrpc.LightClient
 }
 
 // NewProxy creates the struct used to run an HTTP server for serving light
@@ -41,6 +43,7 @@ func NewProxy(
 		Config: config,
 		Client: lrpc.NewClient(rpcClient, lightClient, opts...),
 		Logger: logger,
+		lc:     lightClient,
 	}, nil
 }
 
@@ -49,7 +52,7 @@ func NewProxy(
 // address p.Addr.
 // See http#Server#ListenAndServe.
 func (p *Proxy) ListenAndServe() error {
-	listener, mux, err := p.listen()
+	listener, handler, err := p.listen()
 	if err != nil {
 		return err
 	}
@@ -57,7 +60,7 @@ func (p *Proxy) ListenAndServe() error {
 
 	return rpcserver.Serve(
 		listener,
-		mux,
+		handler,
 		p.Logger,
 		p.Config,
 	)
@@ -67,7 +70,7 @@ func (p *Proxy) ListenAndServe() error {
 // HTTPS connections.
 // See http#Server#ListenAndServeTLS.
 func (p *Proxy) ListenAndServeTLS(certFile, keyFile string) error {
-	listener, mux, err := p.listen()
+	listener, handler, err := p.listen()
 	if err != nil {
 		return err
 	}
@@ -75,7 +78,7 @@ func (p *Proxy) ListenAndServeTLS(certFile, keyFile string) error {
 
 	return rpcserver.ServeTLS(
 		listener,
-		mux,
+		handler,
 		certFile,
 		keyFile,
 		p.Logger,
@@ -83,7 +86,10 @@ func (p *Proxy) ListenAndServeTLS(certFile, keyFile string) error {
 	)
 }
 
-func (p *Proxy) listen() (net.Listener, *http.ServeMux, error) {
+// Handler starts the client and returns a handler serving the RPC routes and
+// the websocket endpoint of the proxy, so that it can be mounted on a server
+// shared with other handlers, e.g. the proxies of other chains.
+func (p *Proxy) Handler() (http.Handler, error) {
 	mux := http.NewServeMux()
 
 	// 1) Register regular routes.
@@ -108,15 +114,24 @@ func (p *Proxy) listen() (net.Listener, *http.ServeMux, error) {
 	// 3) Start a client.
 	if !p.Client.IsRunning() {
 		if err := p.Client.Start(); err != nil {
-			return nil, mux, ErrStartHTTPClient{Err: err}
+			return mux, ErrStartHTTPClient{Err: err}
 		}
 	}
 
-	// 4) Start listening for new connections.
+	return mux, nil
+}
+
+func (p *Proxy) listen() (net.Listener, http.Handler, error) {
+	handler, err := p.Handler()
+	if err != nil {
+		return nil, handler, err
+	}
+
+	// Start listening for new connections.
 	listener, err := rpcserver.Listen(p.Addr, p.Config.MaxOpenConnections)
 	if err != nil {
-		return nil, mux, err
+		return nil, handler, err
 	}
 
-	return listener, mux, nil
+	return listener, handler, nil
 }
@@ -0,0 +1,51 @@
+package proxy
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+	lrpc "github.com/cometbft/cometbft/light/rpc"
+	lcmock "github.com/cometbft/cometbft/light/rpc/mocks"
+	rpcmock "github.com/cometbft/cometbft/rpc/client/mocks"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpcserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
+)
+
+func TestProxy_HandlerUnderPathPrefix(t *testing.T) {
+	next := &rpcmock.Client{}
+	next.On("IsRunning").Return(true)
+	next.On("Health", mock.Anything).Return(&ctypes.ResultHealth{}, nil)
+
+	p := &Proxy{
+		Config: rpcserver.DefaultConfig(),
+		Client: lrpc.NewClient(next, &lcmock.LightClient{}),
+		Logger: log.NewNopLogger(),
+	}
+	handler, err := p.Handler()
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.Handle("/chain-a/", http.StripPrefix("/chain-a", handler))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL + "/chain-a/health")
+	require.NoError(t, err)
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Contains(t, string(body), `"result":{}`)
+
+	res, err = http.Get(srv.URL + "/chain-b/health")
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
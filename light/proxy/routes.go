@@ -32,6 +32,7 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"commit":               rpcserver.NewRPCFunc(makeCommitFunc(c), "height", rpcserver.Cacheable("height")),
 		"tx":                   rpcserver.NewRPCFunc(makeTxFunc(c), "hash,prove", rpcserver.Cacheable()),
 		"tx_search":            rpcserver.NewRPCFunc(makeTxSearchFunc(c), "query,prove,page,per_page,order_by"),
+		"account_txs":          rpcserver.NewRPCFunc(makeAccountTxsFunc(c), "account,prove,page,per_page,order_by"),
 		"block_search":         rpcserver.NewRPCFunc(makeBlockSearchFunc(c), "query,page,per_page,order_by"),
 		"validators":           rpcserver.NewRPCFunc(makeValidatorsFunc(c), "height,page,per_page", rpcserver.Cacheable("height")),
 		"dump_consensus_state": rpcserver.NewRPCFunc(makeDumpConsensusStateFunc(c), ""),
@@ -52,6 +53,9 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 
 		// evidence API
 		"broadcast_evidence": rpcserver.NewRPCFunc(makeBroadcastEvidenceFunc(c), "evidence"),
+
+		// light client API
+		"divergence_reports": rpcserver.NewRPCFunc(makeDivergenceReportsFunc(c), ""),
 	}
 }
 
@@ -179,6 +183,26 @@ func makeTxSearchFunc(c *lrpc.Client) rpcTxSearchFunc {
 	}
 }
 
+type rpcAccountTxsFunc func(
+	ctx *rpctypes.Context,
+	account string,
+	prove bool,
+	page, perPage *int,
+	orderBy string,
+) (*ctypes.ResultTxSearch, error)
+
+func makeAccountTxsFunc(c *lrpc.Client) rpcAccountTxsFunc {
+	return func(
+		ctx *rpctypes.Context,
+		account string,
+		prove bool,
+		page, perPage *int,
+		orderBy string,
+	) (*ctypes.ResultTxSearch, error) {
+		return c.AccountTxs(ctx.Context(), account, prove, page, perPage, orderBy)
+	}
+}
+
 type rpcBlockSearchFunc func(
 	ctx *rpctypes.Context,
 	query string,
@@ -309,3 +333,11 @@ func makeBroadcastEvidenceFunc(c *lrpc.Client) rpcBroadcastEvidenceFunc {
 		return c.BroadcastEvidence(ctx.Context(), ev)
 	}
 }
+
+type rpcDivergenceReportsFunc func(ctx *rpctypes.Context) (*lrpc.ResultDivergenceReports, error)
+
+func makeDivergenceReportsFunc(c *lrpc.Client) rpcDivergenceReportsFunc {
+	return func(_ *rpctypes.Context) (*lrpc.ResultDivergenceReports, error) {
+		return c.DivergenceReports()
+	}
+}
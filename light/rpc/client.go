@@ -11,6 +11,7 @@ import (
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/light/store"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
@@ -260,6 +261,33 @@ func (c *Client) Health(ctx context.Context) (*ctypes.ResultHealth, error) {
 	return c.next.Health(ctx)
 }
 
+// ResultDivergenceReports is the result of DivergenceReports.
+type ResultDivergenceReports struct {
+	Reports []*store.DivergenceReport `json:"reports"`
+}
+
+// DivergenceReports returns the divergence reports saved by the light client,
+// or ErrDivergenceReportsNotSupported if it does not persist them.
+func (c *Client) DivergenceReports() (*ResultDivergenceReports, error) {
+	dc, ok := c.lc.(interface {
+		DivergenceReports() ([]*store.DivergenceReport, error)
+	})
+	if !ok {
+		return nil, ErrDivergenceReportsNotSupported
+	}
+	reports, err := dc.DivergenceReports()
+	if err != nil {
+		return nil, err
+	}
+	return &ResultDivergenceReports{Reports: reports}, nil
+}
+
+// ValidatorLiveness calls rpcclient#ValidatorLiveness. The result is not
+// verified.
+func (c *Client) ValidatorLiveness(ctx context.Context, height *int64, window *int) (*ctypes.ResultValidatorLiveness, error) {
+	return c.next.ValidatorLiveness(ctx, height, window)
+}
+
 // BlockchainInfo calls rpcclient#BlockchainInfo and then verifies every header
 // returned.
 func (c *Client) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error) {
@@ -497,6 +525,17 @@ func (c *Client) TxSearch(
 	return c.next.TxSearch(ctx, query, prove, page, perPage, orderBy)
 }
 
+// AccountTxs calls rpcclient#AccountTxs. The result is not verified.
+func (c *Client) AccountTxs(
+	ctx context.Context,
+	account string,
+	prove bool,
+	page, perPage *int,
+	orderBy string,
+) (*ctypes.ResultTxSearch, error) {
+	return c.next.AccountTxs(ctx, account, prove, page, perPage, orderBy)
+}
+
 func (c *Client) BlockSearch(
 	ctx context.Context,
 	query string,
@@ -6,12 +6,15 @@ import (
 	"regexp"
 
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/types"
 )
 
 var (
 	ErrNegOrZeroHeight = errors.New("negative or zero height")
 	ErrNoProofOps      = errors.New("no proof ops")
 	ErrNilKeyPathFn    = errors.New("please configure Client with KeyPathFn option")
+
+	ErrDivergenceReportsNotSupported = errors.New("the light client does not persist divergence reports")
 )
 
 type ErrMissingStoreName struct {
@@ -102,6 +105,15 @@ func (e ErrBlockIDMismatch) Error() string {
 	return fmt.Sprintf("blockID %X does not match with block %X", e.BlockID, e.Block)
 }
 
+type ErrPartSetHeaderMismatch struct {
+	BlockID types.PartSetHeader
+	Block   types.PartSetHeader
+}
+
+func (e ErrPartSetHeaderMismatch) Error() string {
+	return fmt.Sprintf("part set header %v of the blockID does not match with block %v", e.BlockID, e.Block)
+}
+
 type ErrBuildMerkleKeyPath struct {
 	Err error
 }
@@ -0,0 +1,306 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/light"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+var (
+	// resubscribeInterval is the time waited before subscribing again, once
+	// the subscription was closed or failed.
+	resubscribeInterval = time.Second
+	// verifyRetryInterval is the time first waited before verifying a header
+	// again, e.g. if the primary of the light client did not have its commit
+	// yet. It doubles after each failed attempt, up to maxVerifyRetryInterval.
+	verifyRetryInterval = 500 * time.Millisecond
+	// maxVerifyRetryInterval is the longest time waited between two attempts
+	// to verify a header.
+	maxVerifyRetryInterval = 30 * time.Second
+)
+
+// VerifiedBlockEvent is a header or block delivered by SubscribeVerifiedHeaders
+// or SubscribeVerifiedBlocks, once verified by the light client.
+type VerifiedBlockEvent struct {
+	Header *types.Header
+	// Block and BlockID are only set by SubscribeVerifiedBlocks.
+	Block   *types.Block
+	BlockID types.BlockID
+	// Backfilled is true if the height was missing from the event stream, e.g.
+	// while reconnecting, and was fetched afterwards.
+	Backfilled bool
+	// Error is set on the last event sent before closing the channel, if the
+	// stream stopped for another reason than the context being done.
+	Error error
+}
+
+// SubscribeVerifiedHeaders subscribes to the NewBlockHeader events of the full
+// node, and delivers their headers in height order, once verified by the light
+// client. Heights missing from the events, e.g. after a reconnection, are
+// verified and delivered as well, and the subscription is renewed if it is
+// closed by the node. If fromHeight is positive, the headers from this height
+// are delivered first.
+//
+// The channel is closed once ctx is done or a header can't be verified, in
+// which case the last event holds the error.
+func (c *Client) SubscribeVerifiedHeaders(ctx context.Context, subscriber string,
+	fromHeight int64,
+) (<-chan VerifiedBlockEvent, error) {
+	return c.subscribeVerified(ctx, subscriber, types.EventQueryNewBlockHeader.String(), false, fromHeight)
+}
+
+// SubscribeVerifiedBlocks is as SubscribeVerifiedHeaders, for the NewBlock
+// events. The blocks delivered are checked against the verified headers.
+func (c *Client) SubscribeVerifiedBlocks(ctx context.Context, subscriber string,
+	fromHeight int64,
+) (<-chan VerifiedBlockEvent, error) {
+	return c.subscribeVerified(ctx, subscriber, types.EventQueryNewBlock.String(), true, fromHeight)
+}
+
+func (c *Client) subscribeVerified(ctx context.Context, subscriber, query string,
+	withBlocks bool, fromHeight int64,
+) (<-chan VerifiedBlockEvent, error) {
+	events, err := c.next.Subscribe(ctx, subscriber, query)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &verifiedSubscription{
+		client:     c,
+		subscriber: subscriber,
+		query:      query,
+		withBlocks: withBlocks,
+		out:        make(chan VerifiedBlockEvent),
+	}
+	if fromHeight > 0 {
+		s.lastHeight = fromHeight - 1
+		s.started = true
+	}
+	go s.run(ctx, events)
+	return s.out, nil
+}
+
+type verifiedSubscription struct {
+	client     *Client
+	subscriber string
+	query      string
+	withBlocks bool
+
+	// lastHeight is the height of the last event delivered, or the one before
+	// the start height. Once started, the heights following it are backfilled.
+	lastHeight int64
+	started    bool
+	out        chan VerifiedBlockEvent
+}
+
+func (s *verifiedSubscription) run(ctx context.Context, events <-chan ctypes.ResultEvent) {
+	defer close(s.out)
+	defer func() {
+		if err := s.client.next.Unsubscribe(context.Background(), s.subscriber, s.query); err != nil {
+			s.client.Logger.Debug("Failed to unsubscribe", "query", s.query, "err", err)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-s.client.Quit():
+			return
+
+		case resultEvent, ok := <-events:
+			if !ok {
+				s.client.Logger.Info("Subscription closed, subscribing again", "query", s.query)
+				events = s.resubscribe(ctx)
+				if events == nil {
+					return
+				}
+				continue
+			}
+
+			ev, err := s.verifyEvent(ctx, resultEvent.Data)
+			if err != nil {
+				s.fail(ctx, err)
+				return
+			}
+			if ev == nil || ev.Header.Height <= s.lastHeight {
+				continue
+			}
+
+			// Backfill the heights missed since the last event.
+			if s.started {
+				for h := s.lastHeight + 1; h < ev.Header.Height; h++ {
+					missed, err := s.fetch(ctx, h)
+					if err != nil {
+						s.fail(ctx, err)
+						return
+					}
+					if !s.send(ctx, *missed) {
+						return
+					}
+				}
+			}
+			if !s.send(ctx, *ev) {
+				return
+			}
+		}
+	}
+}
+
+// resubscribe subscribes again until it succeeds, or returns nil once ctx is
+// done.
+func (s *verifiedSubscription) resubscribe(ctx context.Context) <-chan ctypes.ResultEvent {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.client.Quit():
+			return nil
+		case <-time.After(resubscribeInterval):
+		}
+
+		// The previous subscription may still be registered by the node.
+		_ = s.client.next.Unsubscribe(ctx, s.subscriber, s.query)
+		events, err := s.client.next.Subscribe(ctx, s.subscriber, s.query)
+		if err == nil {
+			return events
+		}
+		s.client.Logger.Error("Failed to subscribe again", "query", s.query, "err", err)
+	}
+}
+
+// verifyEvent verifies the header or block of an event against the light
+// client. It returns nil for the events of other types.
+func (s *verifiedSubscription) verifyEvent(ctx context.Context, data types.TMEventData) (*VerifiedBlockEvent, error) {
+	var ev *VerifiedBlockEvent
+	switch data := data.(type) {
+	case types.EventDataNewBlockHeader:
+		ev = &VerifiedBlockEvent{Header: &data.Header}
+	case types.EventDataNewBlock:
+		if data.Block == nil {
+			return nil, nil
+		}
+		if err := data.Block.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		if err := verifyBlockID(data.BlockID, data.Block); err != nil {
+			return nil, err
+		}
+		ev = &VerifiedBlockEvent{Header: &data.Block.Header, Block: data.Block, BlockID: data.BlockID}
+	default:
+		return nil, nil
+	}
+	if ev.Header.Height <= s.lastHeight {
+		return ev, nil
+	}
+
+	lb, err := s.verifyHeight(ctx, ev.Header.Height)
+	if err != nil {
+		return nil, err
+	}
+	if hH, tH := ev.Header.Hash(), lb.Hash(); !bytes.Equal(hH, tH) {
+		return nil, ErrBlockHeaderMismatch{BlockHeader: hH, TrustedHeader: tH}
+	}
+	return ev, nil
+}
+
+// fetch returns the verified header, or block, at height.
+func (s *verifiedSubscription) fetch(ctx context.Context, height int64) (*VerifiedBlockEvent, error) {
+	if s.withBlocks {
+		res, err := s.client.Block(ctx, &height)
+		if err != nil {
+			return nil, err
+		}
+		// Block only checks the hash of the BlockID.
+		if err := verifyBlockID(res.BlockID, res.Block); err != nil {
+			return nil, err
+		}
+		return &VerifiedBlockEvent{
+			Header:     &res.Block.Header,
+			Block:      res.Block,
+			BlockID:    res.BlockID,
+			Backfilled: true,
+		}, nil
+	}
+
+	lb, err := s.verifyHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	return &VerifiedBlockEvent{Header: lb.Header, Backfilled: true}, nil
+}
+
+// verifyBlockID checks that blockID, received along with block, is the ID of
+// the block, i.e. both its hash and its part set header.
+func verifyBlockID(blockID types.BlockID, block *types.Block) error {
+	if bmH, bH := blockID.Hash, block.Hash(); !bytes.Equal(bmH, bH) {
+		return ErrBlockIDMismatch{BlockID: bmH, Block: bH}
+	}
+	ps, err := block.MakePartSet(types.BlockPartSizeBytes)
+	if err != nil {
+		return err
+	}
+	if psh := ps.Header(); !blockID.PartSetHeader.Equals(psh) {
+		return ErrPartSetHeaderMismatch{BlockID: blockID.PartSetHeader, Block: psh}
+	}
+	return nil
+}
+
+// verifyHeight verifies the light block at height, retrying with a capped
+// backoff as the primary of the light client may lag behind the node sending
+// the events, or be unreachable for a while. It only gives up once ctx is
+// done, the client is stopped, or an attack was detected by the light client.
+func (s *verifiedSubscription) verifyHeight(ctx context.Context, height int64) (*types.LightBlock, error) {
+	interval := verifyRetryInterval
+	for attempt := 1; ; attempt++ {
+		lb, err := s.client.lc.VerifyLightBlockAtHeight(ctx, height, cmttime.Now())
+		if err == nil {
+			return lb, nil
+		}
+		if errors.Is(err, light.ErrLightClientAttack) {
+			return nil, ErrUpdateClient{Height: height, Err: err}
+		}
+		s.client.Logger.Debug("Failed to verify header", "height", height, "attempt", attempt, "err", err)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-s.client.Quit():
+			return nil, ErrUpdateClient{Height: height, Err: err}
+		case <-time.After(interval):
+		}
+		if interval *= 2; interval > maxVerifyRetryInterval {
+			interval = maxVerifyRetryInterval
+		}
+	}
+}
+
+func (s *verifiedSubscription) send(ctx context.Context, ev VerifiedBlockEvent) bool {
+	select {
+	case s.out <- ev:
+		s.lastHeight = ev.Header.Height
+		s.started = true
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (s *verifiedSubscription) fail(ctx context.Context, err error) {
+	if ctx.Err() != nil {
+		return
+	}
+	s.client.Logger.Error("Stopping the verified subscription", "query", s.query, "err", err)
+	select {
+	case s.out <- VerifiedBlockEvent{Error: fmt.Errorf("verified subscription to %s: %w", s.query, err)}:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,166 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	lcmock "github.com/cometbft/cometbft/light/rpc/mocks"
+	rpcmock "github.com/cometbft/cometbft/rpc/client/mocks"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestSubscribeVerifiedHeaders(t *testing.T) {
+	resubscribeInterval = time.Millisecond
+
+	headers := make(map[int64]*types.Header)
+	for h := int64(1); h <= 7; h++ {
+		headers[h] = &types.Header{ChainID: "test-chain", Height: h}
+	}
+
+	next := &rpcmock.Client{}
+	lc := &lcmock.LightClient{}
+	for h, header := range headers {
+		lc.On("VerifyLightBlockAtHeight", mock.Anything, h, mock.Anything).Return(&types.LightBlock{
+			SignedHeader: &types.SignedHeader{Header: header},
+		}, nil)
+	}
+
+	query := types.EventQueryNewBlockHeader.String()
+	first, second := make(chan ctypes.ResultEvent, 10), make(chan ctypes.ResultEvent, 10)
+	next.On("Subscribe", mock.Anything, "test", query).Return((<-chan ctypes.ResultEvent)(first), nil).Once()
+	next.On("Subscribe", mock.Anything, "test", query).Return((<-chan ctypes.ResultEvent)(second), nil).Once()
+	next.On("Unsubscribe", mock.Anything, "test", query).Return(nil)
+
+	newBlockHeader := func(h int64) ctypes.ResultEvent {
+		return ctypes.ResultEvent{Data: types.EventDataNewBlockHeader{Header: *headers[h]}}
+	}
+	// Height 3 is skipped, and 2 is received twice.
+	first <- newBlockHeader(2)
+	first <- newBlockHeader(2)
+	first <- newBlockHeader(4)
+	close(first)
+	// Heights 5 and 6 are missed while subscribing again.
+	second <- newBlockHeader(7)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	c := NewClient(next, lc)
+	events, err := c.SubscribeVerifiedHeaders(ctx, "test", 1)
+	require.NoError(t, err)
+
+	for _, expected := range []struct {
+		height     int64
+		backfilled bool
+	}{{1, true}, {2, false}, {3, true}, {4, false}, {5, true}, {6, true}, {7, false}} {
+		ev := <-events
+		require.NoError(t, ev.Error)
+		assert.Equal(t, expected.height, ev.Header.Height)
+		assert.Equal(t, expected.backfilled, ev.Backfilled, "height %d", expected.height)
+	}
+
+	cancel()
+	_, ok := <-events
+	assert.False(t, ok)
+}
+
+func TestSubscribeVerifiedHeaders_Mismatch(t *testing.T) {
+	next := &rpcmock.Client{}
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(2), mock.Anything).Return(&types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: &types.Header{
+			ChainID: "test-chain", Height: 2, ValidatorsHash: []byte("vals"),
+		}},
+	}, nil)
+
+	query := types.EventQueryNewBlockHeader.String()
+	events := make(chan ctypes.ResultEvent, 1)
+	next.On("Subscribe", mock.Anything, "test", query).Return((<-chan ctypes.ResultEvent)(events), nil)
+	next.On("Unsubscribe", mock.Anything, "test", query).Return(nil)
+
+	// The event of height 2 does not match the verified header.
+	events <- ctypes.ResultEvent{Data: types.EventDataNewBlockHeader{
+		Header: types.Header{ChainID: "other-chain", Height: 2, ValidatorsHash: []byte("vals")},
+	}}
+
+	c := NewClient(next, lc)
+	verified, err := c.SubscribeVerifiedHeaders(context.Background(), "test", 0)
+	require.NoError(t, err)
+
+	ev := <-verified
+	require.ErrorAs(t, ev.Error, &ErrBlockHeaderMismatch{})
+	_, ok := <-verified
+	assert.False(t, ok)
+}
+
+func TestSubscribeVerifiedHeaders_Retry(t *testing.T) {
+	verifyRetryInterval, maxVerifyRetryInterval = time.Millisecond, 4*time.Millisecond
+
+	header := &types.Header{ChainID: "test-chain", Height: 2}
+	next := &rpcmock.Client{}
+	lc := &lcmock.LightClient{}
+	// The primary of the light client fails more often than the former limit
+	// of attempts.
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(2), mock.Anything).
+		Return(nil, errors.New("no commit yet")).Times(10)
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(2), mock.Anything).Return(&types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: header},
+	}, nil)
+
+	query := types.EventQueryNewBlockHeader.String()
+	events := make(chan ctypes.ResultEvent, 1)
+	next.On("Subscribe", mock.Anything, "test", query).Return((<-chan ctypes.ResultEvent)(events), nil)
+	next.On("Unsubscribe", mock.Anything, "test", query).Return(nil)
+	events <- ctypes.ResultEvent{Data: types.EventDataNewBlockHeader{Header: *header}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	c := NewClient(next, lc)
+	verified, err := c.SubscribeVerifiedHeaders(ctx, "test", 0)
+	require.NoError(t, err)
+
+	ev := <-verified
+	require.NoError(t, ev.Error)
+	assert.Equal(t, int64(2), ev.Header.Height)
+	lc.AssertNumberOfCalls(t, "VerifyLightBlockAtHeight", 11)
+}
+
+func TestSubscribeVerifiedBlocks_PartSetHeaderMismatch(t *testing.T) {
+	block := types.MakeBlock(1, []types.Tx{types.Tx("tx")}, &types.Commit{}, nil)
+	block.ChainID = "test-chain"
+	block.ProposerAddress = make([]byte, 20)
+	ps, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+
+	next := &rpcmock.Client{}
+	lc := &lcmock.LightClient{}
+
+	query := types.EventQueryNewBlock.String()
+	events := make(chan ctypes.ResultEvent, 1)
+	next.On("Subscribe", mock.Anything, "test", query).Return((<-chan ctypes.ResultEvent)(events), nil)
+	next.On("Unsubscribe", mock.Anything, "test", query).Return(nil)
+
+	// The hash of the BlockID matches the block, but not its part set header.
+	psh := ps.Header()
+	psh.Total++
+	events <- ctypes.ResultEvent{Data: types.EventDataNewBlock{
+		Block:   block,
+		BlockID: types.BlockID{Hash: block.Hash(), PartSetHeader: psh},
+	}}
+
+	c := NewClient(next, lc)
+	verified, err := c.SubscribeVerifiedBlocks(context.Background(), "test", 0)
+	require.NoError(t, err)
+
+	ev := <-verified
+	require.ErrorAs(t, ev.Error, &ErrPartSetHeaderMismatch{})
+	_, ok := <-verified
+	assert.False(t, ok)
+	lc.AssertNotCalled(t, "VerifyLightBlockAtHeight", mock.Anything, mock.Anything, mock.Anything)
+}
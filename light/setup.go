@@ -2,16 +2,19 @@ package light
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/cometbft/cometbft/light/provider"
+	"github.com/cometbft/cometbft/light/provider/grpc"
 	"github.com/cometbft/cometbft/light/provider/http"
 	"github.com/cometbft/cometbft/light/store"
 )
 
 // NewHTTPClient initiates an instance of a light client using HTTP addresses
 // for both the primary provider and witnesses of the light client. A trusted
-// header and hash must be passed to initialize the client.
+// header and hash must be passed to initialize the client. Addresses with the
+// grpc:// scheme are served by the gRPC API of the node instead.
 //
 // See all Option(s) for the additional configuration.
 // See NewClient.
@@ -70,7 +73,15 @@ func NewHTTPClientFromTrustedStore(
 func providersFromAddresses(addrs []string, chainID string) ([]provider.Provider, error) {
 	providers := make([]provider.Provider, len(addrs))
 	for idx, address := range addrs {
-		p, err := http.New(chainID, address)
+		var (
+			p   provider.Provider
+			err error
+		)
+		if strings.HasPrefix(address, grpc.Scheme+"://") {
+			p, err = grpc.New(chainID, address)
+		} else {
+			p, err = http.New(chainID, address)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -2,9 +2,11 @@ package db
 
 import (
 	"encoding/binary"
+	"fmt"
 
 	dbm "github.com/cometbft/cometbft-db"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/light/store"
 	"github.com/cometbft/cometbft/types"
@@ -21,6 +23,8 @@ type dbs struct {
 	dbKeyLayout LightStoreKeyLayout
 }
 
+var _ store.DivergenceStore = (*dbs)(nil)
+
 func isEmpty(db dbm.DB) bool {
 	iter, err := db.Iterator(nil, nil)
 	if err != nil {
@@ -64,7 +68,8 @@ func setDBKeyLayout(db dbm.DB, lightStore *dbs, dbKeyLayoutVersion string) {
 }
 
 // New returns a Store that wraps any DB (with an optional prefix in case you
-// want to use one DB with many light clients).
+// want to use one DB with many light clients). The returned Store also
+// implements store.DivergenceStore.
 func New(db dbm.DB, prefix string) store.Store {
 	return NewWithDBVersion(db, prefix, "")
 }
@@ -339,6 +344,93 @@ func (s *dbs) Size() uint16 {
 	return s.size
 }
 
+// SaveDivergenceReport assigns the next ID to the report and persists it to
+// the db.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *dbs) SaveDivergenceReport(r *store.DivergenceReport) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	count, err := s.divergenceReportCount()
+	if err != nil {
+		return err
+	}
+
+	r.ID = count + 1
+	bz, err := cmtjson.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("marshaling divergence report: %w", err)
+	}
+
+	b := s.db.NewBatch()
+	defer b.Close()
+	if err = b.Set(s.dbKeyLayout.DivergenceReportKey(r.ID, s.prefix), bz); err != nil {
+		return store.ErrStore{Err: err}
+	}
+	if err = b.Set(s.dbKeyLayout.DivergenceReportCountKey(s.prefix), marshalCount(r.ID)); err != nil {
+		return store.ErrStore{Err: err}
+	}
+	if err = b.WriteSync(); err != nil {
+		return store.ErrStore{Err: err}
+	}
+
+	return nil
+}
+
+// DivergenceReport retrieves the divergence report with the given ID.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *dbs) DivergenceReport(id uint64) (*store.DivergenceReport, error) {
+	bz, err := s.db.Get(s.dbKeyLayout.DivergenceReportKey(id, s.prefix))
+	if err != nil {
+		return nil, store.ErrStore{Err: err}
+	}
+	if len(bz) == 0 {
+		return nil, store.ErrDivergenceReportNotFound
+	}
+
+	r := new(store.DivergenceReport)
+	if err := cmtjson.Unmarshal(bz, r); err != nil {
+		return nil, store.ErrUnmarshal{Err: err}
+	}
+	return r, nil
+}
+
+// DivergenceReports retrieves all the divergence reports, in the order they
+// were saved.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *dbs) DivergenceReports() ([]*store.DivergenceReport, error) {
+	s.mtx.RLock()
+	count, err := s.divergenceReportCount()
+	s.mtx.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*store.DivergenceReport, 0, count)
+	for id := uint64(1); id <= count; id++ {
+		r, err := s.DivergenceReport(id)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, r)
+	}
+	return reports, nil
+}
+
+func (s *dbs) divergenceReportCount() (uint64, error) {
+	bz, err := s.db.Get(s.dbKeyLayout.DivergenceReportCountKey(s.prefix))
+	if err != nil {
+		return 0, store.ErrStore{Err: err}
+	}
+	if len(bz) == 0 {
+		return 0, nil
+	}
+	return unmarshalCount(bz), nil
+}
+
 func (s *dbs) lbKey(height int64) []byte {
 	return s.dbKeyLayout.LBKey(height, s.prefix)
 }
@@ -352,3 +444,13 @@ func marshalSize(size uint16) []byte {
 func unmarshalSize(bz []byte) uint16 {
 	return binary.LittleEndian.Uint16(bz)
 }
+
+func marshalCount(count uint64) []byte {
+	bs := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bs, count)
+	return bs
+}
+
+func unmarshalCount(bz []byte) uint64 {
+	return binary.LittleEndian.Uint64(bz)
+}
@@ -13,6 +13,8 @@ type LightStoreKeyLayout interface {
 	ParseLBKey(key []byte, storePrefix string) (height int64, err error)
 	LBKey(height int64, prefix string) []byte
 	SizeKey(prefix string) []byte
+	DivergenceReportKey(id uint64, prefix string) []byte
+	DivergenceReportCountKey(prefix string) []byte
 }
 
 type v1LegacyLayout struct{}
@@ -37,6 +39,16 @@ func (v1LegacyLayout) SizeKey(_ string) []byte {
 	return []byte("size")
 }
 
+// DivergenceReportKey implements LightStoreKeyLayout.
+func (v1LegacyLayout) DivergenceReportKey(id uint64, prefix string) []byte {
+	return []byte(fmt.Sprintf("div/%s/%020d", prefix, id))
+}
+
+// DivergenceReportCountKey implements LightStoreKeyLayout.
+func (v1LegacyLayout) DivergenceReportCountKey(prefix string) []byte {
+	return []byte(fmt.Sprintf("divcount/%s", prefix))
+}
+
 var _ LightStoreKeyLayout = v1LegacyLayout{}
 
 var keyPattern = regexp.MustCompile(`^(lb)/([^/]*)/([0-9]+)$`)
@@ -59,6 +71,9 @@ const (
 	// prefixes must be unique across all db's.
 	prefixLightBlock = int64(11)
 	prefixSize       = int64(12)
+
+	prefixDivergenceReport      = int64(13)
+	prefixDivergenceReportCount = int64(14)
 )
 
 type v2Layout struct{}
@@ -103,4 +118,22 @@ func (v2Layout) SizeKey(prefix string) []byte {
 	return key
 }
 
+// DivergenceReportKey implements LightStoreKeyLayout.
+func (v2Layout) DivergenceReportKey(id uint64, prefix string) []byte {
+	key, err := orderedcode.Append(nil, prefix, prefixDivergenceReport, id)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// DivergenceReportCountKey implements LightStoreKeyLayout.
+func (v2Layout) DivergenceReportCountKey(prefix string) []byte {
+	key, err := orderedcode.Append(nil, prefix, prefixDivergenceReportCount)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
 var _ LightStoreKeyLayout = v2Layout{}
@@ -13,6 +13,7 @@ import (
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmtrand "github.com/cometbft/cometbft/internal/rand"
+	"github.com/cometbft/cometbft/light/store"
 	"github.com/cometbft/cometbft/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
 	"github.com/cometbft/cometbft/version"
@@ -200,6 +201,62 @@ func Test_Prune(t *testing.T) {
 	assert.EqualValues(t, 7, dbStore.Size())
 }
 
+func Test_DivergenceReports(t *testing.T) {
+	for _, version := range []string{"v1", "v2"} {
+		t.Run(version, func(t *testing.T) {
+			dbStore := NewWithDBVersion(dbm.NewMemDB(), "Test_DivergenceReports", version)
+			divStore, ok := dbStore.(store.DivergenceStore)
+			require.True(t, ok)
+
+			// Empty store
+			reports, err := divStore.DivergenceReports()
+			require.NoError(t, err)
+			assert.Empty(t, reports)
+			_, err = divStore.DivergenceReport(1)
+			require.ErrorIs(t, err, store.ErrDivergenceReportNotFound)
+
+			for i := int64(1); i <= 2; i++ {
+				primaryBlock, witnessBlock := randLightBlock(i), randLightBlock(i)
+				err = divStore.SaveDivergenceReport(&store.DivergenceReport{
+					Time:         cmttime.Now(),
+					Height:       i,
+					Primary:      "primary",
+					Witness:      "witness",
+					PrimaryBlock: primaryBlock,
+					WitnessBlock: witnessBlock,
+					EvidenceAgainstPrimary: &types.LightClientAttackEvidence{
+						ConflictingBlock: primaryBlock,
+						CommonHeight:     i,
+						TotalVotingPower: 2,
+						Timestamp:        witnessBlock.Time,
+					},
+				})
+				require.NoError(t, err)
+			}
+
+			reports, err = divStore.DivergenceReports()
+			require.NoError(t, err)
+			require.Len(t, reports, 2)
+			for i, r := range reports {
+				assert.EqualValues(t, i+1, r.ID)
+				assert.EqualValues(t, i+1, r.Height)
+				assert.Equal(t, r.PrimaryBlock.Hash(), r.EvidenceAgainstPrimary.ConflictingBlock.Hash())
+				assert.Nil(t, r.EvidenceAgainstWitness)
+			}
+
+			r, err := divStore.DivergenceReport(2)
+			require.NoError(t, err)
+			assert.Equal(t, reports[1].WitnessBlock.Hash(), r.WitnessBlock.Hash())
+
+			// Reports are not mistaken for light blocks.
+			height, err := dbStore.LastLightBlockHeight()
+			require.NoError(t, err)
+			assert.EqualValues(t, -1, height)
+			assert.EqualValues(t, 0, dbStore.Size())
+		})
+	}
+}
+
 func Test_Concurrency(t *testing.T) {
 	dbStore := New(dbm.NewMemDB(), "Test_Prune")
 
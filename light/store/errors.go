@@ -9,6 +9,10 @@ import (
 // requested header.
 var ErrLightBlockNotFound = errors.New("light block not found")
 
+// ErrDivergenceReportNotFound is returned when a store does not have the
+// requested divergence report.
+var ErrDivergenceReportNotFound = errors.New("divergence report not found")
+
 type ErrMarshalBlock struct {
 	Err error
 }
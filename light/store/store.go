@@ -1,6 +1,10 @@
 package store
 
-import "github.com/cometbft/cometbft/types"
+import (
+	"time"
+
+	"github.com/cometbft/cometbft/types"
+)
 
 // Store is anything that can persistently store headers.
 type Store interface {
@@ -46,3 +50,41 @@ type Store interface {
 	// Size returns a number of currently existing header & validator set pairs.
 	Size() uint16
 }
+
+// DivergenceReport records a conflicting header detected by the light client:
+// the blocks returned by the primary and by the witness, and the evidence
+// computed against either of them.
+type DivergenceReport struct {
+	// ID is assigned by the store when the report is saved.
+	ID uint64 `json:"id"`
+	// Time at which the divergence was detected.
+	Time time.Time `json:"time"`
+	// Height of the conflicting headers.
+	Height  int64  `json:"height"`
+	Primary string `json:"primary"`
+	Witness string `json:"witness"`
+
+	PrimaryBlock *types.LightBlock `json:"primary_block"`
+	WitnessBlock *types.LightBlock `json:"witness_block"`
+
+	// EvidenceAgainstPrimary is sent to the witness.
+	EvidenceAgainstPrimary *types.LightClientAttackEvidence `json:"evidence_against_primary"`
+	// EvidenceAgainstWitness is sent to the primary. It is nil if the trace of
+	// the witness could not be verified against the primary.
+	EvidenceAgainstWitness *types.LightClientAttackEvidence `json:"evidence_against_witness,omitempty"`
+}
+
+// DivergenceStore is implemented by stores that can persist the divergence
+// reports of the light client.
+type DivergenceStore interface {
+	// SaveDivergenceReport assigns the next ID to r and persists it.
+	SaveDivergenceReport(r *DivergenceReport) error
+
+	// DivergenceReport returns the report with the given ID.
+	//
+	// If the report is not found, ErrDivergenceReportNotFound is returned.
+	DivergenceReport(id uint64) (*DivergenceReport, error)
+
+	// DivergenceReports returns all reports, in the order they were saved.
+	DivergenceReports() ([]*DivergenceReport, error)
+}
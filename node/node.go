@@ -84,6 +84,11 @@ type Node struct {
 	indexerService    *txindex.IndexerService
 	prometheusSrv     *http.Server
 	pprofSrv          *http.Server
+
+	// recompressQuit and recompressDone stop and wait for the background
+	// recompression of the stores, if running.
+	recompressQuit chan struct{}
+	recompressDone chan struct{}
 }
 
 type waitSyncP2PReactor interface {
@@ -164,8 +169,16 @@ func BootstrapState(ctx context.Context, config *cfg.Config, dbProvider cfg.DBPr
 		dbProvider = cfg.DefaultDBProvider
 	}
 	blockStoreDB, stateDB, err := initDBs(config, dbProvider)
+	if err != nil {
+		return err
+	}
 
-	blockStore := store.NewBlockStore(blockStoreDB, store.WithMetrics(store.NopMetrics()), store.WithCompaction(config.Storage.Compact, config.Storage.CompactionInterval), store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout))
+	blockStore, err := store.OpenBlockStore(blockStoreDB, store.WithStorageConfig(config.Storage))
+	if err != nil {
+		_ = blockStoreDB.Close()
+		_ = stateDB.Close()
+		return fmt.Errorf("failed to open the block store: %w", err)
+	}
 	logger.Info("Blockstore version", "version", blockStore.GetVersion())
 
 	defer func() {
@@ -176,10 +189,6 @@ func BootstrapState(ctx context.Context, config *cfg.Config, dbProvider cfg.DBPr
 		}
 	}()
 
-	if err != nil {
-		return err
-	}
-
 	if !blockStore.IsEmpty() {
 		return errors.New("blockstore not empty, trying to initialize non empty state")
 	}
@@ -290,6 +299,9 @@ func NewNode(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if err := checkHaltConfig(config.Consensus, state); err != nil {
+		return nil, err
+	}
 
 	csMetrics, p2pMetrics, memplMetrics, smMetrics, bstMetrics, abciMetrics, bsMetrics, ssMetrics := metricsProvider(genDoc.ChainID)
 	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
@@ -299,9 +311,17 @@ func NewNode(ctx context.Context,
 		CompactionInterval:   config.Storage.CompactionInterval,
 		Logger:               logger,
 		DBKeyLayout:          config.Storage.ExperimentalKeyLayout,
+		Compress:             config.Storage.CompressionEnabled(),
 	})
 
-	blockStore := store.NewBlockStore(blockStoreDB, store.WithMetrics(bstMetrics), store.WithCompaction(config.Storage.Compact, config.Storage.CompactionInterval), store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout), store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout))
+	blockStore, err := store.OpenBlockStore(blockStoreDB,
+		store.WithStorageConfig(config.Storage),
+		store.WithMetrics(bstMetrics),
+		store.WithLogger(logger.With("module", "store")),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the block store: %w", err)
+	}
 	logger.Info("Blockstore version", "version", blockStore.GetVersion())
 
 	// The key will be deleted if it existed.
@@ -445,6 +465,7 @@ func NewNode(ctx context.Context,
 		proxyApp.Snapshot(),
 		proxyApp.Query(),
 		ssMetrics,
+		statesync.WithStores(stateStore, blockStore),
 	)
 	stateSyncReactor.SetLogger(logger.With("module", "statesync"))
 
@@ -605,6 +626,10 @@ func (n *Node) OnStart() error {
 		return fmt.Errorf("failed to start background pruning routine: %w", err)
 	}
 
+	if n.config.Storage.CompressionEnabled() && n.config.Storage.Recompress {
+		n.startRecompression()
+	}
+
 	return nil
 }
 
@@ -618,6 +643,10 @@ func (n *Node) OnStop() {
 	if err := n.pruner.Stop(); err != nil {
 		n.Logger.Error("Error stopping the pruning service", "err", err)
 	}
+	if n.recompressQuit != nil {
+		close(n.recompressQuit)
+		<-n.recompressDone
+	}
 	if err := n.eventBus.Stop(); err != nil {
 		n.Logger.Error("Error closing eventBus", "err", err)
 	}
@@ -704,6 +733,7 @@ func (n *Node) ConfigureRPC() (*rpccore.Environment, error) {
 		BlockIndexer:     n.blockIndexer,
 		ConsensusReactor: n.consensusReactor,
 		MempoolReactor:   n.mempoolReactor,
+		StateSyncReactor: n.stateSyncReactor,
 		EventBus:         n.eventBus,
 		Mempool:          n.mempool,
 
@@ -825,6 +855,15 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		if n.config.GRPC.BlockResultsService.Enabled {
 			opts = append(opts, grpcserver.WithBlockResultsService(n.blockStore, n.stateStore, n.Logger))
 		}
+		if n.config.GRPC.ValidatorSetService.Enabled {
+			opts = append(opts, grpcserver.WithValidatorSetService(n.blockStore, n.stateStore, n.Logger))
+		}
+		if n.config.GRPC.ConsensusParamsService.Enabled {
+			opts = append(opts, grpcserver.WithConsensusParamsService(n.blockStore, n.stateStore, n.Logger))
+		}
+		if n.config.GRPC.ABCIQueryService.Enabled {
+			opts = append(opts, grpcserver.WithABCIQueryService(n.proxyApp.Query(), n.Logger))
+		}
 		go func() {
 			if err := grpcserver.Serve(listener, opts...); err != nil {
 				n.Logger.Error("Error starting gRPC server", "err", err)
@@ -844,6 +883,9 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		if n.config.GRPC.Privileged.PruningService.Enabled {
 			opts = append(opts, grpcprivserver.WithPruningService(n.pruner, n.Logger))
 		}
+		if n.config.GRPC.Privileged.HaltService.Enabled {
+			opts = append(opts, grpcprivserver.WithHaltService(n.consensusState, n.Logger))
+		}
 		go func() {
 			if err := grpcprivserver.Serve(listener, opts...); err != nil {
 				n.Logger.Error("Error starting privileged gRPC server", "err", err)
@@ -893,6 +935,45 @@ func (n *Node) startPprofServer() *http.Server {
 	return srv
 }
 
+// recompressionBatchSize is the number of heights recompressed between two
+// checks of whether the node is stopping.
+const recompressionBatchSize = 1000
+
+// startRecompression compresses, in the background, the block parts and ABCI
+// responses of the heights of the block store stored uncompressed.
+func (n *Node) startRecompression() {
+	n.recompressQuit = make(chan struct{})
+	n.recompressDone = make(chan struct{})
+	go func() {
+		defer close(n.recompressDone)
+		var parts, responses int64
+		base, height := n.blockStore.Base(), n.blockStore.Height()
+		n.Logger.Info("Recompressing the stores", "from", base, "to", height)
+		for from := base; from <= height; from += recompressionBatchSize {
+			select {
+			case <-n.recompressQuit:
+				n.Logger.Info("Recompression of the stores interrupted", "height", from)
+				return
+			default:
+			}
+			to := min(from+recompressionBatchSize-1, height)
+			p, err := n.blockStore.RecompressBlockParts(from, to)
+			parts += p
+			if err != nil {
+				n.Logger.Error("Failed to recompress the block parts", "err", err)
+				return
+			}
+			r, err := n.stateStore.RecompressFinalizeBlockResponses(from, to)
+			responses += r
+			if err != nil {
+				n.Logger.Error("Failed to recompress the ABCI responses", "err", err)
+				return
+			}
+		}
+		n.Logger.Info("Recompressed the stores", "block_parts", parts, "abci_responses", responses)
+	}()
+}
+
 // Switch returns the Node's Switch.
 func (n *Node) Switch() *p2p.Switch {
 	return n.sw
@@ -908,6 +989,12 @@ func (n *Node) ConsensusReactor() *cs.Reactor {
 	return n.consensusReactor
 }
 
+// ConsensusHalted returns a channel that is closed once consensus has stopped
+// at the halt height or time, after which the node can be safely stopped.
+func (n *Node) ConsensusHalted() <-chan struct{} {
+	return n.consensusState.Halted()
+}
+
 // MempoolReactor returns the Node's mempool reactor.
 func (n *Node) MempoolReactor() p2p.Reactor {
 	return n.mempoolReactor
@@ -998,6 +1085,7 @@ func makeNodeInfo(
 			mempl.MempoolChannel,
 			evidence.EvidenceChannel,
 			statesync.SnapshotChannel, statesync.ChunkChannel,
+			statesync.LightBlockChannel, statesync.ParamsChannel,
 		},
 		Moniker: config.Moniker,
 		Other: p2p.DefaultNodeInfoOther{
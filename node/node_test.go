@@ -100,6 +100,40 @@ func TestSplitAndTrimEmpty(t *testing.T) {
 	}
 }
 
+func TestCheckHaltConfig(t *testing.T) {
+	blockTime := time.Unix(1000, 0)
+	state := sm.State{LastBlockHeight: 10, LastBlockTime: blockTime}
+	testCases := []struct {
+		haltHeight int64
+		haltTime   int64
+		expectErr  bool
+	}{
+		{0, 0, false},
+		{11, 0, false},
+		{10, 0, true},
+		{5, 0, true},
+		{0, blockTime.Unix() + 1, false},
+		{0, blockTime.Unix(), true},
+		{0, blockTime.Unix() - 1, true},
+	}
+
+	for _, tc := range testCases {
+		config := cfg.DefaultConsensusConfig()
+		config.HaltHeight, config.HaltTime = tc.haltHeight, tc.haltTime
+		err := checkHaltConfig(config, state)
+		if tc.expectErr {
+			require.Error(t, err, "halt height %d, halt time %d", tc.haltHeight, tc.haltTime)
+		} else {
+			require.NoError(t, err, "halt height %d, halt time %d", tc.haltHeight, tc.haltTime)
+		}
+	}
+
+	// No block is committed yet.
+	config := cfg.DefaultConsensusConfig()
+	config.HaltTime = blockTime.Unix()
+	require.NoError(t, checkHaltConfig(config, sm.State{LastBlockTime: blockTime}))
+}
+
 func TestCompanionInitialHeightSetup(t *testing.T) {
 	config := test.ResetTestRoot("companion_initial_height")
 	defer os.RemoveAll(config.RootDir)
@@ -120,6 +120,8 @@ func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
 
 type blockSyncReactor interface {
 	SwitchToBlockSync(state sm.State) error
+	Backfill(ctx context.Context, state sm.State, seenCommit *types.Commit, numBlocks int64,
+		vals blocksync.ValidatorSetProvider) error
 }
 
 // ------------------------------------------------------------------------------
@@ -239,6 +241,24 @@ func onlyValidatorIsUs(state sm.State, pubKey crypto.PubKey) bool {
 }
 
 // createMempoolAndMempoolReactor creates a mempool and a mempool reactor based on the config.
+// checkHaltConfig returns an error if consensus would run past the halt
+// height, already committed in state, or halt again on start for a halt time
+// already reached, e.g. if the node is restarted after halting without
+// unsetting them.
+func checkHaltConfig(config *cfg.ConsensusConfig, state sm.State) error {
+	if haltHeight := config.HaltHeight; haltHeight > 0 && haltHeight <= state.LastBlockHeight {
+		return fmt.Errorf("invalid consensus.halt_height: %w",
+			cs.ErrHaltHeightCommitted{HaltHeight: haltHeight, CommittedHeight: state.LastBlockHeight})
+	}
+	if config.HaltTime > 0 && state.LastBlockHeight > 0 {
+		if haltTime := time.Unix(config.HaltTime, 0); !state.LastBlockTime.Before(haltTime) {
+			return fmt.Errorf("invalid consensus.halt_time: %w",
+				cs.ErrHaltTimeCommitted{HaltTime: haltTime, LastBlockTime: state.LastBlockTime})
+		}
+	}
+	return nil
+}
+
 func createMempoolAndMempoolReactor(
 	config *cfg.Config,
 	proxyApp proxy.AppConns,
@@ -346,7 +366,7 @@ func createConsensusReactor(config *cfg.Config,
 	if privValidator != nil {
 		consensusState.SetPrivValidator(privValidator)
 	}
-	consensusReactor := cs.NewReactor(consensusState, waitSync, cs.ReactorMetrics(csMetrics))
+	consensusReactor := cs.NewReactor(consensusState, waitSync, cs.ReactorMetrics(csMetrics), cs.ReactorMempool(mempool))
 	consensusReactor.SetLogger(consensusLogger)
 	// services which will be publishing and/or subscribing for messages (events)
 	// consensusReactor will set it on consensusState and blockExecutor
@@ -519,7 +539,7 @@ func startStateSync(
 ) error {
 	ssR.Logger.Info("Starting state sync")
 
-	if stateProvider == nil {
+	if stateProvider == nil && !config.UseP2P {
 		var err error
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
@@ -538,6 +558,25 @@ func startStateSync(
 	}
 
 	go func() {
+		// The p2p state provider waits for peers serving light blocks, so it
+		// is set up along with the sync.
+		if stateProvider == nil {
+			var err error
+			stateProvider, err = ssR.NewP2PStateProvider(
+				context.Background(),
+				state.ChainID, state.Version, state.InitialHeight,
+				light.TrustOptions{
+					Period: config.TrustPeriod,
+					Height: config.TrustHeight,
+					Hash:   config.TrustHashBytes(),
+				},
+				dbKeyLayoutVersion)
+			if err != nil {
+				ssR.Logger.Error("Failed to set up p2p state provider", "err", err)
+				return
+			}
+		}
+
 		state, commit, err := ssR.Sync(stateProvider, config.DiscoveryTime)
 		if err != nil {
 			ssR.Logger.Error("State sync failed", "err", err)
@@ -554,6 +593,14 @@ func startStateSync(
 			return
 		}
 
+		if config.BackfillBlocks > 0 {
+			// The node goes on with the blocks fetched if the backfill fails.
+			err = bcR.Backfill(context.Background(), state, commit, config.BackfillBlocks, ssR)
+			if err != nil {
+				ssR.Logger.Error("Failed to backfill blocks", "err", err)
+			}
+		}
+
 		err = bcR.SwitchToBlockSync(state)
 		if err != nil {
 			ssR.Logger.Error("Failed to switch to block sync", "err", err)
@@ -6,6 +6,13 @@ Package privval provides different implementations of the types.PrivValidator.
 FilePV is the simplest implementation and developer default.
 It uses one file for the private key and another to store state.
 
+# SharedSignStatePV
+
+SharedSignStatePV wraps another PrivValidator and keeps the last signed
+height/round/step in a SignStateStore shared by all the nodes using the same key,
+so that a validator can fail over between machines without double signing.
+FileSignStateStore uses a lock file, KVSignStateStore a replicated key-value store.
+
 # SignerListenerEndpoint
 
 SignerListenerEndpoint establishes a connection to an external process,
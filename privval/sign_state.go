@@ -0,0 +1,222 @@
+package privval
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+// ErrSignStateConflict is returned by a SignStateStore when the stored state
+// was modified since it was loaded.
+var ErrSignStateConflict = errors.New("sign state was modified concurrently")
+
+// SignState is the last height/round/step (HRS) signed by a validator, as
+// recorded in a SignStateStore. A state with an HRS but no SignBytes has been
+// claimed by a signer that has not (yet) recorded its signature.
+type SignState struct {
+	Height    int64             `json:"height"`
+	Round     int32             `json:"round"`
+	Step      int8              `json:"step"`
+	Signature []byte            `json:"signature,omitempty"`
+	SignBytes cmtbytes.HexBytes `json:"signbytes,omitempty"`
+}
+
+// CheckHRS checks the given height, round, step against the SignState. See
+// FilePVLastSignState.CheckHRS for the semantics of the return values.
+func (ss SignState) CheckHRS(height int64, round int32, step int8) (bool, error) {
+	lss := FilePVLastSignState{
+		Height:    ss.Height,
+		Round:     ss.Round,
+		Step:      ss.Step,
+		Signature: ss.Signature,
+		SignBytes: ss.SignBytes,
+	}
+	return lss.CheckHRS(height, round, step)
+}
+
+// SignStateStore persists a validator's SignState with compare-and-swap
+// semantics, so that several nodes sharing the same key never sign
+// conflicting messages.
+type SignStateStore interface {
+	// Load returns the stored state along with its revision. An empty store
+	// returns the zero SignState and revision 0.
+	Load() (SignState, uint64, error)
+
+	// CompareAndSwap stores state if the current revision equals rev and
+	// returns the new revision. It returns ErrSignStateConflict otherwise.
+	CompareAndSwap(rev uint64, state SignState) (uint64, error)
+}
+
+// -------------------------------------------------------------------------------
+
+// SharedSignStatePV wraps a PrivValidator and guards it with a SignStateStore
+// shared between all the nodes that may sign with the same key. Before
+// signing, the HRS is claimed in the store; if another node has already
+// signed at (or past) that HRS, signing is refused.
+//
+// NOTE: the wrapped PrivValidator still keeps its own state (e.g.
+// FilePVLastSignState), which only protects the local node.
+type SharedSignStatePV struct {
+	types.PrivValidator
+
+	mtx   cmtsync.Mutex
+	store SignStateStore
+}
+
+var _ types.PrivValidator = (*SharedSignStatePV)(nil)
+
+// NewSharedSignStatePV returns a PrivValidator signing with pv once the HRS
+// has been claimed in store.
+func NewSharedSignStatePV(pv types.PrivValidator, store SignStateStore) *SharedSignStatePV {
+	return &SharedSignStatePV{
+		PrivValidator: pv,
+		store:         store,
+	}
+}
+
+// SignVote claims the vote's HRS in the shared store and signs the vote with
+// the wrapped PrivValidator. Implements PrivValidator.
+func (pv *SharedSignStatePV) SignVote(chainID string, vote *cmtproto.Vote, signExtension bool) error {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	if err := pv.signVote(chainID, vote, signExtension); err != nil {
+		return fmt.Errorf("error signing vote: %w", err)
+	}
+	return nil
+}
+
+// SignProposal claims the proposal's HRS in the shared store and signs the
+// proposal with the wrapped PrivValidator. Implements PrivValidator.
+func (pv *SharedSignStatePV) SignProposal(chainID string, proposal *cmtproto.Proposal) error {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	if err := pv.signProposal(chainID, proposal); err != nil {
+		return fmt.Errorf("error signing proposal: %w", err)
+	}
+	return nil
+}
+
+// String returns a string representation of the SharedSignStatePV.
+func (pv *SharedSignStatePV) String() string {
+	return fmt.Sprintf("SharedSignStatePV{%v}", pv.PrivValidator)
+}
+
+func (pv *SharedSignStatePV) signVote(chainID string, vote *cmtproto.Vote, signExtension bool) error {
+	height, round, step := vote.Height, vote.Round, voteToStep(vote)
+
+	state, rev, sameHRS, err := pv.claim(height, round, step)
+	if err != nil {
+		return err
+	}
+
+	// Some node already signed this HRS. Only sign again if the result is
+	// the exact same vote, which may need the previously signed timestamp.
+	if sameHRS {
+		signBytes := types.VoteSignBytes(chainID, vote)
+		if !bytes.Equal(signBytes, state.SignBytes) {
+			timestamp, ok := checkVotesOnlyDifferByTimestamp(state.SignBytes, signBytes)
+			if !ok {
+				return errors.New("conflicting data")
+			}
+			vote.Timestamp = timestamp
+		}
+		if err := pv.PrivValidator.SignVote(chainID, vote, signExtension); err != nil {
+			return err
+		}
+		if !bytes.Equal(types.VoteSignBytes(chainID, vote), state.SignBytes) {
+			vote.Signature, vote.ExtensionSignature = nil, nil
+			return errors.New("conflicting data")
+		}
+		return nil
+	}
+
+	if err := pv.PrivValidator.SignVote(chainID, vote, signExtension); err != nil {
+		return err
+	}
+	if err := pv.record(rev, height, round, step, types.VoteSignBytes(chainID, vote), vote.Signature); err != nil {
+		vote.Signature, vote.ExtensionSignature = nil, nil
+		return err
+	}
+	return nil
+}
+
+func (pv *SharedSignStatePV) signProposal(chainID string, proposal *cmtproto.Proposal) error {
+	height, round, step := proposal.Height, proposal.Round, stepPropose
+
+	state, rev, sameHRS, err := pv.claim(height, round, step)
+	if err != nil {
+		return err
+	}
+
+	if sameHRS {
+		signBytes := types.ProposalSignBytes(chainID, proposal)
+		if !bytes.Equal(signBytes, state.SignBytes) {
+			timestamp, ok := checkProposalsOnlyDifferByTimestamp(state.SignBytes, signBytes)
+			if !ok {
+				return errors.New("conflicting data")
+			}
+			proposal.Timestamp = timestamp
+		}
+		if err := pv.PrivValidator.SignProposal(chainID, proposal); err != nil {
+			return err
+		}
+		if !bytes.Equal(types.ProposalSignBytes(chainID, proposal), state.SignBytes) {
+			proposal.Signature = nil
+			return errors.New("conflicting data")
+		}
+		return nil
+	}
+
+	if err := pv.PrivValidator.SignProposal(chainID, proposal); err != nil {
+		return err
+	}
+	if err := pv.record(rev, height, round, step, types.ProposalSignBytes(chainID, proposal), proposal.Signature); err != nil {
+		proposal.Signature = nil
+		return err
+	}
+	return nil
+}
+
+// claim checks the HRS against the shared state. If it is new, it is
+// recorded without a signature so that no other node can sign it, and the
+// revision of the claim is returned. If the HRS was already signed, sameHRS
+// is true and the stored state is returned.
+func (pv *SharedSignStatePV) claim(height int64, round int32, step int8) (state SignState, rev uint64, sameHRS bool, err error) {
+	state, rev, err = pv.store.Load()
+	if err != nil {
+		return state, 0, false, fmt.Errorf("loading sign state: %w", err)
+	}
+
+	sameHRS, err = state.CheckHRS(height, round, step)
+	if err != nil || sameHRS {
+		return state, rev, sameHRS, err
+	}
+
+	rev, err = pv.store.CompareAndSwap(rev, SignState{Height: height, Round: round, Step: step})
+	if err != nil {
+		return state, 0, false, fmt.Errorf("claiming height %d round %d step %d: %w", height, round, step, err)
+	}
+	return state, rev, false, nil
+}
+
+// record stores the signature of a claimed HRS.
+func (pv *SharedSignStatePV) record(rev uint64, height int64, round int32, step int8, signBytes, sig []byte) error {
+	_, err := pv.store.CompareAndSwap(rev, SignState{
+		Height:    height,
+		Round:     round,
+		Step:      step,
+		Signature: sig,
+		SignBytes: signBytes,
+	})
+	if err != nil {
+		return fmt.Errorf("recording signature for height %d round %d step %d: %w", height, round, step, err)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package privval
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	cmtos "github.com/cometbft/cometbft/internal/os"
+	"github.com/cometbft/cometbft/internal/tempfile"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+)
+
+const (
+	signStateLockRetryInterval = 10 * time.Millisecond
+	defaultSignStateLockWait   = time.Second
+)
+
+// fileSignState is the on-disk format of FileSignStateStore.
+type fileSignState struct {
+	Revision uint64    `json:"revision"`
+	State    SignState `json:"state"`
+}
+
+// FileSignStateStore is a SignStateStore backed by a JSON file. Updates are
+// serialized with a lock file next to it (filePath + ".lock"), so the store
+// can be shared by processes on the same machine or on a shared filesystem.
+//
+// NOTE: if a process crashes while holding the lock, the lock file must be
+// removed by hand before signing can resume.
+type FileSignStateStore struct {
+	filePath string
+	lockWait time.Duration
+}
+
+var _ SignStateStore = (*FileSignStateStore)(nil)
+
+// NewFileSignStateStore returns a FileSignStateStore persisting to filePath.
+// The file is created on the first update.
+func NewFileSignStateStore(filePath string) *FileSignStateStore {
+	return &FileSignStateStore{
+		filePath: filePath,
+		lockWait: defaultSignStateLockWait,
+	}
+}
+
+// Load implements SignStateStore.
+func (s *FileSignStateStore) Load() (SignState, uint64, error) {
+	fss, err := s.read()
+	if err != nil {
+		return SignState{}, 0, err
+	}
+	return fss.State, fss.Revision, nil
+}
+
+// CompareAndSwap implements SignStateStore.
+func (s *FileSignStateStore) CompareAndSwap(rev uint64, state SignState) (uint64, error) {
+	unlock, err := s.lock()
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	fss, err := s.read()
+	if err != nil {
+		return 0, err
+	}
+	if fss.Revision != rev {
+		return 0, ErrSignStateConflict
+	}
+
+	fss = fileSignState{Revision: rev + 1, State: state}
+	jsonBytes, err := cmtjson.MarshalIndent(fss, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	if err := tempfile.WriteFileAtomic(s.filePath, jsonBytes, 0o600); err != nil {
+		return 0, err
+	}
+	return fss.Revision, nil
+}
+
+func (s *FileSignStateStore) read() (fileSignState, error) {
+	fss := fileSignState{}
+	if !cmtos.FileExists(s.filePath) {
+		return fss, nil
+	}
+	jsonBytes, err := os.ReadFile(s.filePath)
+	if err != nil {
+		return fss, err
+	}
+	if err := cmtjson.Unmarshal(jsonBytes, &fss); err != nil {
+		return fss, fmt.Errorf("error reading sign state from %v: %w", s.filePath, err)
+	}
+	return fss, nil
+}
+
+// lock creates the lock file, waiting up to lockWait for another holder to
+// release it.
+func (s *FileSignStateStore) lock() (func(), error) {
+	lockPath := s.filePath + ".lock"
+	deadline := time.Now().Add(s.lockWait)
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			_ = f.Close()
+			return func() { _ = os.Remove(lockPath) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, err
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("sign state is locked (remove %v if no other process holds it)", lockPath)
+		}
+		time.Sleep(signStateLockRetryInterval)
+	}
+}
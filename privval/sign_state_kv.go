@@ -0,0 +1,123 @@
+package privval
+
+import (
+	"context"
+	"time"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+const defaultKVSignStateTimeout = 5 * time.Second
+
+// KVClient is the subset of a replicated key-value store (e.g. etcd, or any
+// Raft-backed store) needed by KVSignStateStore. Every key has a revision
+// which changes on each write; missing keys have revision 0.
+type KVClient interface {
+	// Get returns the value and revision of key, or (nil, 0, nil) if the key
+	// does not exist.
+	Get(ctx context.Context, key string) (value []byte, rev uint64, err error)
+
+	// CompareAndSwap writes value if the revision of key equals rev, and
+	// returns the new revision. ok is false if the revision did not match.
+	CompareAndSwap(ctx context.Context, key string, rev uint64, value []byte) (newRev uint64, ok bool, err error)
+}
+
+// KVSignStateStore is a SignStateStore backed by a KVClient, allowing the sign
+// state to be shared by validator nodes on different machines.
+type KVSignStateStore struct {
+	client  KVClient
+	key     string
+	timeout time.Duration
+}
+
+var _ SignStateStore = (*KVSignStateStore)(nil)
+
+// NewKVSignStateStore returns a KVSignStateStore keeping the state under key.
+func NewKVSignStateStore(client KVClient, key string) *KVSignStateStore {
+	return &KVSignStateStore{
+		client:  client,
+		key:     key,
+		timeout: defaultKVSignStateTimeout,
+	}
+}
+
+// Load implements SignStateStore.
+func (s *KVSignStateStore) Load() (SignState, uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	state := SignState{}
+	value, rev, err := s.client.Get(ctx, s.key)
+	if err != nil || value == nil {
+		return state, rev, err
+	}
+	if err := cmtjson.Unmarshal(value, &state); err != nil {
+		return state, 0, err
+	}
+	return state, rev, nil
+}
+
+// CompareAndSwap implements SignStateStore.
+func (s *KVSignStateStore) CompareAndSwap(rev uint64, state SignState) (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	value, err := cmtjson.Marshal(state)
+	if err != nil {
+		return 0, err
+	}
+	newRev, ok, err := s.client.CompareAndSwap(ctx, s.key, rev, value)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, ErrSignStateConflict
+	}
+	return newRev, nil
+}
+
+// -------------------------------------------------------------------------------
+
+// MemKVClient is an in-memory KVClient, standing in for a replicated store in
+// tests and local setups.
+type MemKVClient struct {
+	mtx     cmtsync.Mutex
+	rev     uint64
+	entries map[string]memKVEntry
+}
+
+type memKVEntry struct {
+	value []byte
+	rev   uint64
+}
+
+var _ KVClient = (*MemKVClient)(nil)
+
+// NewMemKVClient returns an empty MemKVClient.
+func NewMemKVClient() *MemKVClient {
+	return &MemKVClient{entries: make(map[string]memKVEntry)}
+}
+
+// Get implements KVClient.
+func (c *MemKVClient) Get(_ context.Context, key string) ([]byte, uint64, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	entry := c.entries[key]
+	return entry.value, entry.rev, nil
+}
+
+// CompareAndSwap implements KVClient.
+func (c *MemKVClient) CompareAndSwap(_ context.Context, key string, rev uint64, value []byte) (uint64, bool, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.entries[key].rev != rev {
+		return 0, false, nil
+	}
+	// like etcd, revisions are global to the store
+	c.rev++
+	c.entries[key] = memKVEntry{value: append([]byte(nil), value...), rev: c.rev}
+	return c.rev, true, nil
+}
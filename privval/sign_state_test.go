@@ -0,0 +1,135 @@
+package privval
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtrand "github.com/cometbft/cometbft/internal/rand"
+	"github.com/cometbft/cometbft/types"
+)
+
+// newTestSharedPVs returns two SharedSignStatePVs with the same key, each with
+// its own local FilePV state, sharing store.
+func newTestSharedPVs(t *testing.T, store SignStateStore) (*SharedSignStatePV, *SharedSignStatePV) {
+	t.Helper()
+	filePV, _, _ := newTestFilePV(t)
+	otherFilePV := NewFilePV(filePV.Key.PrivKey, "", filepath.Join(t.TempDir(), "priv_validator_state.json"))
+	return NewSharedSignStatePV(filePV, store), NewSharedSignStatePV(otherFilePV, store)
+}
+
+func TestSharedSignStatePVSignVote(t *testing.T) {
+	stores := map[string]SignStateStore{
+		"file": NewFileSignStateStore(filepath.Join(t.TempDir(), "sign_state.json")),
+		"kv":   NewKVSignStateStore(NewMemKVClient(), "validator"),
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			pv, otherPV := newTestSharedPVs(t, store)
+			pubKey, err := pv.GetPubKey()
+			require.NoError(t, err)
+			addr := pubKey.Address()
+			chainID := "mychainid"
+			height, round := int64(10), int32(1)
+			blockID := types.BlockID{Hash: cmtrand.Bytes(tmhash.Size), PartSetHeader: types.PartSetHeader{}}
+
+			vote := newVote(addr, height, round, types.PrevoteType, blockID).ToProto()
+			require.NoError(t, pv.SignVote(chainID, vote, false))
+
+			// the other node may only produce the very same vote
+			sameVote := newVote(addr, height, round, types.PrevoteType, blockID).ToProto()
+			require.NoError(t, otherPV.SignVote(chainID, sameVote, false))
+			assert.Equal(t, vote.Signature, sameVote.Signature)
+			assert.Equal(t, vote.Timestamp, sameVote.Timestamp)
+
+			otherBlockID := types.BlockID{Hash: cmtrand.Bytes(tmhash.Size), PartSetHeader: types.PartSetHeader{}}
+			conflicting := newVote(addr, height, round, types.PrevoteType, otherBlockID).ToProto()
+			require.Error(t, otherPV.SignVote(chainID, conflicting, false))
+			assert.Nil(t, conflicting.Signature)
+
+			// moving on is fine, going back is not
+			precommit := newVote(addr, height, round, types.PrecommitType, blockID).ToProto()
+			require.NoError(t, otherPV.SignVote(chainID, precommit, false))
+			prevote := newVote(addr, height, round, types.PrevoteType, blockID).ToProto()
+			require.Error(t, pv.SignVote(chainID, prevote, false))
+
+			state, _, err := store.Load()
+			require.NoError(t, err)
+			assert.Equal(t, height, state.Height)
+			assert.Equal(t, round, state.Round)
+			assert.Equal(t, stepPrecommit, state.Step)
+			assert.Equal(t, precommit.Signature, state.Signature)
+		})
+	}
+}
+
+func TestSharedSignStatePVSignProposal(t *testing.T) {
+	pv, otherPV := newTestSharedPVs(t, NewKVSignStateStore(NewMemKVClient(), "validator"))
+	chainID := "mychainid"
+	height, round := int64(10), int32(1)
+	blockID := types.BlockID{Hash: cmtrand.Bytes(tmhash.Size), PartSetHeader: types.PartSetHeader{}}
+
+	proposal := newProposal(height, round, blockID).ToProto()
+	require.NoError(t, pv.SignProposal(chainID, proposal))
+
+	otherBlockID := types.BlockID{Hash: cmtrand.Bytes(tmhash.Size), PartSetHeader: types.PartSetHeader{}}
+	conflicting := newProposal(height, round, otherBlockID).ToProto()
+	require.Error(t, otherPV.SignProposal(chainID, conflicting))
+	assert.Nil(t, conflicting.Signature)
+
+	sameProposal := newProposal(height, round, blockID).ToProto()
+	require.NoError(t, otherPV.SignProposal(chainID, sameProposal))
+	assert.Equal(t, proposal.Signature, sameProposal.Signature)
+}
+
+func TestSharedSignStatePVUnrecordedClaim(t *testing.T) {
+	store := NewKVSignStateStore(NewMemKVClient(), "validator")
+	pv, _ := newTestSharedPVs(t, store)
+
+	// another node claimed the HRS but never recorded its signature
+	_, err := store.CompareAndSwap(0, SignState{Height: 10, Round: 1, Step: stepPrevote})
+	require.NoError(t, err)
+
+	blockID := types.BlockID{Hash: cmtrand.Bytes(tmhash.Size), PartSetHeader: types.PartSetHeader{}}
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	vote := newVote(pubKey.Address(), 10, 1, types.PrevoteType, blockID).ToProto()
+	require.Error(t, pv.SignVote("mychainid", vote, false))
+	assert.Nil(t, vote.Signature)
+}
+
+func TestFileSignStateStore(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "sign_state.json")
+	store := NewFileSignStateStore(filePath)
+
+	state, rev, err := store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, SignState{}, state)
+	assert.Zero(t, rev)
+
+	newState := SignState{Height: 3, Round: 1, Step: stepPrecommit, Signature: []byte{1}, SignBytes: []byte{2}}
+	rev, err = store.CompareAndSwap(rev, newState)
+	require.NoError(t, err)
+
+	_, err = store.CompareAndSwap(0, SignState{Height: 4})
+	require.ErrorIs(t, err, ErrSignStateConflict)
+
+	// another store on the same file sees the update
+	state, loadedRev, err := NewFileSignStateStore(filePath).Load()
+	require.NoError(t, err)
+	assert.Equal(t, newState, state)
+	assert.Equal(t, rev, loadedRev)
+
+	// a held lock blocks updates
+	unlock, err := store.lock()
+	require.NoError(t, err)
+	store.lockWait = 0
+	_, err = store.CompareAndSwap(rev, SignState{Height: 4})
+	require.Error(t, err)
+	unlock()
+	_, err = store.CompareAndSwap(rev, SignState{Height: 4})
+	require.NoError(t, err)
+}
@@ -376,6 +376,26 @@ func (c *baseRPCClient) ConsensusParams(
 	return result, nil
 }
 
+func (c *baseRPCClient) ValidatorLiveness(
+	ctx context.Context,
+	height *int64,
+	window *int,
+) (*ctypes.ResultValidatorLiveness, error) {
+	result := new(ctypes.ResultValidatorLiveness)
+	params := make(map[string]any)
+	if height != nil {
+		params["height"] = height
+	}
+	if window != nil {
+		params["window"] = window
+	}
+	_, err := c.caller.Call(ctx, "validator_liveness", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Health(ctx context.Context) (*ctypes.ResultHealth, error) {
 	result := new(ctypes.ResultHealth)
 	_, err := c.caller.Call(ctx, "health", map[string]any{}, result)
@@ -540,6 +560,36 @@ func (c *baseRPCClient) TxSearch(
 	return result, nil
 }
 
+func (c *baseRPCClient) AccountTxs(
+	ctx context.Context,
+	account string,
+	prove bool,
+	page,
+	perPage *int,
+	orderBy string,
+) (*ctypes.ResultTxSearch, error) {
+	result := new(ctypes.ResultTxSearch)
+	params := map[string]any{
+		"account":  account,
+		"prove":    prove,
+		"order_by": orderBy,
+	}
+
+	if page != nil {
+		params["page"] = page
+	}
+	if perPage != nil {
+		params["per_page"] = perPage
+	}
+
+	_, err := c.caller.Call(ctx, "account_txs", params, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (c *baseRPCClient) BlockSearch(
 	ctx context.Context,
 	query string,
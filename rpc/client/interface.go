@@ -83,6 +83,16 @@ type SignClient interface {
 		orderBy string,
 	) (*ctypes.ResultTxSearch, error)
 
+	// AccountTxs defines a method to get a paginated set of the transactions
+	// indexed by the given account, ordered by height and index.
+	AccountTxs(
+		ctx context.Context,
+		account string,
+		prove bool,
+		page, perPage *int,
+		orderBy string,
+	) (*ctypes.ResultTxSearch, error)
+
 	// BlockSearch defines a method to search for a paginated set of blocks based
 	// from FinalizeBlock event search criteria.
 	BlockSearch(
@@ -113,6 +123,7 @@ type NetworkClient interface {
 	ConsensusState(ctx context.Context) (*ctypes.ResultConsensusState, error)
 	ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error)
 	Health(ctx context.Context) (*ctypes.ResultHealth, error)
+	ValidatorLiveness(ctx context.Context, height *int64, window *int) (*ctypes.ResultValidatorLiveness, error)
 }
 
 // EventsClient is reactive, you can subscribe to any message, given the proper
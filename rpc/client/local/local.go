@@ -142,6 +142,10 @@ func (c *Local) ConsensusParams(_ context.Context, height *int64) (*ctypes.Resul
 	return c.env.ConsensusParams(c.ctx, height)
 }
 
+func (c *Local) ValidatorLiveness(_ context.Context, height *int64, window *int) (*ctypes.ResultValidatorLiveness, error) {
+	return c.env.ValidatorLiveness(c.ctx, height, window)
+}
+
 func (c *Local) Health(context.Context) (*ctypes.ResultHealth, error) {
 	return c.env.Health(c.ctx)
 }
@@ -215,6 +219,17 @@ func (c *Local) TxSearch(
 	return c.env.TxSearch(c.ctx, query, prove, page, perPage, orderBy)
 }
 
+func (c *Local) AccountTxs(
+	_ context.Context,
+	account string,
+	prove bool,
+	page,
+	perPage *int,
+	orderBy string,
+) (*ctypes.ResultTxSearch, error) {
+	return c.env.AccountTxs(c.ctx, account, prove, page, perPage, orderBy)
+}
+
 func (c *Local) BlockSearch(
 	_ context.Context,
 	query string,
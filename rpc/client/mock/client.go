@@ -136,6 +136,10 @@ func (c Client) ConsensusParams(_ context.Context, height *int64) (*ctypes.Resul
 	return c.env.ConsensusParams(&rpctypes.Context{}, height)
 }
 
+func (c Client) ValidatorLiveness(_ context.Context, height *int64, window *int) (*ctypes.ResultValidatorLiveness, error) {
+	return c.env.ValidatorLiveness(&rpctypes.Context{}, height, window)
+}
+
 func (c Client) Health(_ context.Context) (*ctypes.ResultHealth, error) {
 	return c.env.Health(&rpctypes.Context{})
 }
@@ -91,6 +91,29 @@ func (_m *Client) ABCIQueryWithOptions(ctx context.Context, path string, data by
 	return r0, r1
 }
 
+// AccountTxs provides a mock function with given fields: ctx, account, prove, page, perPage, orderBy
+func (_m *Client) AccountTxs(ctx context.Context, account string, prove bool, page *int, perPage *int, orderBy string) (*coretypes.ResultTxSearch, error) {
+	ret := _m.Called(ctx, account, prove, page, perPage, orderBy)
+
+	var r0 *coretypes.ResultTxSearch
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, *int, *int, string) *coretypes.ResultTxSearch); ok {
+		r0 = rf(ctx, account, prove, page, perPage, orderBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultTxSearch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool, *int, *int, string) error); ok {
+		r1 = rf(ctx, account, prove, page, perPage, orderBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Block provides a mock function with given fields: ctx, height
 func (_m *Client) Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error) {
 	ret := _m.Called(ctx, height)
@@ -871,6 +894,29 @@ func (_m *Client) UnsubscribeAll(ctx context.Context, subscriber string) error {
 	return r0
 }
 
+// ValidatorLiveness provides a mock function with given fields: ctx, height, window
+func (_m *Client) ValidatorLiveness(ctx context.Context, height *int64, window *int) (*coretypes.ResultValidatorLiveness, error) {
+	ret := _m.Called(ctx, height, window)
+
+	var r0 *coretypes.ResultValidatorLiveness
+	if rf, ok := ret.Get(0).(func(context.Context, *int64, *int) *coretypes.ResultValidatorLiveness); ok {
+		r0 = rf(ctx, height, window)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultValidatorLiveness)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64, *int) error); ok {
+		r1 = rf(ctx, height, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Validators provides a mock function with given fields: ctx, height, page, perPage
 func (_m *Client) Validators(ctx context.Context, height *int64, page *int, perPage *int) (*coretypes.ResultValidators, error) {
 	ret := _m.Called(ctx, height, page, perPage)
@@ -53,6 +53,87 @@ func (env *Environment) Validators(
 	}, nil
 }
 
+// ValidatorLiveness summarizes which validators signed the commits of the
+// window heights up to the given height, as stored in the BlockStore.
+//
+// If no height is provided, the window ends at the latest block. window
+// defaults to 100 and is capped at 1000 heights.
+//
+// More: https://docs.cometbft.com/main/rpc/#/Info/validator_liveness
+func (env *Environment) ValidatorLiveness(
+	_ *rpctypes.Context,
+	heightPtr *int64,
+	windowPtr *int,
+) (*ctypes.ResultValidatorLiveness, error) {
+	latestHeight := env.BlockStore.Height()
+	height, err := env.getHeight(latestHeight, heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	window := defaultLivenessWindow
+	if windowPtr != nil {
+		if *windowPtr <= 0 {
+			return nil, fmt.Errorf("window must be greater than 0, but got %d", *windowPtr)
+		}
+		window = cmtmath.MinInt(*windowPtr, maxLivenessWindow)
+	}
+	fromHeight := cmtmath.MaxInt64(height-int64(window)+1, env.BlockStore.Base())
+
+	// validators are listed in the order of the most recent set they were in
+	var (
+		liveness  = make([]*ctypes.ValidatorLiveness, 0)
+		byAddress = make(map[string]*ctypes.ValidatorLiveness)
+	)
+	for h := height; h >= fromHeight; h-- {
+		var commit *types.Commit
+		if h == latestHeight {
+			commit = env.BlockStore.LoadSeenCommit(h)
+		} else {
+			commit = env.BlockStore.LoadBlockCommit(h)
+		}
+		if commit == nil {
+			return nil, fmt.Errorf("commit for height %d not found", h)
+		}
+
+		validators, err := env.StateStore.LoadValidators(h)
+		if err != nil {
+			return nil, err
+		}
+		if len(commit.Signatures) != validators.Size() {
+			return nil, fmt.Errorf("commit size (%d) doesn't match valset length (%d) at height %d",
+				len(commit.Signatures), validators.Size(), h)
+		}
+
+		for i, val := range validators.Validators {
+			vl, ok := byAddress[string(val.Address)]
+			if !ok {
+				vl = &ctypes.ValidatorLiveness{
+					Address:     val.Address,
+					VotingPower: val.VotingPower,
+				}
+				byAddress[string(val.Address)] = vl
+				liveness = append(liveness, vl)
+			}
+
+			if commit.Signatures[i].BlockIDFlag == types.BlockIDFlagAbsent {
+				vl.Missed++
+				continue
+			}
+			vl.Signed++
+			if vl.LastSignedHeight == 0 {
+				vl.LastSignedHeight = h
+			}
+		}
+	}
+
+	return &ctypes.ResultValidatorLiveness{
+		FromHeight: fromHeight,
+		ToHeight:   height,
+		Validators: liveness,
+	}, nil
+}
+
 // DumpConsensusState dumps consensus state.
 // UNSTABLE
 // More: https://docs.cometbft.com/main/rpc/#/Info/dump_consensus_state
@@ -0,0 +1,74 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestValidatorLiveness(t *testing.T) {
+	vals, _ := types.RandValidatorSet(3, 10)
+
+	// validator 0 signs everything, validator 1 misses height 4 and validator
+	// 2 misses heights 3 and 5 (the latter in the seen commit)
+	flags := map[int64][]types.BlockIDFlag{
+		3: {types.BlockIDFlagCommit, types.BlockIDFlagCommit, types.BlockIDFlagAbsent},
+		4: {types.BlockIDFlagCommit, types.BlockIDFlagAbsent, types.BlockIDFlagNil},
+		5: {types.BlockIDFlagCommit, types.BlockIDFlagCommit, types.BlockIDFlagAbsent},
+	}
+	commit := func(height int64) *types.Commit {
+		sigs := make([]types.CommitSig, len(flags[height]))
+		for i, flag := range flags[height] {
+			sigs[i] = types.CommitSig{BlockIDFlag: flag}
+		}
+		return &types.Commit{Height: height, Signatures: sigs}
+	}
+
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Height").Return(int64(5))
+	blockStore.On("Base").Return(int64(3))
+	blockStore.On("LoadSeenCommit", int64(5)).Return(commit(5))
+	blockStore.On("LoadBlockCommit", int64(4)).Return(commit(4))
+	blockStore.On("LoadBlockCommit", int64(3)).Return(commit(3))
+	stateStore := &mocks.Store{}
+	stateStore.On("LoadValidators", int64(5)).Return(vals, nil)
+	stateStore.On("LoadValidators", int64(4)).Return(vals, nil)
+	stateStore.On("LoadValidators", int64(3)).Return(vals, nil)
+	env := &Environment{BlockStore: blockStore, StateStore: stateStore}
+
+	res, err := env.ValidatorLiveness(&rpctypes.Context{}, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), res.FromHeight)
+	assert.Equal(t, int64(5), res.ToHeight)
+	require.Len(t, res.Validators, 3)
+
+	expected := []struct {
+		signed, missed, lastSigned int64
+	}{
+		{3, 0, 5},
+		{2, 1, 5},
+		{1, 2, 4},
+	}
+	for i, e := range expected {
+		vl := res.Validators[i]
+		assert.Equal(t, vals.Validators[i].Address, vl.Address)
+		assert.Equal(t, e.signed, vl.Signed, "validator %d", i)
+		assert.Equal(t, e.missed, vl.Missed, "validator %d", i)
+		assert.Equal(t, e.lastSigned, vl.LastSignedHeight, "validator %d", i)
+	}
+
+	height, window := int64(4), 1
+	res, err = env.ValidatorLiveness(&rpctypes.Context{}, &height, &window)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), res.FromHeight)
+	assert.Equal(t, int64(1), res.Validators[1].Missed)
+
+	window = 0
+	_, err = env.ValidatorLiveness(&rpctypes.Context{}, nil, &window)
+	require.Error(t, err)
+}
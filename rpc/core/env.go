@@ -15,6 +15,7 @@ import (
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/statesync"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -23,6 +24,10 @@ const (
 	defaultPerPage = 30
 	maxPerPage     = 100
 
+	// number of heights summarized by validator_liveness.
+	defaultLivenessWindow = 100
+	maxLivenessWindow     = 1000
+
 	// SubscribeTimeout is the maximum time we wait to subscribe for an event.
 	// must be less than the server's write timeout (see rpcserver.DefaultConfig).
 	SubscribeTimeout = 5 * time.Second
@@ -61,6 +66,11 @@ type syncReactor interface {
 	WaitSync() bool
 }
 
+// A reactor restoring the state from a snapshot.
+type stateSyncReactor interface {
+	SyncProgress() (statesync.Progress, bool)
+}
+
 // Environment contains objects and interfaces used by the RPC. It is expected
 // to be setup once during startup.
 type Environment struct {
@@ -75,6 +85,7 @@ type Environment struct {
 	ConsensusState   Consensus
 	ConsensusReactor syncReactor
 	MempoolReactor   syncReactor
+	StateSyncReactor stateSyncReactor
 	P2PPeers         peers
 	P2PTransport     transport
 
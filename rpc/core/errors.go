@@ -9,6 +9,8 @@ var (
 	ErrNegativeHeight          = errors.New("negative height")
 	ErrBlockIndexing           = errors.New("block indexing is disabled")
 	ErrTxIndexingDisabled      = errors.New("transaction indexing is disabled")
+	ErrAccountTxsNotSupported  = errors.New("the transaction indexer does not index transactions by account")
+	ErrEmptyAccount            = errors.New("account cannot be empty")
 	ErrNoEvidence              = errors.New("no evidence was provided")
 	ErrSlowClient              = errors.New("slow client")
 	ErrCometBFTExited          = errors.New("cometBFT exited")
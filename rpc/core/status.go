@@ -71,6 +71,19 @@ func (env *Environment) Status(*rpctypes.Context) (*ctypes.ResultStatus, error)
 		},
 	}
 
+	if env.StateSyncReactor != nil {
+		if progress, ok := env.StateSyncReactor.SyncProgress(); ok {
+			result.SyncInfo.StateSync = &ctypes.StateSyncInfo{
+				SnapshotHeight: int64(progress.SnapshotHeight),
+				TotalChunks:    progress.TotalChunks,
+				FetchedChunks:  progress.FetchedChunks,
+				AppliedChunks:  progress.AppliedChunks,
+				BytesPerSecond: progress.BytesPerSecond,
+				RemainingTime:  progress.RemainingTime,
+			}
+		}
+	}
+
 	return result, nil
 }
 
@@ -1,6 +1,7 @@
 package core
 
 import (
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
@@ -96,6 +97,64 @@ func (env *Environment) TxSearch(
 		return nil, err
 	}
 
+	return &ctypes.ResultTxSearch{Txs: env.resultTxs(results, prove), TotalCount: totalCount}, nil
+}
+
+// AccountTxs allows you to query for the transactions of an account, one of
+// the index keys of their results set by the application, e.g. the address of
+// one of their signers. It returns a list of transactions (maximum ?per_page
+// entries), ordered by height and index, and the total count.
+// More: https://docs.cometbft.com/main/rpc/#/Info/account_txs
+func (env *Environment) AccountTxs(
+	ctx *rpctypes.Context,
+	account string,
+	prove bool,
+	pagePtr, perPagePtr *int,
+	orderBy string,
+) (*ctypes.ResultTxSearch, error) {
+	// if index is disabled, return error
+	if _, ok := env.TxIndexer.(*null.TxIndex); ok {
+		return nil, ErrTxIndexingDisabled
+	}
+	indexer, ok := env.TxIndexer.(txindex.AccountTxIndexer)
+	if !ok {
+		return nil, ErrAccountTxsNotSupported
+	}
+	if account == "" {
+		return nil, ErrEmptyAccount
+	}
+
+	// if orderBy is not "asc", "desc", or blank, return error
+	if orderBy != "" && orderBy != Ascending && orderBy != Descending {
+		return nil, ErrInvalidOrderBy{orderBy}
+	}
+
+	// Validate number of results per page
+	perPage := env.validatePerPage(perPagePtr)
+	if pagePtr == nil {
+		// Default to page 1 if not specified
+		pagePtr = new(int)
+		*pagePtr = 1
+	}
+
+	pagSettings := txindex.Pagination{
+		OrderDesc:   orderBy == Descending,
+		IsPaginated: true,
+		Page:        *pagePtr,
+		PerPage:     perPage,
+	}
+
+	results, totalCount, err := indexer.AccountTxs(ctx.Context(), account, pagSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctypes.ResultTxSearch{Txs: env.resultTxs(results, prove), TotalCount: totalCount}, nil
+}
+
+// resultTxs returns the results of the RPC for the given transaction results,
+// with the proofs of their inclusion in their block if prove is set.
+func (env *Environment) resultTxs(results []*abci.TxResult, prove bool) []*ctypes.ResultTx {
 	apiResults := make([]*ctypes.ResultTx, 0, len(results))
 	for _, r := range results {
 		var proof types.TxProof
@@ -113,6 +172,5 @@ func (env *Environment) TxSearch(
 			Proof:    proof,
 		})
 	}
-
-	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount}, nil
+	return apiResults
 }
@@ -87,6 +87,18 @@ type SyncInfo struct {
 	EarliestBlockTime   time.Time      `json:"earliest_block_time"`
 
 	CatchingUp bool `json:"catching_up"`
+
+	StateSync *StateSyncInfo `json:"state_sync,omitempty"`
+}
+
+// Info about the snapshot being restored by state sync.
+type StateSyncInfo struct {
+	SnapshotHeight int64         `json:"snapshot_height"`
+	TotalChunks    uint32        `json:"total_chunks"`
+	FetchedChunks  uint32        `json:"fetched_chunks"`
+	AppliedChunks  uint32        `json:"applied_chunks"`
+	BytesPerSecond float64       `json:"bytes_per_second"`
+	RemainingTime  time.Duration `json:"remaining_time"`
 }
 
 // Info about the node's validator.
@@ -147,6 +159,23 @@ type ResultValidators struct {
 	Total int `json:"total"`
 }
 
+// Validator liveness over a range of heights.
+type ResultValidatorLiveness struct {
+	FromHeight int64                `json:"from_height"`
+	ToHeight   int64                `json:"to_height"`
+	Validators []*ValidatorLiveness `json:"validators"`
+}
+
+// ValidatorLiveness counts the commits a validator signed or missed.
+type ValidatorLiveness struct {
+	Address types.Address `json:"address"`
+	// Voting power at the most recent height the validator was in the set
+	VotingPower      int64 `json:"voting_power"`
+	Signed           int64 `json:"signed"`
+	Missed           int64 `json:"missed"`
+	LastSignedHeight int64 `json:"last_signed_height"`
+}
+
 // ConsensusParams for given height.
 type ResultConsensusParams struct {
 	BlockHeight     int64                 `json:"block_height"`
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	abciquerysvc "github.com/cometbft/cometbft/api/cometbft/services/abci_query/v1"
+)
+
+// ABCIQueryOptions can be used to provide options for ABCIQuery call other
+// than the path and data.
+type ABCIQueryOptions struct {
+	Height int64
+	Prove  bool
+}
+
+// ABCIQueryServiceClient queries the application.
+type ABCIQueryServiceClient interface {
+	// ABCIQuery queries the application at path with data.
+	ABCIQuery(ctx context.Context, path string, data []byte, opts ABCIQueryOptions) (*abci.QueryResponse, error)
+}
+
+type abciQueryServiceClient struct {
+	client abciquerysvc.ABCIQueryServiceClient
+}
+
+func newABCIQueryServiceClient(conn grpc.ClientConn) ABCIQueryServiceClient {
+	return &abciQueryServiceClient{
+		client: abciquerysvc.NewABCIQueryServiceClient(conn),
+	}
+}
+
+// ABCIQuery implements ABCIQueryServiceClient ABCIQuery.
+func (c *abciQueryServiceClient) ABCIQuery(ctx context.Context, path string, data []byte, opts ABCIQueryOptions) (*abci.QueryResponse, error) {
+	res, err := c.client.Query(ctx, &abciquerysvc.QueryRequest{
+		Path:   path,
+		Data:   data,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Response, nil
+}
+
+type disabledABCIQueryServiceClient struct{}
+
+func newDisabledABCIQueryServiceClient() ABCIQueryServiceClient {
+	return &disabledABCIQueryServiceClient{}
+}
+
+// ABCIQuery implements ABCIQueryServiceClient ABCIQuery - disabled client.
+func (*disabledABCIQueryServiceClient) ABCIQuery(context.Context, string, []byte, ABCIQueryOptions) (*abci.QueryResponse, error) {
+	panic("ABCI query service client is disabled")
+}
@@ -60,6 +60,10 @@ type BlockServiceClient interface {
 	// given height.
 	GetBlockByHeight(ctx context.Context, height int64) (*Block, error)
 
+	// GetSignedHeader attempts to retrieve the header of the block associated
+	// with the given height and the commit signing it.
+	GetSignedHeader(ctx context.Context, height int64) (*types.SignedHeader, error)
+
 	// GetLatestHeight provides sends the latest committed block height to the
 	// resulting output channel as blocks are committed.
 	GetLatestHeight(ctx context.Context, opts ...GetLatestHeightOption) (<-chan LatestHeightResult, error)
@@ -87,6 +91,18 @@ func (c *blockServiceClient) GetBlockByHeight(ctx context.Context, height int64)
 	return blockFromProto(res.BlockId, res.Block)
 }
 
+// GetSignedHeader implements BlockServiceClient GetSignedHeader.
+func (c *blockServiceClient) GetSignedHeader(ctx context.Context, height int64) (*types.SignedHeader, error) {
+	res, err := c.client.GetSignedHeader(ctx, &blocksvc.GetSignedHeaderRequest{
+		Height: height,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.SignedHeaderFromProto(res.SignedHeader)
+}
+
 // GetLatestHeight implements BlockServiceClient GetLatestHeight.
 func (c *blockServiceClient) GetLatestHeight(ctx context.Context, opts ...GetLatestHeightOption) (<-chan LatestHeightResult, error) {
 	req := blocksvc.GetLatestHeightRequest{}
@@ -140,6 +156,11 @@ func (*disabledBlockServiceClient) GetBlockByHeight(context.Context, int64) (*Bl
 	panic("block service client is disabled")
 }
 
+// GetSignedHeader implements BlockServiceClient GetSignedHeader - disabled client.
+func (*disabledBlockServiceClient) GetSignedHeader(context.Context, int64) (*types.SignedHeader, error) {
+	panic("block service client is disabled")
+}
+
 // GetLatestBlock implements BlockServiceClient.
 func (*disabledBlockServiceClient) GetLatestBlock(context.Context) (*Block, error) {
 	panic("block service client is disabled")
@@ -22,6 +22,9 @@ type Client interface {
 	VersionServiceClient
 	BlockServiceClient
 	BlockResultsServiceClient
+	ValidatorSetServiceClient
+	ConsensusParamsServiceClient
+	ABCIQueryServiceClient
 
 	// Close the connection to the server. Any subsequent requests will fail.
 	Close() error
@@ -31,18 +34,24 @@ type clientBuilder struct {
 	dialerFunc func(context.Context, string) (net.Conn, error)
 	grpcOpts   []ggrpc.DialOption
 
-	versionServiceEnabled      bool
-	blockServiceEnabled        bool
-	blockResultsServiceEnabled bool
+	versionServiceEnabled         bool
+	blockServiceEnabled           bool
+	blockResultsServiceEnabled    bool
+	validatorSetServiceEnabled    bool
+	consensusParamsServiceEnabled bool
+	abciQueryServiceEnabled       bool
 }
 
 func newClientBuilder() *clientBuilder {
 	return &clientBuilder{
-		dialerFunc:                 defaultDialerFunc,
-		grpcOpts:                   make([]ggrpc.DialOption, 0),
-		versionServiceEnabled:      true,
-		blockServiceEnabled:        true,
-		blockResultsServiceEnabled: true,
+		dialerFunc:                    defaultDialerFunc,
+		grpcOpts:                      make([]ggrpc.DialOption, 0),
+		versionServiceEnabled:         true,
+		blockServiceEnabled:           true,
+		blockResultsServiceEnabled:    true,
+		validatorSetServiceEnabled:    true,
+		consensusParamsServiceEnabled: true,
+		abciQueryServiceEnabled:       true,
 	}
 }
 
@@ -56,6 +65,9 @@ type client struct {
 	VersionServiceClient
 	BlockServiceClient
 	BlockResultsServiceClient
+	ValidatorSetServiceClient
+	ConsensusParamsServiceClient
+	ABCIQueryServiceClient
 }
 
 // Close implements Client.
@@ -94,6 +106,40 @@ func WithBlockServiceEnabled(enabled bool) Option {
 	}
 }
 
+// WithValidatorSetServiceEnabled allows control of whether or not to create a
+// client for interacting with the validator set service of a CometBFT node.
+//
+// If disabled and the client attempts to access the validator set service API,
+// the client will panic.
+func WithValidatorSetServiceEnabled(enabled bool) Option {
+	return func(b *clientBuilder) {
+		b.validatorSetServiceEnabled = enabled
+	}
+}
+
+// WithConsensusParamsServiceEnabled allows control of whether or not to create
+// a client for interacting with the consensus params service of a CometBFT
+// node.
+//
+// If disabled and the client attempts to access the consensus params service
+// API, the client will panic.
+func WithConsensusParamsServiceEnabled(enabled bool) Option {
+	return func(b *clientBuilder) {
+		b.consensusParamsServiceEnabled = enabled
+	}
+}
+
+// WithABCIQueryServiceEnabled allows control of whether or not to create a
+// client for interacting with the ABCI query service of a CometBFT node.
+//
+// If disabled and the client attempts to access the ABCI query service API,
+// the client will panic.
+func WithABCIQueryServiceEnabled(enabled bool) Option {
+	return func(b *clientBuilder) {
+		b.abciQueryServiceEnabled = enabled
+	}
+}
+
 // WithGRPCDialOption allows passing lower-level gRPC dial options through to
 // the gRPC dialer when creating the client.
 func WithGRPCDialOption(opt ggrpc.DialOption) Option {
@@ -133,10 +179,25 @@ func New(_ context.Context, addr string, opts ...Option) (Client, error) {
 	if builder.blockResultsServiceEnabled {
 		blockResultServiceClient = newBlockResultsServiceClient(conn)
 	}
+	validatorSetServiceClient := newDisabledValidatorSetServiceClient()
+	if builder.validatorSetServiceEnabled {
+		validatorSetServiceClient = newValidatorSetServiceClient(conn)
+	}
+	consensusParamsServiceClient := newDisabledConsensusParamsServiceClient()
+	if builder.consensusParamsServiceEnabled {
+		consensusParamsServiceClient = newConsensusParamsServiceClient(conn)
+	}
+	abciQueryServiceClient := newDisabledABCIQueryServiceClient()
+	if builder.abciQueryServiceEnabled {
+		abciQueryServiceClient = newABCIQueryServiceClient(conn)
+	}
 	return &client{
-		conn:                      conn,
-		VersionServiceClient:      versionServiceClient,
-		BlockServiceClient:        blockServiceClient,
-		BlockResultsServiceClient: blockResultServiceClient,
+		conn:                         conn,
+		VersionServiceClient:         versionServiceClient,
+		BlockServiceClient:           blockServiceClient,
+		BlockResultsServiceClient:    blockResultServiceClient,
+		ValidatorSetServiceClient:    validatorSetServiceClient,
+		ConsensusParamsServiceClient: consensusParamsServiceClient,
+		ABCIQueryServiceClient:       abciQueryServiceClient,
 	}, nil
 }
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	paramssvc "github.com/cometbft/cometbft/api/cometbft/services/consensus_params/v1"
+	"github.com/cometbft/cometbft/types"
+)
+
+// ConsensusParams data returned by the CometBFT ConsensusParamsService gRPC
+// API.
+type ConsensusParams struct {
+	Height          int64                 `json:"height"`
+	ConsensusParams types.ConsensusParams `json:"consensus_params"`
+}
+
+// ConsensusParamsServiceClient provides the consensus parameters of a given
+// height.
+type ConsensusParamsServiceClient interface {
+	// GetConsensusParamsByHeight attempts to retrieve the consensus parameters
+	// at the given height, or at the latest height if 0.
+	GetConsensusParamsByHeight(ctx context.Context, height int64) (*ConsensusParams, error)
+}
+
+type consensusParamsServiceClient struct {
+	client paramssvc.ConsensusParamsServiceClient
+}
+
+func newConsensusParamsServiceClient(conn grpc.ClientConn) ConsensusParamsServiceClient {
+	return &consensusParamsServiceClient{
+		client: paramssvc.NewConsensusParamsServiceClient(conn),
+	}
+}
+
+// GetConsensusParamsByHeight implements ConsensusParamsServiceClient GetConsensusParamsByHeight.
+func (c *consensusParamsServiceClient) GetConsensusParamsByHeight(ctx context.Context, height int64) (*ConsensusParams, error) {
+	res, err := c.client.GetByHeight(ctx, &paramssvc.GetByHeightRequest{
+		Height: height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.ConsensusParams == nil {
+		return nil, errors.New("no consensus parameters in the response")
+	}
+
+	return &ConsensusParams{
+		Height:          res.Height,
+		ConsensusParams: types.ConsensusParamsFromProto(*res.ConsensusParams),
+	}, nil
+}
+
+type disabledConsensusParamsServiceClient struct{}
+
+func newDisabledConsensusParamsServiceClient() ConsensusParamsServiceClient {
+	return &disabledConsensusParamsServiceClient{}
+}
+
+// GetConsensusParamsByHeight implements ConsensusParamsServiceClient GetConsensusParamsByHeight - disabled client.
+func (*disabledConsensusParamsServiceClient) GetConsensusParamsByHeight(context.Context, int64) (*ConsensusParams, error) {
+	panic("consensus params service client is disabled")
+}
@@ -0,0 +1,57 @@
+package privileged
+
+import (
+	"context"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	pbsvc "github.com/cometbft/cometbft/api/cometbft/services/halt/v1"
+)
+
+type HaltServiceClient interface {
+	SetHaltHeight(ctx context.Context, height uint64) error
+	GetHaltHeight(ctx context.Context) (uint64, error)
+}
+
+type haltServiceClient struct {
+	inner pbsvc.HaltServiceClient
+}
+
+func newHaltServiceClient(conn grpc.ClientConn) HaltServiceClient {
+	return &haltServiceClient{
+		inner: pbsvc.NewHaltServiceClient(conn),
+	}
+}
+
+// SetHaltHeight implements HaltServiceClient.
+func (c *haltServiceClient) SetHaltHeight(ctx context.Context, height uint64) error {
+	_, err := c.inner.SetHaltHeight(ctx, &pbsvc.SetHaltHeightRequest{
+		Height: height,
+	})
+	return err
+}
+
+// GetHaltHeight implements HaltServiceClient.
+func (c *haltServiceClient) GetHaltHeight(ctx context.Context) (uint64, error) {
+	res, err := c.inner.GetHaltHeight(ctx, &pbsvc.GetHaltHeightRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return res.Height, nil
+}
+
+type disabledHaltServiceClient struct{}
+
+func newDisabledHaltServiceClient() HaltServiceClient {
+	return &disabledHaltServiceClient{}
+}
+
+// SetHaltHeight implements HaltServiceClient.
+func (*disabledHaltServiceClient) SetHaltHeight(context.Context, uint64) error {
+	panic("halt service client is disabled")
+}
+
+// GetHaltHeight implements HaltServiceClient.
+func (*disabledHaltServiceClient) GetHaltHeight(context.Context) (uint64, error) {
+	panic("halt service client is disabled")
+}
@@ -17,6 +17,7 @@ type Option func(*clientBuilder)
 // a CometBFT node via the privileged gRPC server.
 type Client interface {
 	PruningServiceClient
+	HaltServiceClient
 
 	// Close the connection to the server. Any subsequent requests will fail.
 	Close() error
@@ -27,6 +28,7 @@ type clientBuilder struct {
 	grpcOpts   []ggrpc.DialOption
 
 	pruningServiceEnabled bool
+	haltServiceEnabled    bool
 }
 
 func newClientBuilder() *clientBuilder {
@@ -34,6 +36,7 @@ func newClientBuilder() *clientBuilder {
 		dialerFunc:            defaultDialerFunc,
 		grpcOpts:              make([]ggrpc.DialOption, 0),
 		pruningServiceEnabled: true,
+		haltServiceEnabled:    true,
 	}
 }
 
@@ -45,6 +48,7 @@ type client struct {
 	conn *ggrpc.ClientConn
 
 	PruningServiceClient
+	HaltServiceClient
 }
 
 // Close implements Client.
@@ -72,6 +76,17 @@ func WithPruningServiceEnabled(enabled bool) Option {
 	}
 }
 
+// WithHaltServiceEnabled allows control of whether or not to create a client
+// for interacting with the halt service of a CometBFT node.
+//
+// If disabled and the client attempts to access the halt service API, the
+// client will panic.
+func WithHaltServiceEnabled(enabled bool) Option {
+	return func(b *clientBuilder) {
+		b.haltServiceEnabled = enabled
+	}
+}
+
 // WithGRPCDialOption allows passing lower-level gRPC dial options through to
 // the gRPC dialer when creating the client.
 func WithGRPCDialOption(opt ggrpc.DialOption) Option {
@@ -104,8 +119,13 @@ func New(_ context.Context, addr string, opts ...Option) (Client, error) {
 	if builder.pruningServiceEnabled {
 		pruningServiceClient = newPruningServiceClient(conn)
 	}
+	haltServiceClient := newDisabledHaltServiceClient()
+	if builder.haltServiceEnabled {
+		haltServiceClient = newHaltServiceClient(conn)
+	}
 	return &client{
 		conn:                 conn,
 		PruningServiceClient: pruningServiceClient,
+		HaltServiceClient:    haltServiceClient,
 	}, nil
 }
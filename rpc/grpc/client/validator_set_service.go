@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	valsetsvc "github.com/cometbft/cometbft/api/cometbft/services/validator_set/v1"
+	"github.com/cometbft/cometbft/types"
+)
+
+// ValidatorSet data returned by the CometBFT ValidatorSetService gRPC API.
+type ValidatorSet struct {
+	Height       int64               `json:"height"`
+	ValidatorSet *types.ValidatorSet `json:"validator_set"`
+}
+
+// ValidatorSetChanges data returned by the CometBFT ValidatorSetService gRPC
+// API.
+type ValidatorSetChanges struct {
+	// Heights, in ascending order, whose validator set differs from the one of
+	// the previous height.
+	Heights []int64 `json:"heights"`
+	// LastHeight is the last height scanned, lower than the requested one if
+	// the range was truncated.
+	LastHeight int64 `json:"last_height"`
+}
+
+// ValidatorSetServiceClient provides the validator set of a given height.
+type ValidatorSetServiceClient interface {
+	// GetValidatorSetByHeight attempts to retrieve the validator set at the
+	// given height, or at the latest height if 0.
+	GetValidatorSetByHeight(ctx context.Context, height int64) (*ValidatorSet, error)
+
+	// GetValidatorSetChanges attempts to retrieve the heights at which the
+	// validator set changed within [from, to], or [from, latest] if to is 0.
+	GetValidatorSetChanges(ctx context.Context, from, to int64) (*ValidatorSetChanges, error)
+}
+
+type validatorSetServiceClient struct {
+	client valsetsvc.ValidatorSetServiceClient
+}
+
+func newValidatorSetServiceClient(conn grpc.ClientConn) ValidatorSetServiceClient {
+	return &validatorSetServiceClient{
+		client: valsetsvc.NewValidatorSetServiceClient(conn),
+	}
+}
+
+// GetValidatorSetByHeight implements ValidatorSetServiceClient GetValidatorSetByHeight.
+func (c *validatorSetServiceClient) GetValidatorSetByHeight(ctx context.Context, height int64) (*ValidatorSet, error) {
+	res, err := c.client.GetByHeight(ctx, &valsetsvc.GetByHeightRequest{
+		Height: height,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := types.ValidatorSetFromProto(res.ValidatorSet)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSet{
+		Height:       res.Height,
+		ValidatorSet: vals,
+	}, nil
+}
+
+// GetValidatorSetChanges implements ValidatorSetServiceClient GetValidatorSetChanges.
+func (c *validatorSetServiceClient) GetValidatorSetChanges(ctx context.Context, from, to int64) (*ValidatorSetChanges, error) {
+	res, err := c.client.GetChanges(ctx, &valsetsvc.GetChangesRequest{
+		FromHeight: from,
+		ToHeight:   to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ValidatorSetChanges{
+		Heights:    res.Heights,
+		LastHeight: res.LastHeight,
+	}, nil
+}
+
+type disabledValidatorSetServiceClient struct{}
+
+func newDisabledValidatorSetServiceClient() ValidatorSetServiceClient {
+	return &disabledValidatorSetServiceClient{}
+}
+
+// GetValidatorSetByHeight implements ValidatorSetServiceClient GetValidatorSetByHeight - disabled client.
+func (*disabledValidatorSetServiceClient) GetValidatorSetByHeight(context.Context, int64) (*ValidatorSet, error) {
+	panic("validator set service client is disabled")
+}
+
+// GetValidatorSetChanges implements ValidatorSetServiceClient GetValidatorSetChanges - disabled client.
+func (*disabledValidatorSetServiceClient) GetValidatorSetChanges(context.Context, int64, int64) (*ValidatorSetChanges, error) {
+	panic("validator set service client is disabled")
+}
@@ -6,8 +6,11 @@ import (
 
 	"google.golang.org/grpc"
 
+	pbhaltsvc "github.com/cometbft/cometbft/api/cometbft/services/halt/v1"
 	pbpruningsvc "github.com/cometbft/cometbft/api/cometbft/services/pruning/v1"
+	cs "github.com/cometbft/cometbft/internal/consensus"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/haltservice"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/pruningservice"
 	sm "github.com/cometbft/cometbft/state"
 )
@@ -19,6 +22,7 @@ type Option func(*serverBuilder)
 type serverBuilder struct {
 	listener       net.Listener
 	pruningService pbpruningsvc.PruningServiceServer
+	haltService    pbhaltsvc.HaltServiceServer
 	logger         log.Logger
 	grpcOpts       []grpc.ServerOption
 }
@@ -38,6 +42,13 @@ func WithPruningService(pruner *sm.Pruner, logger log.Logger) Option {
 	}
 }
 
+// WithHaltService enables the halt service on the CometBFT server.
+func WithHaltService(consensusState *cs.State, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.haltService = haltservice.New(consensusState, logger)
+	}
+}
+
 // WithLogger enables logging using the given logger. If not specified, the
 // gRPC server does not log anything.
 func WithLogger(logger log.Logger) Option {
@@ -69,6 +80,10 @@ func Serve(listener net.Listener, opts ...Option) error {
 		pbpruningsvc.RegisterPruningServiceServer(server, b.pruningService)
 		b.logger.Debug("Registered pruning service")
 	}
+	if b.haltService != nil {
+		pbhaltsvc.RegisterHaltServiceServer(server, b.haltService)
+		b.logger.Debug("Registered halt service")
+	}
 	b.logger.Info("serve", "msg", fmt.Sprintf("Starting privileged gRPC server on %s", listener.Addr()))
 	return server.Serve(b.listener)
 }
@@ -7,13 +7,20 @@ import (
 
 	"google.golang.org/grpc"
 
+	pbabciquerysvc "github.com/cometbft/cometbft/api/cometbft/services/abci_query/v1"
 	pbblocksvc "github.com/cometbft/cometbft/api/cometbft/services/block/v1"
 	brs "github.com/cometbft/cometbft/api/cometbft/services/block_results/v1"
+	pbparamssvc "github.com/cometbft/cometbft/api/cometbft/services/consensus_params/v1"
+	pbvalsetsvc "github.com/cometbft/cometbft/api/cometbft/services/validator_set/v1"
 	pbversionsvc "github.com/cometbft/cometbft/api/cometbft/services/version/v1"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
 	grpcerr "github.com/cometbft/cometbft/rpc/grpc/errors"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/abciqueryservice"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/blockresultservice"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/blockservice"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/consensusparamsservice"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/validatorsetservice"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/versionservice"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/store"
@@ -29,6 +36,9 @@ type serverBuilder struct {
 	versionService      pbversionsvc.VersionServiceServer
 	blockService        pbblocksvc.BlockServiceServer
 	blockResultsService brs.BlockResultsServiceServer
+	validatorSetService pbvalsetsvc.ValidatorSetServiceServer
+	paramsService       pbparamssvc.ConsensusParamsServiceServer
+	abciQueryService    pbabciquerysvc.ABCIQueryServiceServer
 	logger              log.Logger
 	grpcOpts            []grpc.ServerOption
 }
@@ -74,6 +84,29 @@ func WithBlockResultsService(bs *store.BlockStore, ss sm.Store, logger log.Logge
 	}
 }
 
+// WithValidatorSetService enables the validator set service on the CometBFT
+// server.
+func WithValidatorSetService(bs *store.BlockStore, ss sm.Store, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.validatorSetService = validatorsetservice.New(bs, ss, logger)
+	}
+}
+
+// WithConsensusParamsService enables the consensus parameters service on the
+// CometBFT server.
+func WithConsensusParamsService(bs *store.BlockStore, ss sm.Store, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.paramsService = consensusparamsservice.New(bs, ss, logger)
+	}
+}
+
+// WithABCIQueryService enables the ABCI query service on the CometBFT server.
+func WithABCIQueryService(proxyApp proxy.AppConnQuery, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.abciQueryService = abciqueryservice.New(proxyApp, logger)
+	}
+}
+
 // WithLogger enables logging using the given logger. If not specified, the
 // gRPC server does not log anything.
 func WithLogger(logger log.Logger) Option {
@@ -113,6 +146,18 @@ func Serve(listener net.Listener, opts ...Option) error {
 		brs.RegisterBlockResultsServiceServer(server, b.blockResultsService)
 		b.logger.Debug("Registered block results service")
 	}
+	if b.validatorSetService != nil {
+		pbvalsetsvc.RegisterValidatorSetServiceServer(server, b.validatorSetService)
+		b.logger.Debug("Registered validator set service")
+	}
+	if b.paramsService != nil {
+		pbparamssvc.RegisterConsensusParamsServiceServer(server, b.paramsService)
+		b.logger.Debug("Registered consensus params service")
+	}
+	if b.abciQueryService != nil {
+		pbabciquerysvc.RegisterABCIQueryServiceServer(server, b.abciQueryService)
+		b.logger.Debug("Registered ABCI query service")
+	}
 	b.logger.Info("serve", "msg", fmt.Sprintf("Starting gRPC server on %s", listener.Addr()))
 	return server.Serve(b.listener)
 }
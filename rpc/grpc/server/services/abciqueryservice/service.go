@@ -0,0 +1,48 @@
+package abciqueryservice
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	abciquerysvc "github.com/cometbft/cometbft/api/cometbft/services/abci_query/v1"
+	"github.com/cometbft/cometbft/internal/rpctrace"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+)
+
+type abciQueryServiceServer struct {
+	proxyApp proxy.AppConnQuery
+	logger   log.Logger
+}
+
+// New creates a new CometBFT ABCI query service server.
+func New(proxyApp proxy.AppConnQuery, logger log.Logger) abciquerysvc.ABCIQueryServiceServer {
+	return &abciQueryServiceServer{
+		proxyApp: proxyApp,
+		logger:   logger.With("service", "ABCIQueryService"),
+	}
+}
+
+// Query implements v1.ABCIQueryServiceServer Query method.
+func (s *abciQueryServiceServer) Query(ctx context.Context, req *abciquerysvc.QueryRequest) (*abciquerysvc.QueryResponse, error) {
+	logger := s.logger.With("endpoint", "Query")
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Height cannot be negative")
+	}
+
+	res, err := s.proxyApp.Query(ctx, &abci.QueryRequest{
+		Path:   req.Path,
+		Data:   req.Data,
+		Height: req.Height,
+		Prove:  req.Prove,
+	})
+	if err != nil {
+		traceID, _ := rpctrace.New()
+		logger.Error("Error querying the application", "err", err, "traceID", traceID)
+		return nil, status.Errorf(codes.Internal, "Failed to query the application (see logs for trace ID: %s)", traceID)
+	}
+	return &abciquerysvc.QueryResponse{Response: res}, nil
+}
@@ -75,6 +75,34 @@ func (s *blockServiceServer) getBlock(height int64, logger log.Logger) (*ptypes.
 	return &blockIDProto, bp, nil
 }
 
+// GetSignedHeader implements v1.BlockServiceServer GetSignedHeader method. The
+// commit of a block is only stored in the next block, hence the latest block
+// has no signed header yet.
+func (s *blockServiceServer) GetSignedHeader(_ context.Context, req *blocksvc.GetSignedHeaderRequest) (*blocksvc.GetSignedHeaderResponse, error) {
+	latestHeight := s.store.Height()
+	if err := validateBlockHeight(req.Height, s.store.Base(), latestHeight); err != nil {
+		return nil, err
+	}
+	if req.Height == latestHeight {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Requested height %d is higher than latest height %d with a signed header", req.Height, latestHeight-1)
+	}
+
+	blockMeta := s.store.LoadBlockMeta(req.Height)
+	if blockMeta == nil {
+		return nil, status.Errorf(codes.NotFound, "Block meta not found for height %d", req.Height)
+	}
+	commit := s.store.LoadBlockCommit(req.Height)
+	if commit == nil {
+		return nil, status.Errorf(codes.NotFound, "Commit not found for height %d", req.Height)
+	}
+	sh := types.SignedHeader{Header: &blockMeta.Header, Commit: commit}
+
+	return &blocksvc.GetSignedHeaderResponse{
+		SignedHeader: sh.ToProto(),
+	}, nil
+}
+
 // GetLatestHeight implements v1.BlockServiceServer GetLatestHeight method.
 func (s *blockServiceServer) GetLatestHeight(_ *blocksvc.GetLatestHeightRequest, stream blocksvc.BlockService_GetLatestHeightServer) error {
 	logger := s.logger.With("endpoint", "GetLatestHeight")
@@ -0,0 +1,66 @@
+package consensusparamsservice
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	paramssvc "github.com/cometbft/cometbft/api/cometbft/services/consensus_params/v1"
+	"github.com/cometbft/cometbft/internal/rpctrace"
+	"github.com/cometbft/cometbft/libs/log"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+)
+
+type consensusParamsServiceServer struct {
+	blockStore *store.BlockStore
+	stateStore sm.Store
+	logger     log.Logger
+}
+
+// New creates a new CometBFT consensus parameters service server.
+func New(bs *store.BlockStore, ss sm.Store, logger log.Logger) paramssvc.ConsensusParamsServiceServer {
+	return &consensusParamsServiceServer{
+		blockStore: bs,
+		stateStore: ss,
+		logger:     logger.With("service", "ConsensusParamsService"),
+	}
+}
+
+// GetByHeight implements v1.ConsensusParamsServiceServer GetByHeight method.
+func (s *consensusParamsServiceServer) GetByHeight(_ context.Context, req *paramssvc.GetByHeightRequest) (*paramssvc.GetByHeightResponse, error) {
+	logger := s.logger.With("endpoint", "GetByHeight")
+
+	height := req.Height
+	latestHeight := s.blockStore.Height()
+	if height == 0 {
+		height = latestHeight
+	}
+	switch {
+	case height < 0:
+		return nil, status.Error(codes.InvalidArgument, "Height cannot be negative")
+	case height < s.blockStore.Base():
+		return nil, status.Errorf(codes.InvalidArgument, "Requested height %d is below base height %d", height, s.blockStore.Base())
+	case height > latestHeight:
+		return nil, status.Errorf(codes.InvalidArgument, "Requested height %d is higher than latest height %d", height, latestHeight)
+	}
+
+	traceID, err := rpctrace.New()
+	if err != nil {
+		logger.Error("Error generating RPC trace ID", "err", err)
+		return nil, status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+
+	params, err := s.stateStore.LoadConsensusParams(height)
+	if err != nil {
+		logger.Error("Error loading consensus parameters", "height", height, "err", err, "traceID", traceID)
+		return nil, status.Errorf(codes.NotFound, "Consensus parameters not found for height %d", height)
+	}
+	pparams := params.ToProto()
+
+	return &paramssvc.GetByHeightResponse{
+		Height:          height,
+		ConsensusParams: &pparams,
+	}, nil
+}
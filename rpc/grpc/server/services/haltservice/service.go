@@ -0,0 +1,58 @@
+package haltservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbsvc "github.com/cometbft/cometbft/api/cometbft/services/halt/v1"
+	cs "github.com/cometbft/cometbft/internal/consensus"
+	"github.com/cometbft/cometbft/internal/rpctrace"
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+type haltServiceServer struct {
+	consensusState *cs.State
+	logger         log.Logger
+}
+
+// New creates a new CometBFT halt service server.
+func New(consensusState *cs.State, logger log.Logger) pbsvc.HaltServiceServer {
+	return &haltServiceServer{
+		consensusState: consensusState,
+		logger:         logger.With("service", "HaltService"),
+	}
+}
+
+// SetHaltHeight implements v1.HaltServiceServer.
+func (s *haltServiceServer) SetHaltHeight(_ context.Context, req *pbsvc.SetHaltHeightRequest) (*pbsvc.SetHaltHeightResponse, error) {
+	height := req.Height
+	// Because we can't agree on a single type to represent block height.
+	if height > uint64(math.MaxInt64) {
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid height %d", height))
+	}
+	logger := s.logger.With("endpoint", "SetHaltHeight")
+	traceID, err := rpctrace.New()
+	if err != nil {
+		logger.Error("Error generating RPC trace ID", "err", err)
+		return nil, status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+	if err := s.consensusState.SetHaltHeight(int64(height)); err != nil {
+		if errors.As(err, &cs.ErrHaltHeightCommitted{}) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		logger.Error("Cannot set halt height", "err", err, "traceID", traceID)
+		return nil, status.Errorf(codes.Internal, "Failed to set halt height (see logs for trace ID: %s)", traceID)
+	}
+	logger.Info("Halt height set", "height", height, "traceID", traceID)
+	return &pbsvc.SetHaltHeightResponse{}, nil
+}
+
+// GetHaltHeight implements v1.HaltServiceServer.
+func (s *haltServiceServer) GetHaltHeight(_ context.Context, _ *pbsvc.GetHaltHeightRequest) (*pbsvc.GetHaltHeightResponse, error) {
+	return &pbsvc.GetHaltHeightResponse{Height: uint64(s.consensusState.GetHaltHeight())}, nil
+}
@@ -0,0 +1,132 @@
+package validatorsetservice
+
+import (
+	"bytes"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	valsetsvc "github.com/cometbft/cometbft/api/cometbft/services/validator_set/v1"
+	"github.com/cometbft/cometbft/internal/rpctrace"
+	"github.com/cometbft/cometbft/libs/log"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+)
+
+// maxChangesRange is the maximum number of heights scanned by a GetChanges
+// request.
+const maxChangesRange = 10000
+
+type validatorSetServiceServer struct {
+	blockStore *store.BlockStore
+	stateStore sm.Store
+	logger     log.Logger
+}
+
+// New creates a new CometBFT validator set service server.
+func New(bs *store.BlockStore, ss sm.Store, logger log.Logger) valsetsvc.ValidatorSetServiceServer {
+	return &validatorSetServiceServer{
+		blockStore: bs,
+		stateStore: ss,
+		logger:     logger.With("service", "ValidatorSetService"),
+	}
+}
+
+// GetByHeight implements v1.ValidatorSetServiceServer GetByHeight method.
+func (s *validatorSetServiceServer) GetByHeight(_ context.Context, req *valsetsvc.GetByHeightRequest) (*valsetsvc.GetByHeightResponse, error) {
+	logger := s.logger.With("endpoint", "GetByHeight")
+
+	height := req.Height
+	latestHeight := s.blockStore.Height()
+	if height == 0 {
+		height = latestHeight
+	}
+	if err := validateHeight(height, s.blockStore.Base(), latestHeight); err != nil {
+		return nil, err
+	}
+
+	traceID, err := rpctrace.New()
+	if err != nil {
+		logger.Error("Error generating RPC trace ID", "err", err)
+		return nil, status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+
+	vals, err := s.stateStore.LoadValidators(height)
+	if err != nil {
+		logger.Error("Error loading validator set", "height", height, "err", err, "traceID", traceID)
+		return nil, status.Errorf(codes.NotFound, "Validator set not found for height %d", height)
+	}
+	pvals, err := vals.ToProto()
+	if err != nil {
+		logger.Error("Error attempting to convert validator set to its Protobuf representation",
+			"err", err, "traceID", traceID)
+		return nil, status.Errorf(codes.Internal, "Failed to load validator set (see logs for trace ID: %s)", traceID)
+	}
+
+	return &valsetsvc.GetByHeightResponse{
+		Height:       height,
+		ValidatorSet: pvals,
+	}, nil
+}
+
+// GetChanges implements v1.ValidatorSetServiceServer GetChanges method. The
+// changes are computed from the headers of the block store: the validator set
+// changes at height h if the validators hash of h-1 differs from its next
+// validators hash.
+func (s *validatorSetServiceServer) GetChanges(_ context.Context, req *valsetsvc.GetChangesRequest) (*valsetsvc.GetChangesResponse, error) {
+	logger := s.logger.With("endpoint", "GetChanges")
+
+	from, to := req.FromHeight, req.ToHeight
+	latestHeight := s.blockStore.Height()
+	if to == 0 {
+		to = latestHeight
+	}
+	if from <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "From height must be positive")
+	}
+	if from > to {
+		return nil, status.Errorf(codes.InvalidArgument, "From height %d is higher than to height %d", from, to)
+	}
+	if err := validateHeight(to, s.blockStore.Base(), latestHeight); err != nil {
+		return nil, err
+	}
+
+	// The first height has no previous validator set to differ from.
+	start := max(from, 2)
+	last := min(to, start+maxChangesRange-1)
+	if start <= last {
+		if err := validateHeight(start-1, s.blockStore.Base(), latestHeight); err != nil {
+			return nil, err
+		}
+	}
+
+	heights := make([]int64, 0)
+	for h := start; h <= last; h++ {
+		meta := s.blockStore.LoadBlockMeta(h - 1)
+		if meta == nil {
+			logger.Error("Block meta not found", "height", h-1)
+			return nil, status.Errorf(codes.NotFound, "Block meta not found for height %d", h-1)
+		}
+		if !bytes.Equal(meta.Header.ValidatorsHash, meta.Header.NextValidatorsHash) {
+			heights = append(heights, h)
+		}
+	}
+
+	return &valsetsvc.GetChangesResponse{
+		Heights:    heights,
+		LastHeight: last,
+	}, nil
+}
+
+func validateHeight(height, baseHeight, latestHeight int64) error {
+	switch {
+	case height < 0:
+		return status.Error(codes.InvalidArgument, "Height cannot be negative")
+	case height < baseHeight:
+		return status.Errorf(codes.InvalidArgument, "Requested height %d is below base height %d", height, baseHeight)
+	case height > latestHeight:
+		return status.Errorf(codes.InvalidArgument, "Requested height %d is higher than latest height %d", height, latestHeight)
+	}
+	return nil
+}
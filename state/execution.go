@@ -699,6 +699,7 @@ func updateState(
 		LastResultsHash:                  TxResultsHash(abciResponse.TxResults),
 		AppHash:                          nil,
 		NextBlockDelay:                   abciResponse.NextBlockDelay,
+		ProposerSeed:                     header.LastCommitHash,
 	}, nil
 }
 
@@ -75,6 +75,7 @@ func TestApplyBlock(t *testing.T) {
 
 	// TODO check state and mempool
 	assert.EqualValues(t, 1, state.Version.Consensus.App, "App version wasn't updated")
+	assert.Equal(t, []byte(block.LastCommitHash), state.ProposerSeed)
 }
 
 // TestFinalizeBlockDecidedLastCommit ensures we correctly send the
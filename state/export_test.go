@@ -3,6 +3,7 @@ package state
 import (
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -47,7 +48,7 @@ func SaveValidatorsInfo(db dbm.DB, height, lastHeightChanged int64, valSet *type
 	case "v2":
 		keyLayout = v2Layout{}
 	}
-	stateStore := dbStore{db, keyLayout, StoreOptions{DiscardABCIResponses: false, Metrics: NopMetrics()}}
+	stateStore := dbStore{db, keyLayout, new(cmtsync.Mutex), StoreOptions{DiscardABCIResponses: false, Metrics: NopMetrics()}}
 	batch := stateStore.db.NewBatch()
 	err := stateStore.saveValidatorsInfo(height, lastHeightChanged, valSet, batch)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	blockidxnull "github.com/cometbft/cometbft/state/indexer/block/null"
 	"github.com/cometbft/cometbft/state/indexer/sink/psql"
+	"github.com/cometbft/cometbft/state/indexer/sink/sqlite"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/state/txindex/kv"
 	"github.com/cometbft/cometbft/state/txindex/null"
@@ -40,6 +41,13 @@ func IndexerFromConfig(cfg *config.Config, dbProvider config.DBProvider, chainID
 		}
 		return es.TxIndexer(), es.BlockIndexer(), nil
 
+	case "sqlite":
+		es, err := sqlite.NewEventSink(cfg.TxIndex.SQLiteFile(), chainID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("creating sqlite indexer: %w", err)
+		}
+		return es.TxIndexer(), es.BlockIndexer(), nil
+
 	default:
 		return &null.TxIndex{}, &blockidxnull.BlockerIndexer{}, nil
 	}
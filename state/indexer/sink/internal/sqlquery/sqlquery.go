@@ -0,0 +1,290 @@
+// Package sqlquery implements the searches of the SQL event sinks, by
+// translating pubsub queries into statements against the schema they share,
+// defined in state/indexer/sink/psql/schema.sql.
+//
+// The statements only use SQL understood by all the supported databases. The
+// placeholders of arguments and the comparison of attribute values, which
+// cannot be expressed portably, are delegated to a Dialect.
+package sqlquery
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Operators maps the comparison operators of the query language to their SQL
+// counterparts.
+var Operators = map[syntax.Token]string{
+	syntax.TEq:  "=",
+	syntax.TLt:  "<",
+	syntax.TLeq: "<=",
+	syntax.TGt:  ">",
+	syntax.TGeq: ">=",
+}
+
+// Dialect captures the differences between the databases supported by the SQL
+// event sinks.
+type Dialect interface {
+	// Placeholder returns the placeholder of the nth argument (1-based) of a
+	// statement.
+	Placeholder(n int) string
+
+	// ValuePredicate translates the comparison of c into a SQL condition on
+	// the value of an attribute, ea.value. Arguments of the condition must be
+	// added with b.Arg.
+	ValuePredicate(b *Builder, c syntax.Condition) (string, error)
+}
+
+// Builder collects the arguments of a SQL statement translated from a pubsub
+// query.
+type Builder struct {
+	dialect Dialect
+	args    []any
+}
+
+// NewBuilder returns a builder of statements for the given dialect.
+func NewBuilder(d Dialect) *Builder {
+	return &Builder{dialect: d}
+}
+
+// Arg adds v to the arguments of the statement, and returns its placeholder.
+func (b *Builder) Arg(v any) string {
+	b.args = append(b.args, v)
+	return b.dialect.Placeholder(len(b.args))
+}
+
+// Conditions translates conds into SQL conditions on a candidate row, i.e. a
+// block or a transaction result.
+//
+// Conditions comparing heightKey to a number are checked against
+// heightColumn, and equality conditions on the transaction hash against
+// hashColumn, if not empty. As with the kv indexer, all the other conditions
+// must be matched by the same event of the candidate, selected by eventFilter
+// from the events table aliased as ev.
+func (b *Builder) Conditions(conds []syntax.Condition, heightKey, heightColumn, hashColumn, eventFilter string) ([]string, error) {
+	var rowConds, eventConds []string
+	for _, c := range conds {
+		if c.Op != syntax.TExists && c.Arg == nil {
+			return nil, fmt.Errorf("missing argument for %v", c.Op)
+		}
+
+		switch {
+		case c.Op == syntax.TExists:
+			// As in the pubsub query package, a tag naming an event type
+			// matches the events of that type.
+			tag := b.Arg(c.Tag)
+			eventConds = append(eventConds, `(ev.type = `+tag+` OR `+attributeCondition(tag, "")+`)`)
+
+		case c.Tag == heightKey && c.Arg.Type == syntax.TNumber:
+			op, ok := Operators[c.Op]
+			if !ok {
+				return nil, fmt.Errorf("invalid op/arg combination (%v, %v)", c.Op, c.Arg.Type)
+			}
+			num, err := NumberArg(c.Arg)
+			if err != nil {
+				return nil, err
+			}
+			rowConds = append(rowConds, heightColumn+` `+op+` CAST(`+b.Arg(num)+` AS NUMERIC)`)
+
+		case c.Tag == types.TxHashKey && hashColumn != "" && c.Op == syntax.TEq && c.Arg.Type == syntax.TString:
+			rowConds = append(rowConds, hashColumn+` = upper(`+b.Arg(c.Arg.Value())+`)`)
+
+		default:
+			tag := b.Arg(c.Tag)
+			pred, err := b.dialect.ValuePredicate(b, c)
+			if err != nil {
+				return nil, err
+			}
+			eventConds = append(eventConds, attributeCondition(tag, pred))
+		}
+	}
+
+	if len(eventConds) > 0 {
+		rowConds = append(rowConds, `EXISTS (SELECT 1 FROM events ev WHERE `+eventFilter+
+			` AND `+strings.Join(eventConds, ` AND `)+`)`)
+	}
+	return rowConds, nil
+}
+
+// attributeCondition returns a SQL condition on the event ev, matched by any
+// of its attributes, aliased as ea, with the composite key tag and satisfying
+// pred, if not empty.
+func attributeCondition(tag, pred string) string {
+	cond := `EXISTS (SELECT 1 FROM attributes ea WHERE ea.event_id = ev.rowid AND ea.composite_key = ` + tag
+	if pred != "" {
+		cond += ` AND ` + pred
+	}
+	return cond + `)`
+}
+
+// SearchBlocks returns the heights of the blocks of chainID in db matching q,
+// in ascending order.
+func SearchBlocks(ctx context.Context, db *sql.DB, d Dialect, q *query.Query, chainID string) ([]int64, error) {
+	b := NewBuilder(d)
+	conds := []string{`blocks.chain_id = ` + b.Arg(chainID)}
+	queryConds, err := b.Conditions(q.Syntax(), types.BlockHeightKey, "blocks.height", "",
+		`ev.block_id = blocks.rowid AND ev.tx_id IS NULL`)
+	if err != nil {
+		return nil, err
+	}
+	conds = append(conds, queryConds...)
+
+	rows, err := db.QueryContext(ctx, `
+SELECT blocks.height FROM blocks
+  WHERE `+strings.Join(conds, " AND ")+`
+  ORDER BY blocks.height;
+`, b.args...)
+	if err != nil {
+		return nil, fmt.Errorf("searching block events: %w", err)
+	}
+	defer rows.Close()
+
+	var heights []int64
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, fmt.Errorf("scanning block height: %w", err)
+		}
+		heights = append(heights, height)
+	}
+	return heights, rows.Err()
+}
+
+// SearchTxs returns the page of the results of the transactions of chainID in
+// db matching q selected by pagSettings, ordered by height and index, and the
+// total number of matching transactions.
+func SearchTxs(
+	ctx context.Context,
+	db *sql.DB,
+	d Dialect,
+	q *query.Query,
+	chainID string,
+	pagSettings txindex.Pagination,
+) ([]*abci.TxResult, int, error) {
+	b := NewBuilder(d)
+	conds := []string{`blocks.chain_id = ` + b.Arg(chainID)}
+	queryConds, err := b.Conditions(q.Syntax(), types.TxHeightKey, "blocks.height", "tx_results.tx_hash",
+		`ev.tx_id = tx_results.rowid`)
+	if err != nil {
+		return nil, 0, err
+	}
+	conds = append(conds, queryConds...)
+	from := `
+  FROM tx_results JOIN blocks ON (blocks.rowid = tx_results.block_id)
+  WHERE ` + strings.Join(conds, " AND ")
+
+	var total int
+	if err := db.QueryRowContext(ctx, `SELECT count(*)`+from+`;`, b.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("counting tx events: %w", err)
+	}
+
+	order := "ASC"
+	if pagSettings.OrderDesc {
+		order = "DESC"
+	}
+	stmt := `SELECT tx_results.tx_result` + from + `
+  ORDER BY blocks.height ` + order + `, tx_results."index" ` + order
+	if pagSettings.IsPaginated {
+		page, err := ValidatePage(pagSettings.Page, pagSettings.PerPage, total)
+		if err != nil {
+			return nil, 0, err
+		}
+		stmt += `
+  LIMIT ` + b.Arg(pagSettings.PerPage) + ` OFFSET ` + b.Arg((page-1)*pagSettings.PerPage)
+	}
+
+	rows, err := db.QueryContext(ctx, stmt+`;`, b.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("searching tx events: %w", err)
+	}
+	defer rows.Close()
+
+	var txrs []*abci.TxResult
+	for rows.Next() {
+		var resultData []byte
+		if err := rows.Scan(&resultData); err != nil {
+			return nil, 0, fmt.Errorf("scanning tx_result: %w", err)
+		}
+		txr := new(abci.TxResult)
+		if err := proto.Unmarshal(resultData, txr); err != nil {
+			return nil, 0, fmt.Errorf("unmarshaling tx_result: %w", err)
+		}
+		txrs = append(txrs, txr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return txrs, total, nil
+}
+
+// GetTx returns the result of the transaction of chainID in db with the given
+// hash, or nil if it is not indexed.
+func GetTx(db *sql.DB, d Dialect, hash []byte, chainID string) (*abci.TxResult, error) {
+	if len(hash) == 0 {
+		return nil, txindex.ErrorEmptyHash
+	}
+	var resultData []byte
+	err := db.QueryRow(`
+SELECT tx_result FROM tx_results JOIN blocks ON (blocks.rowid = tx_results.block_id)
+  WHERE tx_hash = `+d.Placeholder(1)+` AND chain_id = `+d.Placeholder(2)+`;
+`, fmt.Sprintf("%X", hash), chainID).Scan(&resultData)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("looking up tx_result: %w", err)
+	}
+
+	txr := new(abci.TxResult)
+	if err := proto.Unmarshal(resultData, txr); err != nil {
+		return nil, fmt.Errorf("unmarshaling tx_result: %w", err)
+	}
+	return txr, nil
+}
+
+// HasBlock reports whether the events of the block of chainID at the given
+// height are indexed in db.
+func HasBlock(db *sql.DB, d Dialect, height int64, chainID string) (bool, error) {
+	var exists bool
+	if err := db.QueryRow(`
+SELECT EXISTS(SELECT 1 FROM blocks WHERE height = `+d.Placeholder(1)+` AND chain_id = `+d.Placeholder(2)+`);
+`, height, chainID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("looking up block: %w", err)
+	}
+	return exists, nil
+}
+
+// NumberArg returns the decimal representation of the number argument a.
+func NumberArg(a *syntax.Arg) (string, error) {
+	num := a.Number()
+	if num == nil {
+		return "", fmt.Errorf("invalid number %q", a.Value())
+	}
+	return num.Text('f', -1), nil
+}
+
+// ValidatePage returns page if it is within the pages of totalCount results,
+// or an error.
+func ValidatePage(page, perPage, totalCount int) (int, error) {
+	if perPage < 1 {
+		return 1, fmt.Errorf("zero or negative perPage: %d", perPage)
+	}
+	pages := ((totalCount - 1) / perPage) + 1
+	if pages == 0 {
+		pages = 1 // one page (even if it's empty)
+	}
+	if page <= 0 || page > pages {
+		return 1, fmt.Errorf("page should be within [1, %d] range, given %d", pages, page)
+	}
+	return page, nil
+}
@@ -15,7 +15,6 @@ package psql
 
 import (
 	"context"
-	"errors"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
@@ -56,16 +55,16 @@ func (b BackportTxIndexer) Index(txr *abci.TxResult) error {
 	return b.psql.IndexTxEvents([]*abci.TxResult{txr})
 }
 
-// Get is implemented to satisfy the TxIndexer interface, but is not supported
-// by the psql event sink and reports an error for all inputs.
-func (BackportTxIndexer) Get([]byte) (*abci.TxResult, error) {
-	return nil, errors.New("the TxIndexer.Get method is not supported")
+// Get looks up the transaction result with the given hash in Postgres, as
+// part of TxIndexer.
+func (b BackportTxIndexer) Get(hash []byte) (*abci.TxResult, error) {
+	return b.psql.GetTxByHash(hash)
 }
 
-// Search is implemented to satisfy the TxIndexer interface, but it is not
-// supported by the psql event sink and reports an error for all inputs.
-func (BackportTxIndexer) Search(context.Context, *query.Query, txindex.Pagination) ([]*abci.TxResult, int, error) {
-	return nil, 0, errors.New("the TxIndexer.Search method is not supported")
+// Search returns the page of the transaction results matching q selected by
+// pagSettings, and the total number of matches, as part of TxIndexer.
+func (b BackportTxIndexer) Search(ctx context.Context, q *query.Query, pagSettings txindex.Pagination) ([]*abci.TxResult, int, error) {
+	return b.psql.searchTxEvents(ctx, q, pagSettings)
 }
 
 func (BackportTxIndexer) SetLogger(log.Logger) {}
@@ -93,10 +92,10 @@ func (BackportBlockIndexer) Prune(_ int64) (numPruned, newRetainHeight int64, er
 	return 0, 0, nil
 }
 
-// Has is implemented to satisfy the BlockIndexer interface, but it is not
-// supported by the psql event sink and reports an error for all inputs.
-func (BackportBlockIndexer) Has(_ int64) (bool, error) {
-	return false, errors.New("the BlockIndexer.Has method is not supported")
+// Has reports whether the events of the block at height are indexed in
+// Postgres. It is part of the BlockIndexer interface.
+func (b BackportBlockIndexer) Has(height int64) (bool, error) {
+	return b.psql.HasBlock(height)
 }
 
 // Index indexes block begin and end events for the specified block.  It is
@@ -105,10 +104,10 @@ func (b BackportBlockIndexer) Index(block types.EventDataNewBlockEvents) error {
 	return b.psql.IndexBlockEvents(block)
 }
 
-// Search is implemented to satisfy the BlockIndexer interface, but it is not
-// supported by the psql event sink and reports an error for all inputs.
-func (BackportBlockIndexer) Search(context.Context, *query.Query) ([]int64, error) {
-	return nil, errors.New("the BlockIndexer.Search method is not supported")
+// Search returns the heights of the blocks matching q. It is part of the
+// BlockIndexer interface.
+func (b BackportBlockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	return b.psql.SearchBlockEvents(ctx, q)
 }
 
 func (BackportBlockIndexer) SetLogger(log.Logger) {}
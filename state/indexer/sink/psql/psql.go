@@ -4,6 +4,7 @@ package psql
 import (
 	"context"
 	"database/sql"
+	_ "embed"
 	"errors"
 	"fmt"
 	"strconv"
@@ -16,6 +17,8 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/internal/rand"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer/sink/internal/sqlquery"
+	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -27,6 +30,12 @@ const (
 	driverName      = "postgres"
 )
 
+// Schema is the database schema of the event sink, defined in
+// state/indexer/sink/psql/schema.sql.
+//
+//go:embed schema.sql
+var Schema string
+
 // EventSink is an indexer backend providing the tx/block index services.  This
 // implementation stores records in a PostgreSQL database using the schema
 // defined in state/indexer/sink/psql/schema.sql.
@@ -251,24 +260,39 @@ func (es *EventSink) IndexTxEvents(txrs []*abci.TxResult) error {
 	return nil
 }
 
-// SearchBlockEvents is not implemented by this sink, and reports an error for all queries.
-func (*EventSink) SearchBlockEvents(_ context.Context, _ *query.Query) ([]int64, error) {
-	return nil, errors.New("block search is not supported via the postgres event sink")
+// SearchBlockEvents returns the heights of the blocks matching q, in
+// ascending order, part of the indexer.EventSink interface.
+func (es *EventSink) SearchBlockEvents(ctx context.Context, q *query.Query) ([]int64, error) {
+	return sqlquery.SearchBlocks(ctx, es.store, dialect{}, q, es.chainID)
+}
+
+// SearchTxEvents returns the results of the transactions matching q, ordered
+// by height and index, part of the indexer.EventSink interface.
+func (es *EventSink) SearchTxEvents(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	txrs, _, err := es.searchTxEvents(ctx, q, txindex.Pagination{})
+	return txrs, err
 }
 
-// SearchTxEvents is not implemented by this sink, and reports an error for all queries.
-func (*EventSink) SearchTxEvents(_ context.Context, _ *query.Query) ([]*abci.TxResult, error) {
-	return nil, errors.New("tx search is not supported via the postgres event sink")
+// searchTxEvents returns the page of the results of the transactions matching
+// q selected by pagSettings, and the total number of matching transactions.
+func (es *EventSink) searchTxEvents(
+	ctx context.Context,
+	q *query.Query,
+	pagSettings txindex.Pagination,
+) ([]*abci.TxResult, int, error) {
+	return sqlquery.SearchTxs(ctx, es.store, dialect{}, q, es.chainID, pagSettings)
 }
 
-// GetTxByHash is not implemented by this sink, and reports an error for all queries.
-func (*EventSink) GetTxByHash(_ []byte) (*abci.TxResult, error) {
-	return nil, errors.New("getTxByHash is not supported via the postgres event sink")
+// GetTxByHash returns the result of the transaction with the given hash, or
+// nil if it is not indexed, part of the indexer.EventSink interface.
+func (es *EventSink) GetTxByHash(hash []byte) (*abci.TxResult, error) {
+	return sqlquery.GetTx(es.store, dialect{}, hash, es.chainID)
 }
 
-// HasBlock is not implemented by this sink, and reports an error for all queries.
-func (*EventSink) HasBlock(_ int64) (bool, error) {
-	return false, errors.New("hasBlock is not supported via the postgres event sink")
+// HasBlock reports whether the events of the block at the given height are
+// indexed, part of the indexer.EventSink interface.
+func (es *EventSink) HasBlock(height int64) (bool, error) {
+	return sqlquery.HasBlock(es.store, dialect{}, height, es.chainID)
 }
 
 // Stop closes the underlying PostgreSQL database.
@@ -9,6 +9,7 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"strings"
 	"testing"
 	"time"
 
@@ -21,9 +22,13 @@ import (
 
 	_ "github.com/lib/pq"
 
+	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/state/txindex/kv"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -148,13 +153,29 @@ func TestIndexing(t *testing.T) {
 		verifyBlock(t, 1)
 		verifyBlock(t, 2)
 
-		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(1) })
-		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(2) })
-
-		verifyNotImplemented(t, "block search", func() (bool, error) {
-			v, err := indexer.SearchBlockEvents(context.Background(), nil)
-			return v != nil, err
-		})
+		ok, err := indexer.HasBlock(1)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		ok, err = indexer.HasBlock(2)
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		for q, want := range map[string][]int64{
+			"block.height = 1":                      {1},
+			"block.height > 1":                      nil,
+			"end_event.foo > 50":                    {1},
+			"end_event.foo <= 50":                   nil,
+			"thingy.whatzit = 'O.O'":                {1},
+			"thingy.whatzit CONTAINS '-.'":          {1},
+			"thingy.whatzit CONTAINS 'X'":           nil,
+			"thingy EXISTS":                         {1},
+			"begin_event.proposer EXISTS":           {1},
+			"end_event.foo = 100 AND thingy EXISTS": nil,
+		} {
+			heights, err := indexer.SearchBlockEvents(context.Background(), query.MustCompile(q))
+			require.NoError(t, err, q)
+			assert.Equal(t, want, heights, q)
+		}
 
 		require.NoError(t, verifyTimeStamp(tableBlocks))
 
@@ -187,14 +208,40 @@ func TestIndexing(t *testing.T) {
 		require.NoError(t, verifyTimeStamp(tableTxResults))
 		require.NoError(t, verifyTimeStamp(viewTxEvents))
 
-		verifyNotImplemented(t, "getTxByHash", func() (bool, error) {
-			txr, err := indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
-			return txr != nil, err
-		})
-		verifyNotImplemented(t, "tx search", func() (bool, error) {
-			txr, err := indexer.SearchTxEvents(context.Background(), nil)
-			return txr != nil, err
-		})
+		txr, err = indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, txResult, txr)
+		txr, err = indexer.GetTxByHash(types.Tx("unknown").Hash())
+		require.NoError(t, err)
+		assert.Nil(t, txr)
+
+		hash := fmt.Sprintf("%x", types.Tx(txResult.Tx).Hash())
+		for q, want := range map[string]int{
+			"tx.height = 1":                               1,
+			"tx.height > 1":                               0,
+			"tx.hash = '" + hash + "'":                    1,
+			"account.owner = 'Ivan'":                      1,
+			"account.owner = 'Vlad'":                      0,
+			"account.owner CONTAINS 'Yul'":                1,
+			"account.number >= 1 AND account.number < 2":  1,
+			"account.number > 1":                          0,
+			"account EXISTS":                              1,
+			"account.balance EXISTS":                      0,
+			"tx.height = 1 AND account.owner = 'Yulieta'": 1,
+		} {
+			txrs, err := indexer.SearchTxEvents(context.Background(), query.MustCompile(q))
+			require.NoError(t, err, q)
+			assert.Len(t, txrs, want, q)
+		}
+
+		txrs, total, err := indexer.TxIndexer().Search(context.Background(), query.MustCompile("tx.height = 1"),
+			txindex.Pagination{IsPaginated: true, Page: 1, PerPage: 10})
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, []*abci.TxResult{txResult}, txrs)
+		_, _, err = indexer.TxIndexer().Search(context.Background(), query.MustCompile("tx.height = 1"),
+			txindex.Pagination{IsPaginated: true, Page: 2, PerPage: 10})
+		require.Error(t, err)
 
 		// try to insert the duplicate tx events.
 		err = indexer.IndexTxEvents([]*abci.TxResult{txResult})
@@ -259,6 +306,92 @@ func TestStop(t *testing.T) {
 
 // newTestBlock constructs a fresh copy of a new block event containing
 // known test values to exercise the indexer.
+// TestSearchLikeKV checks the searches match the same events as the kv
+// indexers, which require all the conditions but the height and hash ones to
+// be matched by the same event.
+func TestSearchLikeKV(t *testing.T) {
+	// Another chain, not to mix the events with the ones of the other tests.
+	indexer := &EventSink{store: testDB(), chainID: "test-kv-chainID"}
+
+	transfer := func(sender, amount string) abci.Event {
+		return abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{
+			{Key: "sender", Value: sender, Index: true},
+			{Key: "amount", Value: amount, Index: true},
+		}}
+	}
+	txResults := []*abci.TxResult{
+		{Height: 1, Tx: types.Tx("tx1"), Result: abci.ExecTxResult{Events: []abci.Event{
+			transfer("bob", "10"),
+			transfer("tom", "20"),
+			{Type: "message", Attributes: []abci.EventAttribute{{Key: "action", Value: "send", Index: true}}},
+		}}},
+		{Height: 2, Tx: types.Tx("tx2"), Result: abci.ExecTxResult{Events: []abci.Event{
+			transfer("bob", "20"),
+		}}},
+	}
+	blockEvents := []types.EventDataNewBlockEvents{
+		{Height: 1, Events: []abci.Event{transfer("bob", "10"), transfer("tom", "20")}},
+		{Height: 2, Events: []abci.Event{transfer("bob", "20")}},
+	}
+
+	kvStore := dbm.NewMemDB()
+	kvTxIndexer := kv.NewTxIndex(kvStore)
+	kvBlockIndexer := blockidxkv.New(dbm.NewPrefixDB(kvStore, []byte("block_events")))
+	for i, e := range blockEvents {
+		require.NoError(t, indexer.IndexBlockEvents(e))
+		require.NoError(t, indexer.IndexTxEvents(txResults[i:i+1]))
+		require.NoError(t, kvBlockIndexer.Index(e))
+		batch := txindex.NewBatch(1)
+		require.NoError(t, batch.Add(txResults[i]))
+		require.NoError(t, kvTxIndexer.AddBatch(batch))
+	}
+
+	for q, want := range map[string][]int64{
+		"transfer.sender = 'bob'":                                            {1, 2},
+		"transfer.sender = 'bob' AND transfer.amount = 10":                   {1},
+		"transfer.sender = 'bob' AND transfer.amount = 20":                   {2},
+		"transfer.sender = 'tom' AND transfer.amount > 15":                   {1},
+		"transfer.sender = 'bob' AND message.action = 'send'":                nil,
+		"transfer.sender EXISTS AND transfer.amount < 15":                    {1},
+		"tx.height = 1 AND transfer.sender = 'bob' AND transfer.amount = 20": nil,
+		"tx.height > 1 AND transfer.amount = 20":                             {2},
+	} {
+		txrs, _, err := indexer.TxIndexer().Search(context.Background(), query.MustCompile(q), txindex.Pagination{})
+		require.NoError(t, err, q)
+		kvTxrs, _, err := kvTxIndexer.Search(context.Background(), query.MustCompile(q), txindex.Pagination{})
+		require.NoError(t, err, q)
+		assert.Equal(t, want, txHeights(txrs), q)
+		assert.Equal(t, want, txHeights(kvTxrs), q)
+
+		q = strings.ReplaceAll(q, types.TxHeightKey, types.BlockHeightKey)
+		if strings.Contains(q, "message") {
+			continue
+		}
+		heights, err := indexer.BlockIndexer().Search(context.Background(), query.MustCompile(q))
+		require.NoError(t, err, q)
+		kvHeights, err := kvBlockIndexer.Search(context.Background(), query.MustCompile(q))
+		require.NoError(t, err, q)
+		assert.Equal(t, want, heights, q)
+		assert.Equal(t, want, nilIfEmpty(kvHeights), q)
+	}
+}
+
+// txHeights returns the heights of txrs, or nil if it is empty.
+func txHeights(txrs []*abci.TxResult) []int64 {
+	var heights []int64
+	for _, txr := range txrs {
+		heights = append(heights, txr.Height)
+	}
+	return heights
+}
+
+func nilIfEmpty(heights []int64) []int64 {
+	if len(heights) == 0 {
+		return nil
+	}
+	return heights
+}
+
 func newTestBlockEvents() types.EventDataNewBlockEvents {
 	return types.EventDataNewBlockEvents{
 		Height: 1,
@@ -287,7 +420,7 @@ func readSchema() ([]*schema.Migration, error) {
 
 // resetDB drops all the data from the test database.
 func resetDatabase(db *sql.DB) error {
-	_, err := db.Exec(`DROP TABLE IF EXISTS blocks,tx_results,events,attributes CASCADE;`)
+	_, err := db.Exec(`DROP TABLE IF EXISTS blocks,tx_results,events,attributes,reindex_checkpoints CASCADE;`)
 	if err != nil {
 		return fmt.Errorf("dropping tables: %v", err)
 	}
@@ -361,20 +494,6 @@ SELECT type, height, chain_id FROM `+viewBlockEvents+`
 	}
 }
 
-// verifyNotImplemented calls f and verifies that it returns both a
-// false-valued flag and a non-nil error whose string matching the expected
-// "not supported" message with label prefixed.
-func verifyNotImplemented(t *testing.T, label string, f func() (bool, error)) {
-	t.Helper()
-	t.Logf("Verifying that %q reports it is not implemented", label)
-
-	want := label + " is not supported via the postgres event sink"
-	ok, err := f()
-	assert.False(t, ok)
-	require.Error(t, err)
-	assert.Equal(t, want, err.Error())
-}
-
 // waitForInterrupt blocks until a SIGINT is received by the process.
 func waitForInterrupt() {
 	ch := make(chan os.Signal, 1)
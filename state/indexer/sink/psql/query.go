@@ -0,0 +1,66 @@
+package psql
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+	"github.com/cometbft/cometbft/state/indexer/sink/internal/sqlquery"
+)
+
+// Patterns of the attribute values that can be compared to numbers, dates and
+// timestamps. Values are only cast when they match, so that a malformed value
+// does not fail the whole search. As in the pubsub query package, a number may
+// be followed by a non-numeric suffix, e.g. "100stake".
+const (
+	numberPattern = `^-?[0-9]+(?:\.[0-9]+)?`
+	datePattern   = `^[0-9]{4}-[0-9]{2}-[0-9]{2}$`
+	timePattern   = `^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}(?:\.[0-9]+)?(?:Z|[-+][0-9]{2}:[0-9]{2})$`
+)
+
+// dialect is the PostgreSQL dialect of the statements translated from pubsub
+// queries.
+type dialect struct{}
+
+var _ sqlquery.Dialect = dialect{}
+
+// Placeholder implements sqlquery.Dialect.
+func (dialect) Placeholder(n int) string {
+	return "$" + strconv.Itoa(n)
+}
+
+// ValuePredicate implements sqlquery.Dialect.
+func (dialect) ValuePredicate(b *sqlquery.Builder, c syntax.Condition) (string, error) {
+	if c.Op == syntax.TContains && c.Arg.Type == syntax.TString {
+		return `strpos(ea.value, ` + b.Arg(c.Arg.Value()) + `) > 0`, nil
+	}
+
+	op, ok := sqlquery.Operators[c.Op]
+	if !ok {
+		return "", fmt.Errorf("invalid op/arg combination (%v, %v)", c.Op, c.Arg.Type)
+	}
+	switch c.Arg.Type {
+	case syntax.TString:
+		if c.Op != syntax.TEq {
+			break
+		}
+		return `ea.value = ` + b.Arg(c.Arg.Value()), nil
+
+	case syntax.TNumber:
+		num, err := sqlquery.NumberArg(c.Arg)
+		if err != nil {
+			return "", err
+		}
+		return `substring(ea.value FROM '` + numberPattern + `')::numeric ` + op + ` ` + b.Arg(num) + `::numeric`, nil
+
+	case syntax.TDate:
+		return `(CASE WHEN ea.value ~ '` + datePattern + `' THEN ea.value::date END) ` + op + ` ` +
+			b.Arg(c.Arg.Time().Format("2006-01-02")) + `::date`, nil
+
+	case syntax.TTime:
+		return `(CASE WHEN ea.value ~ '` + timePattern + `' THEN ea.value::timestamptz END) ` + op + ` ` +
+			b.Arg(c.Arg.Time().Format(time.RFC3339Nano)) + `::timestamptz`, nil
+	}
+	return "", fmt.Errorf("invalid op/arg combination (%v, %v)", c.Op, c.Arg.Type)
+}
@@ -30,7 +30,7 @@ CREATE TABLE tx_results (
   -- The block to which this transaction belongs.
   block_id BIGINT NOT NULL REFERENCES blocks(rowid),
   -- The sequential index of the transaction within the block.
-  index INTEGER NOT NULL,
+  "index" INTEGER NOT NULL,
   -- When this result record was logged into the sink, in UTC.
   created_at TIMESTAMPTZ NOT NULL,
   -- The hex-encoded hash of the transaction.
@@ -38,7 +38,7 @@ CREATE TABLE tx_results (
   -- The protobuf wire encoding of the TxResult message.
   tx_result BYTEA NOT NULL,
 
-  UNIQUE (block_id, index)
+  UNIQUE (block_id, "index")
 );
 
 -- The events table records events. All events (both block and transaction) are
@@ -79,7 +79,17 @@ CREATE VIEW block_events AS
 
 -- A joined view of all transaction events.
 CREATE VIEW tx_events AS
-  SELECT height, index, chain_id, type, key, composite_key, value, tx_results.created_at
+  SELECT height, "index", chain_id, type, key, composite_key, value, tx_results.created_at
   FROM blocks JOIN tx_results ON (blocks.rowid = tx_results.block_id)
   JOIN event_attributes ON (tx_results.rowid = event_attributes.tx_id)
   WHERE event_attributes.tx_id IS NOT NULL;
+
+-- The reindex_checkpoints table records the progress of the reindex-event
+-- command, so that an interrupted run resumes where it stopped.
+CREATE TABLE IF NOT EXISTS reindex_checkpoints (
+  chain_id     VARCHAR NOT NULL PRIMARY KEY,
+  -- The start height of the run.
+  start_height BIGINT NOT NULL,
+  -- All the heights from start_height up to this one are re-indexed.
+  height       BIGINT NOT NULL
+);
@@ -0,0 +1,103 @@
+package sqlite
+
+// As for the psql package, the Backport* types defined here bridge the SQLite
+// EventSink to the TxIndexer and BlockIndexer interfaces used by the node.
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+// TxIndexer returns a bridge from es to the CometBFT transaction indexer.
+func (es *EventSink) TxIndexer() BackportTxIndexer {
+	return BackportTxIndexer{sqlite: es}
+}
+
+// BackportTxIndexer implements the txindex.TxIndexer interface by delegating
+// indexing operations to an underlying SQLite event sink.
+type BackportTxIndexer struct{ sqlite *EventSink }
+
+func (BackportTxIndexer) GetRetainHeight() (int64, error) {
+	return 0, nil
+}
+
+func (BackportTxIndexer) SetRetainHeight(_ int64) error {
+	return nil
+}
+
+func (BackportTxIndexer) Prune(_ int64) (numPruned, newRetainHeight int64, err error) {
+	// Not implemented
+	return 0, 0, nil
+}
+
+// AddBatch indexes a batch of transactions in SQLite, as part of TxIndexer.
+func (b BackportTxIndexer) AddBatch(batch *txindex.Batch) error {
+	return b.sqlite.IndexTxEvents(batch.Ops)
+}
+
+// Index indexes a single transaction result in SQLite, as part of TxIndexer.
+func (b BackportTxIndexer) Index(txr *abci.TxResult) error {
+	return b.sqlite.IndexTxEvents([]*abci.TxResult{txr})
+}
+
+// Get looks up the transaction result with the given hash in SQLite, as part
+// of TxIndexer.
+func (b BackportTxIndexer) Get(hash []byte) (*abci.TxResult, error) {
+	return b.sqlite.GetTxByHash(hash)
+}
+
+// Search returns the page of the transaction results matching q selected by
+// pagSettings, and the total number of matches, as part of TxIndexer.
+func (b BackportTxIndexer) Search(ctx context.Context, q *query.Query, pagSettings txindex.Pagination) ([]*abci.TxResult, int, error) {
+	return b.sqlite.searchTxEvents(ctx, q, pagSettings)
+}
+
+func (BackportTxIndexer) SetLogger(log.Logger) {}
+
+// BlockIndexer returns a bridge that implements the CometBFT block indexer
+// interface, using the SQLite event sink as a backing store.
+func (es *EventSink) BlockIndexer() BackportBlockIndexer {
+	return BackportBlockIndexer{sqlite: es}
+}
+
+// BackportBlockIndexer implements the indexer.BlockIndexer interface by
+// delegating indexing operations to an underlying SQLite event sink.
+type BackportBlockIndexer struct{ sqlite *EventSink }
+
+func (BackportBlockIndexer) SetRetainHeight(_ int64) error {
+	return nil
+}
+
+func (BackportBlockIndexer) GetRetainHeight() (int64, error) {
+	return 0, nil
+}
+
+func (BackportBlockIndexer) Prune(_ int64) (numPruned, newRetainHeight int64, err error) {
+	// Not implemented
+	return 0, 0, nil
+}
+
+// Has reports whether the events of the block at height are indexed in
+// SQLite. It is part of the BlockIndexer interface.
+func (b BackportBlockIndexer) Has(height int64) (bool, error) {
+	return b.sqlite.HasBlock(height)
+}
+
+// Index indexes block begin and end events for the specified block.  It is
+// part of the BlockIndexer interface.
+func (b BackportBlockIndexer) Index(block types.EventDataNewBlockEvents) error {
+	return b.sqlite.IndexBlockEvents(block)
+}
+
+// Search returns the heights of the blocks matching q. It is part of the
+// BlockIndexer interface.
+func (b BackportBlockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	return b.sqlite.SearchBlockEvents(ctx, q)
+}
+
+func (BackportBlockIndexer) SetLogger(log.Logger) {}
@@ -0,0 +1,11 @@
+package sqlite
+
+import (
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/txindex"
+)
+
+var (
+	_ indexer.BlockIndexer = BackportBlockIndexer{}
+	_ txindex.TxIndexer    = BackportTxIndexer{}
+)
@@ -0,0 +1,83 @@
+package sqlite
+
+import (
+	"database/sql/driver"
+	"strconv"
+	"sync"
+
+	"modernc.org/sqlite"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+	"github.com/cometbft/cometbft/state/indexer/sink/internal/sqlquery"
+)
+
+const (
+	// driverName is the name of the pure Go SQLite driver, to the connections
+	// of which matchFunc is added.
+	driverName = "sqlite"
+
+	// matchFunc is the name of the SQL function matching an attribute value
+	// against a query condition, see matchValue.
+	matchFunc = "cmt_match"
+)
+
+func init() {
+	sqlite.MustRegisterDeterministicScalarFunction(matchFunc, 2,
+		func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+			cond, _ := args[0].(string)
+			return matchValue(cond, args[1])
+		})
+}
+
+// conditions caches the queries compiled by matchValue, by condition.
+var conditions sync.Map
+
+// matchValue reports whether value satisfies cond, a single query condition on
+// the attribute holding value.
+//
+// SQLite lacks the functions needed to compare values as the pubsub query
+// package does, e.g. to parse numbers followed by a denomination. Rather than
+// approximating them in SQL, the comparison is delegated to the query package
+// itself, so that searches match exactly the events a subscription would.
+func matchValue(cond string, value any) (bool, error) {
+	s, ok := value.(string)
+	if !ok {
+		return false, nil
+	}
+	var q *query.Query
+	if cached, ok := conditions.Load(cond); ok {
+		q = cached.(*query.Query)
+	} else {
+		var err error
+		if q, err = query.New(cond); err != nil {
+			return false, err
+		}
+		conditions.Store(cond, q)
+	}
+	return q.Matches(map[string][]string{q.Syntax()[0].Tag: {s}})
+}
+
+// dialect is the SQLite dialect of the statements translated from pubsub
+// queries.
+type dialect struct{}
+
+var _ sqlquery.Dialect = dialect{}
+
+// Placeholder implements sqlquery.Dialect. Arguments are numbered explicitly,
+// since SQLite numbers named placeholders such as $1 in order of appearance.
+func (dialect) Placeholder(n int) string {
+	return "?" + strconv.Itoa(n)
+}
+
+// ValuePredicate implements sqlquery.Dialect.
+func (dialect) ValuePredicate(b *sqlquery.Builder, c syntax.Condition) (string, error) {
+	if c.Op == syntax.TEq && c.Arg.Type == syntax.TString {
+		// Let SQLite use the indexes, if any, for the common case.
+		return `ea.value = ` + b.Arg(c.Arg.Value()), nil
+	}
+	if _, err := query.Compile(syntax.Query{c}); err != nil {
+		return "", err
+	}
+	return matchFunc + `(` + b.Arg(c.String()) + `, ea.value)`, nil
+}
@@ -0,0 +1,284 @@
+// Package sqlite implements an event sink backed by an embedded SQLite
+// database.
+//
+// The sink uses the schema of the PostgreSQL event sink, and shares its
+// translation of queries into SQL. Unlike the PostgreSQL sink, it installs the
+// schema itself when it opens a new database.
+//
+// The sink uses a pure Go port of SQLite, and thus does not require cgo.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/internal/rand"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer/sink/internal/sqlquery"
+	"github.com/cometbft/cometbft/state/indexer/sink/psql"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	tableBlocks     = "blocks"
+	tableTxResults  = "tx_results"
+	tableEvents     = "events"
+	tableAttributes = "attributes"
+)
+
+// EventSink is an indexer backend providing the tx/block index services. This
+// implementation stores records in a SQLite database using the schema defined
+// in state/indexer/sink/psql/schema.sql.
+type EventSink struct {
+	store   *sql.DB
+	chainID string
+}
+
+// NewEventSink constructs an event sink associated with the SQLite database
+// at path, which is created, along with its schema, if it does not exist.
+// Events written to the sink are attributed to the specified chainID.
+func NewEventSink(path, chainID string) (*EventSink, error) {
+	db, err := sql.Open(driverName, path)
+	if err != nil {
+		return nil, err
+	}
+	// SQLite serializes writes anyway, and sharing a single connection spares
+	// the sink from handling "database is locked" errors.
+	db.SetMaxOpenConns(1)
+
+	if err := installSchema(db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("installing schema: %w", err)
+	}
+	return &EventSink{
+		store:   db,
+		chainID: chainID,
+	}, nil
+}
+
+// installSchema installs the schema of the event sink into db, unless it is
+// already installed.
+func installSchema(db *sql.DB) error {
+	var installed bool
+	if err := db.QueryRow(`
+SELECT EXISTS(SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = ?1);
+`, tableBlocks).Scan(&installed); err != nil {
+		return err
+	}
+	if installed {
+		return nil
+	}
+	return runInTransaction(db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(psql.Schema)
+		return err
+	})
+}
+
+// DB returns the underlying SQLite connection used by the sink.
+// This is exported to support testing.
+func (es *EventSink) DB() *sql.DB { return es.store }
+
+// runInTransaction executes query in a fresh database transaction.
+// If query reports an error, the transaction is rolled back and the
+// error from query is reported to the caller.
+// Otherwise, the result of committing the transaction is returned.
+func runInTransaction(db *sql.DB, query func(*sql.Tx) error) error {
+	dbtx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := query(dbtx); err != nil {
+		_ = dbtx.Rollback() // report the initial error, not the rollback
+		return err
+	}
+	return dbtx.Commit()
+}
+
+func randomBigserial() int64 {
+	return rand.Int63()
+}
+
+// insertEvents inserts the events of the block with the given ID, and of the
+// transaction with the given ID if it is > 0, along with their attributes.
+func insertEvents(dbtx *sql.Tx, blockID, txID int64, events []abci.Event) error {
+	// Populate the transaction ID field iff one is defined (> 0).
+	var txIDArg any
+	if txID > 0 {
+		txIDArg = txID
+	}
+	for _, event := range events {
+		// Skip events with an empty type.
+		if event.Type == "" {
+			continue
+		}
+		eventID := randomBigserial()
+		if _, err := dbtx.Exec(`
+INSERT INTO `+tableEvents+` (rowid, block_id, tx_id, type) VALUES (?1, ?2, ?3, ?4);
+`, eventID, blockID, txIDArg, event.Type); err != nil {
+			return fmt.Errorf("inserting event: %w", err)
+		}
+		for _, attr := range event.Attributes {
+			if !attr.Index {
+				continue
+			}
+			compositeKey := event.Type + "." + attr.Key
+			if _, err := dbtx.Exec(`
+INSERT INTO `+tableAttributes+` (event_id, key, composite_key, value) VALUES (?1, ?2, ?3, ?4);
+`, eventID, attr.Key, compositeKey, attr.Value); err != nil {
+				return fmt.Errorf("inserting attribute: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// makeIndexedEvent constructs an event from the specified composite key and
+// value. If the key has the form "type.name", the event will have a single
+// attribute with that name and the value; otherwise the event will have only
+// a type and no attributes.
+func makeIndexedEvent(compositeKey, value string) abci.Event {
+	i := strings.Index(compositeKey, ".")
+	if i < 0 {
+		return abci.Event{Type: compositeKey}
+	}
+	return abci.Event{Type: compositeKey[:i], Attributes: []abci.EventAttribute{
+		{Key: compositeKey[i+1:], Value: value, Index: true},
+	}}
+}
+
+// IndexBlockEvents indexes the specified block header, part of the
+// indexer.EventSink interface.
+func (es *EventSink) IndexBlockEvents(h types.EventDataNewBlockEvents) error {
+	ts := time.Now().UTC()
+
+	return runInTransaction(es.store, func(dbtx *sql.Tx) error {
+		// Add the block to the blocks table and report back its row ID for use
+		// in indexing the events for the block.
+		var blockID int64
+		//nolint:execinquery
+		err := dbtx.QueryRow(`
+INSERT INTO `+tableBlocks+` (rowid, height, chain_id, created_at)
+  VALUES (?1, ?2, ?3, ?4)
+  ON CONFLICT DO NOTHING
+  RETURNING rowid;
+`, randomBigserial(), h.Height, es.chainID, ts).Scan(&blockID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil // we already saw this block; quietly succeed
+		} else if err != nil {
+			return fmt.Errorf("indexing block header: %w", err)
+		}
+
+		// Insert the special block meta-event for height.
+		events := append([]abci.Event{makeIndexedEvent(types.BlockHeightKey, strconv.FormatInt(h.Height, 10))}, h.Events...)
+		if err := insertEvents(dbtx, blockID, 0, events); err != nil {
+			return fmt.Errorf("inserting block events: %w", err)
+		}
+		return nil
+	})
+}
+
+// IndexTxEvents indexes the specified transaction results, part of the
+// indexer.EventSink interface. Every block header must have been indexed prior
+// to the transactions belonging to it.
+func (es *EventSink) IndexTxEvents(txrs []*abci.TxResult) error {
+	ts := time.Now().UTC()
+
+	return runInTransaction(es.store, func(dbtx *sql.Tx) error {
+		for _, txr := range txrs {
+			var blockID int64
+			err := dbtx.QueryRow(`
+SELECT rowid FROM `+tableBlocks+` WHERE height = ?1 AND chain_id = ?2;
+`, txr.Height, es.chainID).Scan(&blockID)
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("block at height %d is not indexed", txr.Height)
+			} else if err != nil {
+				return fmt.Errorf("getting block id for tx: %w", err)
+			}
+
+			var alreadyIndexed bool
+			if err := dbtx.QueryRow(`
+SELECT EXISTS(SELECT 1 FROM `+tableTxResults+` WHERE block_id = ?1 AND "index" = ?2);
+`, blockID, txr.Index).Scan(&alreadyIndexed); err != nil {
+				return fmt.Errorf("checking whether txr was already indexed: %w", err)
+			}
+			if alreadyIndexed {
+				continue
+			}
+
+			// Encode the result message in protobuf wire format for indexing.
+			resultData, err := proto.Marshal(txr)
+			if err != nil {
+				return fmt.Errorf("marshaling tx_result: %w", err)
+			}
+			// Index the hash of the underlying transaction as a hex string.
+			txHash := fmt.Sprintf("%X", types.Tx(txr.Tx).Hash())
+			// Generate random ID for this tx_result and insert a record for it
+			txID := randomBigserial()
+			if _, err := dbtx.Exec(`
+INSERT INTO `+tableTxResults+` (rowid, block_id, "index", created_at, tx_hash, tx_result)
+  VALUES (?1, ?2, ?3, ?4, ?5, ?6);
+`, txID, blockID, txr.Index, ts, txHash, resultData); err != nil {
+				return fmt.Errorf("inserting txr: %w", err)
+			}
+
+			// Insert the special transaction meta-events for hash and height.
+			events := append([]abci.Event{
+				makeIndexedEvent(types.TxHashKey, txHash),
+				makeIndexedEvent(types.TxHeightKey, strconv.FormatInt(txr.Height, 10)),
+			},
+				txr.Result.Events...,
+			)
+			if err := insertEvents(dbtx, blockID, txID, events); err != nil {
+				return fmt.Errorf("inserting tx events: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// SearchBlockEvents returns the heights of the blocks matching q, in
+// ascending order, part of the indexer.EventSink interface.
+func (es *EventSink) SearchBlockEvents(ctx context.Context, q *query.Query) ([]int64, error) {
+	return sqlquery.SearchBlocks(ctx, es.store, dialect{}, q, es.chainID)
+}
+
+// SearchTxEvents returns the results of the transactions matching q, ordered
+// by height and index, part of the indexer.EventSink interface.
+func (es *EventSink) SearchTxEvents(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	txrs, _, err := es.searchTxEvents(ctx, q, txindex.Pagination{})
+	return txrs, err
+}
+
+// searchTxEvents returns the page of the results of the transactions matching
+// q selected by pagSettings, and the total number of matching transactions.
+func (es *EventSink) searchTxEvents(
+	ctx context.Context,
+	q *query.Query,
+	pagSettings txindex.Pagination,
+) ([]*abci.TxResult, int, error) {
+	return sqlquery.SearchTxs(ctx, es.store, dialect{}, q, es.chainID, pagSettings)
+}
+
+// GetTxByHash returns the result of the transaction with the given hash, or
+// nil if it is not indexed, part of the indexer.EventSink interface.
+func (es *EventSink) GetTxByHash(hash []byte) (*abci.TxResult, error) {
+	return sqlquery.GetTx(es.store, dialect{}, hash, es.chainID)
+}
+
+// HasBlock reports whether the events of the block at the given height are
+// indexed, part of the indexer.EventSink interface.
+func (es *EventSink) HasBlock(height int64) (bool, error) {
+	return sqlquery.HasBlock(es.store, dialect{}, height, es.chainID)
+}
+
+// Stop closes the underlying SQLite database.
+func (es *EventSink) Stop() error { return es.store.Close() }
@@ -0,0 +1,359 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/state/txindex/kv"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	chainID = "test-chainID"
+
+	viewBlockEvents = "block_events"
+	viewTxEvents    = "tx_events"
+)
+
+// newTestSink opens a sink on a fresh database, closed at the end of the test.
+func newTestSink(t *testing.T) *EventSink {
+	t.Helper()
+	es, err := NewEventSink(filepath.Join(t.TempDir(), "tx_index.sqlite"), chainID)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = es.Stop() })
+	return es
+}
+
+func TestNewEventSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tx_index.sqlite")
+	es, err := NewEventSink(path, chainID)
+	require.NoError(t, err)
+	require.NoError(t, es.IndexBlockEvents(newTestBlockEvents()))
+	require.NoError(t, es.Stop())
+
+	// Reopening the database keeps the schema and the indexed events.
+	es, err = NewEventSink(path, chainID)
+	require.NoError(t, err)
+	defer es.Stop()
+	ok, err := es.HasBlock(1)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestIndexing(t *testing.T) {
+	t.Run("IndexBlockEvents", func(t *testing.T) {
+		indexer := newTestSink(t)
+		require.NoError(t, indexer.IndexBlockEvents(newTestBlockEvents()))
+
+		ok, err := indexer.HasBlock(1)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		ok, err = indexer.HasBlock(2)
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		var count int
+		require.NoError(t, indexer.DB().QueryRow(`
+SELECT count(*) FROM `+viewBlockEvents+` WHERE height = ?1 AND chain_id = ?2;
+`, 1, chainID).Scan(&count))
+		assert.Equal(t, 7, count)
+
+		for q, want := range map[string][]int64{
+			"block.height = 1":                      {1},
+			"block.height > 1":                      nil,
+			"end_event.foo > 50":                    {1},
+			"end_event.foo <= 50":                   nil,
+			"end_event.amount = 42":                 {1},
+			"end_event.amount > 41.5":               {1},
+			"end_event.date > DATE 2024-01-01":      {1},
+			"end_event.date < DATE 2024-01-01":      nil,
+			"thingy.whatzit = 'O.O'":                {1},
+			"thingy.whatzit CONTAINS '-.'":          {1},
+			"thingy.whatzit CONTAINS 'X'":           nil,
+			"thingy EXISTS":                         {1},
+			"begin_event.proposer EXISTS":           {1},
+			"end_event.foo = 100 AND thingy EXISTS": nil,
+		} {
+			heights, err := indexer.SearchBlockEvents(context.Background(), query.MustCompile(q))
+			require.NoError(t, err, q)
+			assert.Equal(t, want, heights, q)
+		}
+
+		// Attempting to reindex the same events should gracefully succeed.
+		require.NoError(t, indexer.IndexBlockEvents(newTestBlockEvents()))
+	})
+
+	t.Run("IndexTxEvents", func(t *testing.T) {
+		indexer := newTestSink(t)
+		require.NoError(t, indexer.IndexBlockEvents(newTestBlockEvents()))
+
+		txResult := txResultWithEvents([]abci.Event{
+			makeIndexedEvent("account.number", "1"),
+			makeIndexedEvent("account.owner", "Ivan"),
+			makeIndexedEvent("account.owner", "Yulieta"),
+
+			{Type: "", Attributes: []abci.EventAttribute{
+				{
+					Key:   "not_allowed",
+					Value: "Vlad",
+					Index: true,
+				},
+			}},
+		})
+		require.NoError(t, indexer.IndexTxEvents([]*abci.TxResult{txResult}))
+
+		var count int
+		require.NoError(t, indexer.DB().QueryRow(`
+SELECT count(*) FROM `+viewTxEvents+` WHERE height = ?1 AND chain_id = ?2;
+`, 1, chainID).Scan(&count))
+		assert.Equal(t, 5, count)
+
+		txr, err := indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, txResult, txr)
+		txr, err = indexer.GetTxByHash(types.Tx("unknown").Hash())
+		require.NoError(t, err)
+		assert.Nil(t, txr)
+		_, err = indexer.GetTxByHash(nil)
+		require.ErrorIs(t, err, txindex.ErrorEmptyHash)
+
+		hash := fmt.Sprintf("%x", types.Tx(txResult.Tx).Hash())
+		for q, want := range map[string]int{
+			"tx.height = 1":                               1,
+			"tx.height > 1":                               0,
+			"tx.hash = '" + hash + "'":                    1,
+			"account.owner = 'Ivan'":                      1,
+			"account.owner = 'Vlad'":                      0,
+			"account.owner CONTAINS 'Yul'":                1,
+			"account.number >= 1 AND account.number < 2":  1,
+			"account.number > 1":                          0,
+			"account EXISTS":                              1,
+			"account.balance EXISTS":                      0,
+			"tx.height = 1 AND account.owner = 'Yulieta'": 1,
+		} {
+			txrs, err := indexer.SearchTxEvents(context.Background(), query.MustCompile(q))
+			require.NoError(t, err, q)
+			assert.Len(t, txrs, want, q)
+		}
+
+		// try to insert the duplicate tx events.
+		require.NoError(t, indexer.IndexTxEvents([]*abci.TxResult{txResult}))
+
+		// The transactions of unindexed blocks are rejected.
+		unindexed := txResultWithEvents(nil)
+		unindexed.Height = 2
+		require.Error(t, indexer.IndexTxEvents([]*abci.TxResult{unindexed}))
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		indexer := newTestSink(t)
+		require.NoError(t, indexer.IndexBlockEvents(newTestBlockEvents()))
+
+		txrs := make([]*abci.TxResult, 5)
+		for i := range txrs {
+			txrs[i] = txResultWithEvents([]abci.Event{makeIndexedEvent("account.owner", "Ivan")})
+			txrs[i].Index = uint32(i)
+			txrs[i].Tx = types.Tx(fmt.Sprintf("tx%d", i))
+		}
+		require.NoError(t, indexer.TxIndexer().AddBatch(&txindex.Batch{Ops: txrs}))
+
+		q := query.MustCompile("account.owner = 'Ivan'")
+		page, total, err := indexer.TxIndexer().Search(context.Background(), q,
+			txindex.Pagination{IsPaginated: true, Page: 2, PerPage: 2})
+		require.NoError(t, err)
+		assert.Equal(t, 5, total)
+		assert.Equal(t, txrs[2:4], page)
+
+		page, _, err = indexer.TxIndexer().Search(context.Background(), q,
+			txindex.Pagination{IsPaginated: true, Page: 1, PerPage: 2, OrderDesc: true})
+		require.NoError(t, err)
+		assert.Equal(t, []*abci.TxResult{txrs[4], txrs[3]}, page)
+
+		_, _, err = indexer.TxIndexer().Search(context.Background(), q,
+			txindex.Pagination{IsPaginated: true, Page: 4, PerPage: 2})
+		require.Error(t, err)
+	})
+
+	t.Run("IndexerService", func(t *testing.T) {
+		indexer := newTestSink(t)
+
+		// event bus
+		eventBus := types.NewEventBus()
+		err := eventBus.Start()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			if err := eventBus.Stop(); err != nil {
+				t.Error(err)
+			}
+		})
+
+		service := txindex.NewIndexerService(indexer.TxIndexer(), indexer.BlockIndexer(), eventBus, true)
+		service.SetLogger(tmlog.TestingLogger())
+		err = service.Start()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			if err := service.Stop(); err != nil {
+				t.Error(err)
+			}
+		})
+
+		// publish block with txs
+		err = eventBus.PublishEventNewBlockEvents(types.EventDataNewBlockEvents{
+			Height: 1,
+			NumTxs: 2,
+		})
+		require.NoError(t, err)
+		txResult1 := &abci.TxResult{
+			Height: 1,
+			Index:  uint32(0),
+			Tx:     types.Tx("foo"),
+			Result: abci.ExecTxResult{Code: 0},
+		}
+		err = eventBus.PublishEventTx(types.EventDataTx{TxResult: *txResult1})
+		require.NoError(t, err)
+		txResult2 := &abci.TxResult{
+			Height: 1,
+			Index:  uint32(1),
+			Tx:     types.Tx("bar"),
+			Result: abci.ExecTxResult{Code: 1},
+		}
+		err = eventBus.PublishEventTx(types.EventDataTx{TxResult: *txResult2})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			txr, err := indexer.GetTxByHash(types.Tx("bar").Hash())
+			return err == nil && txr != nil
+		}, time.Second, 10*time.Millisecond)
+		require.True(t, service.IsRunning())
+	})
+}
+
+// newTestBlockEvents constructs a fresh copy of a new block event containing
+// known test values to exercise the indexer.
+// TestSearchLikeKV checks the searches match the same events as the kv
+// indexers, which require all the conditions but the height and hash ones to
+// be matched by the same event.
+func TestSearchLikeKV(t *testing.T) {
+	indexer := newTestSink(t)
+
+	transfer := func(sender, amount string) abci.Event {
+		return abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{
+			{Key: "sender", Value: sender, Index: true},
+			{Key: "amount", Value: amount, Index: true},
+		}}
+	}
+	txResults := []*abci.TxResult{
+		{Height: 1, Tx: types.Tx("tx1"), Result: abci.ExecTxResult{Events: []abci.Event{
+			transfer("bob", "10"),
+			transfer("tom", "20"),
+			{Type: "message", Attributes: []abci.EventAttribute{{Key: "action", Value: "send", Index: true}}},
+		}}},
+		{Height: 2, Tx: types.Tx("tx2"), Result: abci.ExecTxResult{Events: []abci.Event{
+			transfer("bob", "20"),
+		}}},
+	}
+	blockEvents := []types.EventDataNewBlockEvents{
+		{Height: 1, Events: []abci.Event{transfer("bob", "10"), transfer("tom", "20")}},
+		{Height: 2, Events: []abci.Event{transfer("bob", "20")}},
+	}
+
+	kvStore := dbm.NewMemDB()
+	kvTxIndexer := kv.NewTxIndex(kvStore)
+	kvBlockIndexer := blockidxkv.New(dbm.NewPrefixDB(kvStore, []byte("block_events")))
+	for i, e := range blockEvents {
+		require.NoError(t, indexer.IndexBlockEvents(e))
+		require.NoError(t, indexer.IndexTxEvents(txResults[i:i+1]))
+		require.NoError(t, kvBlockIndexer.Index(e))
+		batch := txindex.NewBatch(1)
+		require.NoError(t, batch.Add(txResults[i]))
+		require.NoError(t, kvTxIndexer.AddBatch(batch))
+	}
+
+	for q, want := range map[string][]int64{
+		"transfer.sender = 'bob'":                                            {1, 2},
+		"transfer.sender = 'bob' AND transfer.amount = 10":                   {1},
+		"transfer.sender = 'bob' AND transfer.amount = 20":                   {2},
+		"transfer.sender = 'tom' AND transfer.amount > 15":                   {1},
+		"transfer.sender = 'bob' AND message.action = 'send'":                nil,
+		"transfer.sender EXISTS AND transfer.amount < 15":                    {1},
+		"tx.height = 1 AND transfer.sender = 'bob' AND transfer.amount = 20": nil,
+		"tx.height > 1 AND transfer.amount = 20":                             {2},
+	} {
+		txrs, _, err := indexer.TxIndexer().Search(context.Background(), query.MustCompile(q), txindex.Pagination{})
+		require.NoError(t, err, q)
+		kvTxrs, _, err := kvTxIndexer.Search(context.Background(), query.MustCompile(q), txindex.Pagination{})
+		require.NoError(t, err, q)
+		assert.Equal(t, want, txHeights(txrs), q)
+		assert.Equal(t, want, txHeights(kvTxrs), q)
+
+		q = strings.ReplaceAll(q, types.TxHeightKey, types.BlockHeightKey)
+		if strings.Contains(q, "message") {
+			continue
+		}
+		heights, err := indexer.BlockIndexer().Search(context.Background(), query.MustCompile(q))
+		require.NoError(t, err, q)
+		kvHeights, err := kvBlockIndexer.Search(context.Background(), query.MustCompile(q))
+		require.NoError(t, err, q)
+		assert.Equal(t, want, heights, q)
+		assert.Equal(t, want, nilIfEmpty(kvHeights), q)
+	}
+}
+
+// txHeights returns the heights of txrs, or nil if it is empty.
+func txHeights(txrs []*abci.TxResult) []int64 {
+	var heights []int64
+	for _, txr := range txrs {
+		heights = append(heights, txr.Height)
+	}
+	return heights
+}
+
+func nilIfEmpty(heights []int64) []int64 {
+	if len(heights) == 0 {
+		return nil
+	}
+	return heights
+}
+
+func newTestBlockEvents() types.EventDataNewBlockEvents {
+	return types.EventDataNewBlockEvents{
+		Height: 1,
+		Events: []abci.Event{
+			makeIndexedEvent("begin_event.proposer", "FCAA001"),
+			makeIndexedEvent("thingy.whatzit", "O.O"),
+			makeIndexedEvent("end_event.foo", "100"),
+			makeIndexedEvent("end_event.amount", "42stake"),
+			makeIndexedEvent("end_event.date", "2024-03-01"),
+			makeIndexedEvent("thingy.whatzit", "-.O"),
+		},
+	}
+}
+
+// txResultWithEvents constructs a fresh transaction result with fixed values
+// for testing, that includes the specified events.
+func txResultWithEvents(events []abci.Event) *abci.TxResult {
+	return &abci.TxResult{
+		Height: 1,
+		Index:  0,
+		Tx:     types.Tx("HELLO WORLD"),
+		Result: abci.ExecTxResult{
+			Data:   []byte{0},
+			Code:   abci.CodeTypeOK,
+			Log:    "",
+			Events: events,
+		},
+	}
+}
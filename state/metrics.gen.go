@@ -96,6 +96,12 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 
 			Buckets: stdprometheus.ExponentialBuckets(0.0002, 10, 5),
 		}, append(labels, "method")).With(labelsAndValues...),
+		ABCIResponsesBytesSaved: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "abciresponses_bytes_saved",
+			Help:      "The number of bytes saved by compressing the FinalizeBlock responses written to the state store.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -114,5 +120,6 @@ func NopMetrics() *Metrics {
 		TxIndexerBaseHeight:                    discard.NewGauge(),
 		BlockIndexerBaseHeight:                 discard.NewGauge(),
 		StoreAccessDurationSeconds:             discard.NewHistogram(),
+		ABCIResponsesBytesSaved:                discard.NewCounter(),
 	}
 }
@@ -66,4 +66,8 @@ type Metrics struct {
 	// The duration of accesses to the state store labeled by which method
 	// was called on the store.
 	StoreAccessDurationSeconds metrics.Histogram `metrics_bucketsizes:"0.0002, 10, 5" metrics_buckettype:"exp" metrics_labels:"method"`
+
+	// The number of bytes saved by compressing the FinalizeBlock responses
+	// written to the state store.
+	ABCIResponsesBytesSaved metrics.Counter
 }
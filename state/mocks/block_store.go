@@ -14,6 +14,24 @@ type BlockStore struct {
 	mock.Mock
 }
 
+// BackfillBlock provides a mock function with given fields: block, blockParts, seenCommit
+func (_m *BlockStore) BackfillBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) error {
+	ret := _m.Called(block, blockParts, seenCommit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackfillBlock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*types.Block, *types.PartSet, *types.Commit) error); ok {
+		r0 = rf(block, blockParts, seenCommit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Base provides a mock function with given fields:
 func (_m *BlockStore) Base() int64 {
 	ret := _m.Called()
@@ -50,6 +68,24 @@ func (_m *BlockStore) Close() error {
 	return r0
 }
 
+// ColdHeight provides a mock function with given fields:
+func (_m *BlockStore) ColdHeight() int64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ColdHeight")
+	}
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
 // DeleteLatestBlock provides a mock function with given fields:
 func (_m *BlockStore) DeleteLatestBlock() error {
 	ret := _m.Called()
@@ -52,6 +52,24 @@ func (_m *Store) Close() error {
 	return r0
 }
 
+// DeleteStatesAbove provides a mock function with given fields: height, lastHeight
+func (_m *Store) DeleteStatesAbove(height int64, lastHeight int64) error {
+	ret := _m.Called(height, lastHeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStatesAbove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(height, lastHeight)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetABCIResRetainHeight provides a mock function with given fields:
 func (_m *Store) GetABCIResRetainHeight() (int64, error) {
 	ret := _m.Called()
@@ -429,6 +447,34 @@ func (_m *Store) PruneStates(fromHeight int64, toHeight int64, evidenceThreshold
 	return r0, r1
 }
 
+// RecompressFinalizeBlockResponses provides a mock function with given fields: fromHeight, toHeight
+func (_m *Store) RecompressFinalizeBlockResponses(fromHeight int64, toHeight int64) (int64, error) {
+	ret := _m.Called(fromHeight, toHeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecompressFinalizeBlockResponses")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64) (int64, error)); ok {
+		return rf(fromHeight, toHeight)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64) int64); ok {
+		r0 = rf(fromHeight, toHeight)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(fromHeight, toHeight)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: _a0
 func (_m *Store) Save(_a0 state.State) error {
 	ret := _m.Called(_a0)
@@ -519,6 +565,24 @@ func (_m *Store) SaveFinalizeBlockResponse(height int64, res *v1.FinalizeBlockRe
 	return r0
 }
 
+// SaveValidatorSets provides a mock function with given fields: lowerHeight, upperHeight, vals
+func (_m *Store) SaveValidatorSets(lowerHeight int64, upperHeight int64, vals *types.ValidatorSet) error {
+	ret := _m.Called(lowerHeight, upperHeight, vals)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveValidatorSets")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64, *types.ValidatorSet) error); ok {
+		r0 = rf(lowerHeight, upperHeight, vals)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetOfflineStateSyncHeight provides a mock function with given fields: height
 func (_m *Store) SetOfflineStateSyncHeight(height int64) error {
 	ret := _m.Called(height)
@@ -3,6 +3,7 @@ package state
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v1"
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
@@ -105,6 +106,17 @@ func Rollback(bs BlockStore, ss Store, removeBlock bool) (int64, []byte, error)
 
 		LastResultsHash: latestBlock.Header.LastResultsHash,
 		AppHash:         latestBlock.Header.AppHash,
+
+		ProposerSeed: rollbackBlock.Header.LastCommitHash,
+	}
+
+	// The block to remove must not be in the cold storage, where it can't be
+	// deleted: check it before the state is rolled back.
+	if removeBlock {
+		if coldHeight := bs.ColdHeight(); height <= coldHeight {
+			return -1, nil, fmt.Errorf("block at height %d was moved to the cold storage (up to height %d)",
+				height, coldHeight)
+		}
 	}
 
 	// persist the new state. This overrides the invalid one. NOTE: this will also
@@ -124,3 +136,168 @@ func Rollback(bs BlockStore, ss Store, removeBlock bool) (int64, []byte, error)
 
 	return rolledBackState.LastBlockHeight, rolledBackState.AppHash, nil
 }
+
+// RollbackToHeight overwrites the current CometBFT state with the state after
+// the block at the given height was committed, then deletes the states and
+// FinalizeBlock responses of the later heights and, from the top, the blocks
+// above height + 1. The block at height + 1 is deleted as well if removeBlock
+// is true, otherwise it is executed again on restart. The blocks deleted must
+// not have been moved to the cold storage of the block store.
+//
+// The following block must be retained, as well as the validator sets from
+// height to height + 2. If interrupted, RollbackToHeight can be called again
+// with the same height to complete the rollback.
+// Note that this function does not affect application state: the application
+// must be rolled back to height (see the rollback command).
+func RollbackToHeight(bs BlockStore, ss Store, height int64, removeBlock bool) (int64, []byte, error) {
+	current, err := ss.Load()
+	if err != nil {
+		return -1, nil, err
+	}
+	if current.IsEmpty() {
+		return -1, nil, errors.New("no state found")
+	}
+	if height > current.LastBlockHeight {
+		return -1, nil, fmt.Errorf("height %d is above the state height %d", height, current.LastBlockHeight)
+	}
+
+	storeHeight := bs.Height()
+	if storeHeight < current.LastBlockHeight {
+		return -1, nil, fmt.Errorf("blockstore height (%d) is below the statestore height (%d)",
+			storeHeight, current.LastBlockHeight)
+	}
+	if base := bs.Base(); height < base {
+		return -1, nil, fmt.Errorf("height %d is below the blockstore base %d", height, base)
+	}
+	lastHeight := height + 1
+	if removeBlock {
+		lastHeight = height
+	}
+	// The blocks moved to the cold storage can't be deleted: check it before
+	// the state is rolled back.
+	if coldHeight := bs.ColdHeight(); lastHeight < coldHeight {
+		return -1, nil, fmt.Errorf("block at height %d was moved to the cold storage (up to height %d)",
+			lastHeight+1, coldHeight)
+	}
+
+	rolledBackState, err := LoadStateAtHeight(bs, ss, height)
+	if err != nil {
+		return -1, nil, err
+	}
+	// The validator set and consensus params are only stored in full at the
+	// heights they changed, which must thus be kept if not above the heights
+	// saved with the rolled back state.
+	rolledBackState.LastHeightValidatorsChanged = min(current.LastHeightValidatorsChanged, height+2)
+	rolledBackState.LastHeightConsensusParamsChanged = min(current.LastHeightConsensusParamsChanged, height+1)
+
+	if err := ss.Save(rolledBackState); err != nil {
+		return -1, nil, fmt.Errorf("failed to save rolled back state: %w", err)
+	}
+	if err := ss.DeleteStatesAbove(height, max(current.LastBlockHeight, storeHeight)); err != nil {
+		return -1, nil, fmt.Errorf("failed to delete the states above height %d: %w", height, err)
+	}
+
+	for bs.Height() > lastHeight {
+		if err := bs.DeleteLatestBlock(); err != nil {
+			return -1, nil, fmt.Errorf("failed to remove block %d from blockstore: %w", bs.Height(), err)
+		}
+	}
+
+	return rolledBackState.LastBlockHeight, rolledBackState.AppHash, nil
+}
+
+// LoadStateAtHeight returns the CometBFT state after the block at the given
+// height was committed. Unless height is the last height of the current state,
+// the state is rebuilt from the block store and the validator sets and
+// consensus parameters retained in ss, so the following block must be
+// retained as well.
+//
+// The heights at which the validator set and the consensus parameters last
+// changed cannot be recovered for a past height, and are set to the next
+// height, where both are stored in full. The delay before the next block is
+// taken from the FinalizeBlock response at height, and left to 0 if it is not
+// retained.
+func LoadStateAtHeight(bs BlockStore, ss Store, height int64) (State, error) {
+	current, err := ss.Load()
+	if err != nil {
+		return State{}, err
+	}
+	if current.IsEmpty() {
+		return State{}, errors.New("no state found")
+	}
+	if height == current.LastBlockHeight {
+		return current, nil
+	}
+	if height <= 0 || height > current.LastBlockHeight {
+		return State{}, fmt.Errorf("height %d is not within [1, %d]", height, current.LastBlockHeight)
+	}
+
+	blockMeta := bs.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return State{}, fmt.Errorf("block at height %d not found", height)
+	}
+	// The app hash and last results hash are only agreed upon in the
+	// following block.
+	nextBlockMeta := bs.LoadBlockMeta(height + 1)
+	if nextBlockMeta == nil {
+		return State{}, fmt.Errorf("block at height %d not found", height+1)
+	}
+
+	lastValidators, err := ss.LoadValidators(height)
+	if err != nil {
+		return State{}, err
+	}
+	validators, err := ss.LoadValidators(height + 1)
+	if err != nil {
+		return State{}, err
+	}
+	nextValidators, err := ss.LoadValidators(height + 2)
+	if err != nil {
+		return State{}, err
+	}
+	params, err := ss.LoadConsensusParams(height + 1)
+	if err != nil {
+		return State{}, err
+	}
+	var nextBlockDelay time.Duration
+	resp, err := ss.LoadFinalizeBlockResponse(height)
+	switch {
+	case err == nil:
+		nextBlockDelay = resp.NextBlockDelay
+	case errors.Is(err, ErrFinalizeBlockResponsesNotPersisted) || errors.As(err, &ErrNoABCIResponsesForHeight{}):
+		// The response is not retained; the delay is left to 0.
+	default:
+		return State{}, err
+	}
+
+	return State{
+		Version: cmtstate.Version{
+			Consensus: cmtversion.Consensus{
+				Block: version.BlockProtocol,
+				App:   params.Version.App,
+			},
+			Software: version.CMTSemVer,
+		},
+		ChainID:       current.ChainID,
+		InitialHeight: current.InitialHeight,
+
+		LastBlockHeight: blockMeta.Header.Height,
+		LastBlockID:     blockMeta.BlockID,
+		LastBlockTime:   blockMeta.Header.Time,
+
+		NextValidators:              nextValidators,
+		Validators:                  validators,
+		LastValidators:              lastValidators,
+		LastHeightValidatorsChanged: height + 1,
+
+		ConsensusParams:                  params,
+		LastHeightConsensusParamsChanged: height + 1,
+
+		LastResultsHash: nextBlockMeta.Header.LastResultsHash,
+		AppHash:         nextBlockMeta.Header.AppHash,
+
+		ProposerSeed: blockMeta.Header.LastCommitHash,
+
+		NextBlockDelay: nextBlockDelay,
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v1"
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
 	"github.com/cometbft/cometbft/crypto"
@@ -202,6 +203,125 @@ func TestRollbackHard(t *testing.T) {
 	require.Equal(t, rollbackHash, currState.AppHash)
 }
 
+func TestRollbackToHeight(t *testing.T) {
+	const lastHeight int64 = 6
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	stateStore := state.NewStore(dbm.NewMemDB(), state.StoreOptions{DiscardABCIResponses: false})
+
+	valSet, _ := types.RandValidatorSet(5, 10)
+	params := types.DefaultConsensusParams()
+
+	// appHashes[h] is the app hash after the block at height h, included in
+	// the block at height h + 1.
+	appHashes := make(map[int64][]byte)
+	states := make(map[int64]state.State)
+	var lastBlockID types.BlockID
+	for h := int64(1); h <= lastHeight; h++ {
+		appHashes[h] = crypto.CRandBytes(tmhash.Size)
+		block := &types.Block{
+			Header: types.Header{
+				Version:         cmtversion.Consensus{Block: version.BlockProtocol},
+				ChainID:         "test-chain",
+				Height:          h,
+				Time:            time.Date(2020, 1, 1, 0, 0, int(h), 0, time.UTC),
+				AppHash:         appHashes[h-1],
+				LastBlockID:     lastBlockID,
+				LastCommitHash:  crypto.CRandBytes(tmhash.Size),
+				ValidatorsHash:  valSet.Hash(),
+				ProposerAddress: crypto.CRandBytes(crypto.AddressSize),
+			},
+			LastCommit: &types.Commit{Height: h - 1},
+		}
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockStore.SaveBlock(block, partSet, &types.Commit{Height: h})
+		lastBlockID = types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+
+		st := state.State{
+			ChainID:                          "test-chain",
+			InitialHeight:                    1,
+			LastBlockHeight:                  h,
+			LastBlockID:                      lastBlockID,
+			LastBlockTime:                    block.Time,
+			AppHash:                          appHashes[h],
+			LastValidators:                   valSet,
+			Validators:                       valSet,
+			NextValidators:                   valSet,
+			LastHeightValidatorsChanged:      3,
+			ConsensusParams:                  *params,
+			LastHeightConsensusParamsChanged: 2,
+			NextBlockDelay:                   time.Duration(h) * time.Second,
+		}
+		if h == 1 {
+			require.NoError(t, stateStore.Bootstrap(st))
+		} else {
+			require.NoError(t, stateStore.Save(st))
+		}
+		require.NoError(t, stateStore.SaveFinalizeBlockResponse(h, &abci.FinalizeBlockResponse{
+			AppHash:        appHashes[h],
+			NextBlockDelay: st.NextBlockDelay,
+		}))
+		states[h] = st
+	}
+
+	// The blocks moved to the cold storage can't be deleted: nothing is
+	// rolled back.
+	_, _, err := state.RollbackToHeight(coldBlockStore{blockStore, 5}, stateStore, 3, false)
+	require.ErrorContains(t, err, "cold storage")
+	loadedState, err := stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, lastHeight, loadedState.LastBlockHeight)
+	require.Equal(t, lastHeight, blockStore.Height())
+
+	rollbackHeight, rollbackHash, err := state.RollbackToHeight(blockStore, stateStore, 3, false)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, rollbackHeight)
+	require.Equal(t, appHashes[3], rollbackHash)
+
+	loadedState, err = stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, states[3].LastBlockID, loadedState.LastBlockID)
+	require.Equal(t, states[3].AppHash, loadedState.AppHash)
+	require.Equal(t, states[3].LastHeightValidatorsChanged, loadedState.LastHeightValidatorsChanged)
+	require.Equal(t, states[3].LastHeightConsensusParamsChanged, loadedState.LastHeightConsensusParamsChanged)
+	require.Equal(t, states[3].NextBlockDelay, loadedState.NextBlockDelay)
+
+	// The next block is kept to be executed again.
+	require.EqualValues(t, 4, blockStore.Height())
+	_, err = stateStore.LoadFinalizeBlockResponse(3)
+	require.NoError(t, err)
+	_, err = stateStore.LoadFinalizeBlockResponse(4)
+	require.Error(t, err)
+	vals, err := stateStore.LoadValidators(5)
+	require.NoError(t, err)
+	require.Equal(t, valSet.Hash(), vals.Hash())
+	_, err = stateStore.LoadValidators(6)
+	require.Error(t, err)
+	_, err = stateStore.LoadConsensusParams(4)
+	require.NoError(t, err)
+	_, err = stateStore.LoadConsensusParams(5)
+	require.Error(t, err)
+
+	// Rolling back further with --hard removes the next block as well.
+	rollbackHeight, rollbackHash, err = state.RollbackToHeight(blockStore, stateStore, 2, true)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, rollbackHeight)
+	require.Equal(t, appHashes[2], rollbackHash)
+	require.EqualValues(t, 2, blockStore.Height())
+
+	_, _, err = state.RollbackToHeight(blockStore, stateStore, 3, true)
+	require.Error(t, err)
+}
+
+// coldBlockStore is a block store whose blocks up to coldHeight are in the
+// cold storage.
+type coldBlockStore struct {
+	*store.BlockStore
+	coldHeight int64
+}
+
+func (bs coldBlockStore) ColdHeight() int64 { return bs.coldHeight }
+
 func TestRollbackNoState(t *testing.T) {
 	stateStore := state.NewStore(dbm.NewMemDB(),
 		state.StoreOptions{
@@ -26,6 +26,7 @@ type BlockStore interface {
 
 	SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit)
 	SaveBlockWithExtendedCommit(block *types.Block, blockParts *types.PartSet, seenCommit *types.ExtendedCommit)
+	BackfillBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) error
 
 	PruneBlocks(height int64, state State) (uint64, int64, error)
 
@@ -38,6 +39,9 @@ type BlockStore interface {
 	LoadBlockExtendedCommit(height int64) *types.ExtendedCommit
 
 	DeleteLatestBlock() error
+	// ColdHeight returns the height of the highest block moved to the cold
+	// storage, which cannot be deleted, or 0 if none.
+	ColdHeight() int64
 
 	Close() error
 }
@@ -81,6 +81,10 @@ type State struct {
 	// delay between the time when this block is committed and the next height is started.
 	// previously `timeout_commit` in config.toml
 	NextBlockDelay time.Duration
+
+	// LastCommitHash of the last block, seeding the verifiable-random proposer
+	// selection.
+	ProposerSeed []byte
 }
 
 // Copy makes a copy of the State for mutating.
@@ -107,6 +111,8 @@ func (state State) Copy() State {
 		LastResultsHash: state.LastResultsHash,
 
 		NextBlockDelay: state.NextBlockDelay,
+
+		ProposerSeed: state.ProposerSeed,
 	}
 }
 
@@ -176,6 +182,7 @@ func (state *State) ToProto() (*cmtstate.State, error) {
 	sm.LastResultsHash = state.LastResultsHash
 	sm.AppHash = state.AppHash
 	sm.NextBlockDelay = state.NextBlockDelay
+	sm.ProposerSeed = state.ProposerSeed
 
 	return sm, nil
 }
@@ -228,6 +235,7 @@ func FromProto(pb *cmtstate.State) (*State, error) { //nolint:golint
 	state.LastResultsHash = pb.LastResultsHash
 	state.AppHash = pb.AppHash
 	state.NextBlockDelay = pb.NextBlockDelay
+	state.ProposerSeed = pb.ProposerSeed
 
 	return state, nil
 }
@@ -1,9 +1,12 @@
 package state
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -14,9 +17,11 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v1"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/internal/compress"
 	cmtos "github.com/cometbft/cometbft/internal/os"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -103,6 +108,89 @@ func (v2l v2Layout) CalcValidatorsKey(height int64) []byte {
 
 var _ KeyLayout = (*v2Layout)(nil)
 
+// parseKey parses a key of the v1 layout into its prefix, one of the prefixes
+// of the v2 layout, and its height.
+func (v1LegacyLayout) parseKey(key []byte) (int64, int64, bool) {
+	name, height, ok := strings.Cut(string(key), ":")
+	if !ok {
+		return 0, 0, false
+	}
+	prefixes := map[string]int64{
+		"validatorsKey":      prefixValidators,
+		"consensusParamsKey": prefixConsensusParams,
+		"abciResponsesKey":   prefixABCIResponses,
+	}
+	prefix, ok := prefixes[name]
+	if !ok {
+		return 0, 0, false
+	}
+	h, err := strconv.ParseInt(height, 10, 64)
+	return prefix, h, err == nil
+}
+
+// parseKey parses a key of the v2 layout into its prefix and its height.
+func (v2Layout) parseKey(key []byte) (int64, int64, bool) {
+	var prefix, height int64
+	rest, err := orderedcode.Parse(string(key), &prefix, &height)
+	if err != nil || rest != "" {
+		return 0, 0, false
+	}
+	switch prefix {
+	case prefixValidators, prefixConsensusParams, prefixABCIResponses:
+		return prefix, height, true
+	default:
+		return 0, 0, false
+	}
+}
+
+// stateKeyLayout is a key layout of the state store.
+type stateKeyLayout interface {
+	KeyLayout
+	parseKey(key []byte) (int64, int64, bool)
+}
+
+func newKeyLayout(version string) (stateKeyLayout, error) {
+	switch version {
+	case "v1", "":
+		return v1LegacyLayout{}, nil
+	case "v2":
+		return v2Layout{}, nil
+	default:
+		return nil, fmt.Errorf("unknown key layout version %q", version)
+	}
+}
+
+func calcKey(l KeyLayout, prefix, height int64) []byte {
+	switch prefix {
+	case prefixValidators:
+		return l.CalcValidatorsKey(height)
+	case prefixConsensusParams:
+		return l.CalcConsensusParamsKey(height)
+	default:
+		return l.CalcABCIResponsesKey(height)
+	}
+}
+
+// MigrateKey returns the key of the state store entry stored under key in the
+// from key layout, in the to key layout. Keys that do not depend on the key
+// layout, such as the key of the state, are returned unchanged.
+func MigrateKey(key []byte, from, to string) ([]byte, error) {
+	fromLayout, err := newKeyLayout(from)
+	if err != nil {
+		return nil, err
+	}
+	toLayout, err := newKeyLayout(to)
+	if err != nil {
+		return nil, err
+	}
+	prefix, height, ok := fromLayout.parseKey(key)
+	// Keys parsed by chance, e.g. a key of another layout, are not rewritten.
+	if !ok || !bytes.Equal(calcKey(fromLayout, prefix, height), key) {
+		return key, nil
+	}
+	return calcKey(toLayout, prefix, height), nil
+}
+
 //go:generate ../scripts/mockery_generate.sh Store
 
 // Store defines the state store interface
@@ -130,12 +218,16 @@ type Store interface {
 	Save(state State) error
 	// SaveFinalizeBlockResponse saves ABCIResponses for a given height
 	SaveFinalizeBlockResponse(height int64, res *abci.FinalizeBlockResponse) error
+	// SaveValidatorSets saves the validator set of the heights from lowerHeight to upperHeight, e.g. of backfilled blocks
+	SaveValidatorSets(lowerHeight, upperHeight int64, vals *types.ValidatorSet) error
 	// Bootstrap is used for bootstrapping state when not starting from a initial height.
 	Bootstrap(state State) error
 	// PruneStates takes the height from which to start pruning and which height stop at
 	PruneStates(fromHeight, toHeight, evidenceThresholdHeight int64, previouslyPrunedStates uint64) (uint64, error)
 	// PruneABCIResponses will prune all ABCI responses below the given height.
 	PruneABCIResponses(targetRetainHeight int64, forceCompact bool) (int64, int64, error)
+	// DeleteStatesAbove deletes what was saved for the heights after the state at height, up to the state at lastHeight
+	DeleteStatesAbove(height, lastHeight int64) error
 	// SaveApplicationRetainHeight persists the application retain height from the application
 	SaveApplicationRetainHeight(height int64) error
 	// GetApplicationRetainHeight returns the retain height set by the application
@@ -148,6 +240,8 @@ type Store interface {
 	SaveABCIResRetainHeight(height int64) error
 	// GetABCIResRetainHeight returns the last saved retain height for ABCI results set by the data companion
 	GetABCIResRetainHeight() (int64, error)
+	// RecompressFinalizeBlockResponses compresses the ABCI responses of the given range of heights stored uncompressed
+	RecompressFinalizeBlockResponses(fromHeight, toHeight int64) (int64, error)
 	// Saves the height at which the store is bootstrapped after out of band statesync
 	SetOfflineStateSyncHeight(height int64) error
 	// Gets the height at which the store is bootstrapped after out of band statesync
@@ -162,6 +256,10 @@ type dbStore struct {
 
 	DBKeyLayout KeyLayout
 
+	// abciResponsesMtx prevents ABCI responses from being rewritten by their
+	// recompression while pruned, which would store them again.
+	abciResponsesMtx *cmtsync.Mutex
+
 	StoreOptions
 }
 
@@ -183,6 +281,10 @@ type StoreOptions struct {
 	Logger log.Logger
 
 	DBKeyLayout string
+
+	// Compress determines whether the ABCI responses are compressed when
+	// saved. They are decompressed when loaded whatever this option.
+	Compress bool
 }
 
 var _ Store = (*dbStore)(nil)
@@ -233,8 +335,9 @@ func NewStore(db dbm.DB, options StoreOptions) Store {
 	}
 
 	store := dbStore{
-		db:           db,
-		StoreOptions: options,
+		db:               db,
+		abciResponsesMtx: new(cmtsync.Mutex),
+		StoreOptions:     options,
 	}
 
 	dbKeyLayoutVersion := setDBKeyLayout(&store, options.DBKeyLayout)
@@ -572,6 +675,8 @@ func (store dbStore) PruneABCIResponses(targetRetainHeight int64, forceCompact b
 		return 0, 0, nil
 	}
 	defer addTimeSample(store.StoreOptions.Metrics.StoreAccessDurationSeconds.With("method", "prune_abci_responses"), time.Now())()
+	store.abciResponsesMtx.Lock()
+	defer store.abciResponsesMtx.Unlock()
 	lastRetainHeight, err := store.getLastABCIResponsesRetainHeight()
 	if err != nil {
 		return 0, 0, fmt.Errorf("failed to look up last ABCI responses retain height: %w", err)
@@ -619,6 +724,42 @@ func (store dbStore) PruneABCIResponses(targetRetainHeight int64, forceCompact b
 	return pruned + batchPruned, targetRetainHeight, err
 }
 
+// DeleteStatesAbove deletes what was saved for the heights after the state at
+// the given height, up to the state at lastHeight: the FinalizeBlock responses
+// of the heights above height, and the validator sets and consensus
+// parameters saved for the heights above those of the state at height. It is
+// used to roll back the state by several heights, once the state at height is
+// saved.
+func (store dbStore) DeleteStatesAbove(height, lastHeight int64) error {
+	defer addTimeSample(store.StoreOptions.Metrics.StoreAccessDurationSeconds.With("method", "delete_states_above"), time.Now())()
+	store.abciResponsesMtx.Lock()
+	defer store.abciResponsesMtx.Unlock()
+
+	batch := store.db.NewBatch()
+	defer batch.Close()
+
+	// The state at a height saves the consensus parameters of the next height
+	// and the validator set of the height after.
+	for h := height + 1; h <= lastHeight+2; h++ {
+		if h <= lastHeight+1 {
+			if err := batch.Delete(store.DBKeyLayout.CalcABCIResponsesKey(h)); err != nil {
+				return fmt.Errorf("failed to delete ABCI responses at height %d: %w", h, err)
+			}
+		}
+		if h > height+1 {
+			if err := batch.Delete(store.DBKeyLayout.CalcConsensusParamsKey(h)); err != nil {
+				return fmt.Errorf("failed to delete consensus params at height %d: %w", h, err)
+			}
+		}
+		if h > height+2 {
+			if err := batch.Delete(store.DBKeyLayout.CalcValidatorsKey(h)); err != nil {
+				return fmt.Errorf("failed to delete validators at height %d: %w", h, err)
+			}
+		}
+	}
+	return batch.WriteSync()
+}
+
 // ------------------------------------------------------------------------
 
 // TxResultsHash returns the root hash of a Merkle tree of
@@ -648,6 +789,10 @@ func (store dbStore) LoadFinalizeBlockResponse(height int64) (*abci.FinalizeBloc
 	if len(buf) == 0 {
 		return nil, ErrNoABCIResponsesForHeight{height}
 	}
+	buf, err = compress.Decompress(buf)
+	if err != nil {
+		return nil, err
+	}
 
 	resp := new(abci.FinalizeBlockResponse)
 	err = resp.Unmarshal(buf)
@@ -711,6 +856,10 @@ func (store dbStore) LoadLastFinalizeBlockResponse(height int64) (*abci.Finalize
 		// END OF DEPRECATED lastABCIResponseKey
 		return nil, fmt.Errorf("expected last ABCI responses at height %d, but none are found", height)
 	}
+	buf, err = compress.Decompress(buf)
+	if err != nil {
+		return nil, err
+	}
 	resp := new(abci.FinalizeBlockResponse)
 	err = resp.Unmarshal(buf)
 	if err != nil {
@@ -739,6 +888,9 @@ func (store dbStore) SaveFinalizeBlockResponse(height int64, resp *abci.Finalize
 	if err != nil {
 		return err
 	}
+	if store.Compress {
+		bz = store.compressABCIResponses(bz)
+	}
 
 	// Save the ABCI response.
 	//
@@ -757,6 +909,61 @@ func (store dbStore) SaveFinalizeBlockResponse(height int64, resp *abci.Finalize
 	return nil
 }
 
+// compressABCIResponses returns the compressed form of the ABCI responses bz
+// if it is smaller, or bz otherwise.
+func (store dbStore) compressABCIResponses(bz []byte) []byte {
+	compressed := compress.Compress(bz)
+	if len(compressed) >= len(bz) {
+		return bz
+	}
+	store.StoreOptions.Metrics.ABCIResponsesBytesSaved.Add(float64(len(bz) - len(compressed)))
+	return compressed
+}
+
+// RecompressFinalizeBlockResponses compresses the ABCI responses of the given
+// range of heights stored uncompressed, e.g. saved before compression was
+// enabled, and returns the number of responses rewritten. It does nothing if
+// compression is disabled or the ABCI responses are discarded.
+func (store dbStore) RecompressFinalizeBlockResponses(fromHeight, toHeight int64) (int64, error) {
+	if !store.Compress || store.DiscardABCIResponses {
+		return 0, nil
+	}
+	defer addTimeSample(store.StoreOptions.Metrics.StoreAccessDurationSeconds.With("method", "recompress_abci_responses"), time.Now())()
+	var recompressed int64
+	for h := fromHeight; h <= toHeight; h++ {
+		ok, err := store.recompressFinalizeBlockResponse(h)
+		if err != nil {
+			return recompressed, fmt.Errorf("recompressing ABCI responses at height %d: %w", h, err)
+		}
+		if ok {
+			recompressed++
+		}
+	}
+	return recompressed, nil
+}
+
+func (store dbStore) recompressFinalizeBlockResponse(height int64) (bool, error) {
+	store.abciResponsesMtx.Lock()
+	defer store.abciResponsesMtx.Unlock()
+	retainHeight, err := store.getLastABCIResponsesRetainHeight()
+	if err != nil {
+		return false, err
+	}
+	if height < retainHeight {
+		return false, nil
+	}
+	key := store.DBKeyLayout.CalcABCIResponsesKey(height)
+	bz, err := store.db.Get(key)
+	if err != nil || len(bz) == 0 || compress.IsCompressed(bz) {
+		return false, err
+	}
+	compressed := store.compressABCIResponses(bz)
+	if !compress.IsCompressed(compressed) {
+		return false, nil
+	}
+	return true, store.db.Set(key, compressed)
+}
+
 func (store dbStore) getValue(key []byte) ([]byte, error) {
 	bz, err := store.db.Get(key)
 	if err != nil {
@@ -919,6 +1126,20 @@ func loadValidatorsInfo(db dbm.DB, valInfoKey []byte) (*cmtstate.ValidatorsInfo,
 	return v, elapsedTime, nil
 }
 
+// SaveValidatorSets saves vals as the validator set of the heights from
+// lowerHeight to upperHeight, lowerHeight being the height it changed at.
+func (store dbStore) SaveValidatorSets(lowerHeight, upperHeight int64, vals *types.ValidatorSet) error {
+	batch := store.db.NewBatch()
+	defer batch.Close()
+
+	for height := lowerHeight; height <= upperHeight; height++ {
+		if err := store.saveValidatorsInfo(height, lowerHeight, vals, batch); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
 // saveValidatorsInfo persists the validator set.
 //
 // `height` is the effective height for which the validator is responsible for
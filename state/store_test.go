@@ -3,6 +3,7 @@ package state_test
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v1"
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/internal/compress"
 	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/libs/log"
 	sm "github.com/cometbft/cometbft/state"
@@ -581,6 +583,49 @@ func TestLastFinalizeBlockResponses(t *testing.T) {
 	})
 }
 
+func TestFinalizeBlockResponseCompression(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+	response := func(height int64) *abci.FinalizeBlockResponse {
+		events := make([]abci.Event, 100)
+		for i := range events {
+			events[i] = abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{
+				{Key: "sender", Value: "cosmos1sender", Index: true},
+				{Key: "amount", Value: strconv.Itoa(i), Index: true},
+			}}
+		}
+		return &abci.FinalizeBlockResponse{Events: events, AppHash: []byte{byte(height)}}
+	}
+	isCompressed := func(height int64) bool {
+		bz, err := stateDB.Get([]byte(fmt.Sprintf("abciResponsesKey:%v", height)))
+		require.NoError(t, err)
+		return compress.IsCompressed(bz)
+	}
+
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+	require.NoError(t, stateStore.SaveFinalizeBlockResponse(1, response(1)))
+	assert.False(t, isCompressed(1))
+
+	stateStore = sm.NewStore(stateDB, sm.StoreOptions{Compress: true})
+	require.NoError(t, stateStore.SaveFinalizeBlockResponse(2, response(2)))
+	assert.True(t, isCompressed(2))
+
+	n, err := stateStore.RecompressFinalizeBlockResponses(1, 2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, n)
+	assert.True(t, isCompressed(1))
+
+	// Compressed responses are loaded whatever the option.
+	stateStore = sm.NewStore(stateDB, sm.StoreOptions{})
+	for h := int64(1); h <= 2; h++ {
+		res, err := stateStore.LoadFinalizeBlockResponse(h)
+		require.NoError(t, err)
+		assert.Equal(t, response(h), res)
+	}
+	res, err := stateStore.LoadLastFinalizeBlockResponse(2)
+	require.NoError(t, err)
+	assert.Equal(t, response(2), res)
+}
+
 func TestFinalizeBlockRecoveryUsingLegacyABCIResponses(t *testing.T) {
 	var (
 		height              int64 = 10
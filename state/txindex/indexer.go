@@ -38,6 +38,15 @@ type TxIndexer interface {
 	SetRetainHeight(retainHeight int64) error
 }
 
+// AccountTxIndexer is implemented by the transaction indexers indexing
+// transactions by the index keys of their results, e.g. the addresses of their
+// signers.
+type AccountTxIndexer interface {
+	// AccountTxs returns the transactions indexed by the given account,
+	// ordered by height and index, and their total number.
+	AccountTxs(ctx context.Context, account string, pagSettings Pagination) ([]*abci.TxResult, int, error)
+}
+
 // Batch groups together multiple Index operations to be performed at the same time.
 // NOTE: Batch is NOT thread-safe and must not be modified after starting its execution.
 type Batch struct {
@@ -11,8 +11,10 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/google/orderedcode"
 
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -30,6 +32,13 @@ const (
 	tagKeySeparator     = "/"
 	tagKeySeparatorRune = '/'
 	eventSeqSeparator   = "$es$"
+
+	// accountTxKeyPrefix prefixes the keys indexing the transactions by the
+	// index keys of their results.
+	accountTxKeyPrefix = "tx.account"
+	// accountCountKeyPrefix prefixes the keys holding the number of
+	// transactions indexed by each account.
+	accountCountKeyPrefix = "tx.account.count"
 )
 
 var (
@@ -45,6 +54,11 @@ type TxIndex struct {
 
 	log log.Logger
 
+	// accounts is true once transactions were indexed by account: only then
+	// the previous result of a re-indexed transaction can have account keys
+	// to drop, and is loaded.
+	accounts atomic.Bool
+
 	compact            bool
 	compactionInterval int64
 	lastPruned         int64
@@ -93,7 +107,14 @@ func (txi *TxIndex) Prune(retainHeight int64) (numPruned int64, newRetainHeight
 	}
 	defer closeBatch(batch)
 	pruned := uint64(0)
+	// The numbers of transactions of the accounts are only updated in the
+	// store once the batch is written.
+	counts := make(accountCounts)
 	flush := func(batch dbm.Batch) error {
+		if err := txi.writeAccountCounts(counts, batch); err != nil {
+			return fmt.Errorf("failed to update account counts in tx indexer pruning batch %w", err)
+		}
+		clear(counts)
 		err := batch.WriteSync()
 		if err != nil {
 			return fmt.Errorf("failed to flush tx indexer pruning batch %w", err)
@@ -111,7 +132,7 @@ func (txi *TxIndex) Prune(retainHeight int64) (numPruned int64, newRetainHeight
 	numHeightsPersistentlyPruned := int64(0)              // number of heights pruned persistently
 	currentPersistentlyRetainedHeight := lastRetainHeight // height retained persistently
 	for i, result := range results {
-		errDeleteResult := txi.deleteResult(result, batch)
+		errDeleteResult := txi.deleteResult(result, batch, counts)
 		if errDeleteResult != nil {
 			// If we crashed in the middle of pruning the height,
 			// we assume this height is retained
@@ -119,7 +140,11 @@ func (txi *TxIndex) Prune(retainHeight int64) (numPruned int64, newRetainHeight
 			if errSetLastRetainHeight != nil {
 				return 0, lastRetainHeight, fmt.Errorf("error setting last retain height '%v' while handling result deletion error '%v' for tx indexer", errSetLastRetainHeight, errDeleteResult)
 			}
-			errWriteBatch := batch.WriteSync()
+			// The results deleted before are still deleted with the batch.
+			errWriteBatch := txi.writeAccountCounts(counts, batch)
+			if errWriteBatch == nil {
+				errWriteBatch = batch.WriteSync()
+			}
 			if errWriteBatch != nil {
 				return 0, lastRetainHeight, fmt.Errorf("error writing tx indexer batch '%v' while handling result deletion error '%v'", errWriteBatch, errDeleteResult)
 			}
@@ -204,10 +229,35 @@ func NewTxIndex(store dbm.DB, options ...IndexerOption) *TxIndex {
 	for _, option := range options {
 		option(txIndex)
 	}
+	txIndex.accounts.Store(hasAccountCounts(store))
 
 	return txIndex
 }
 
+// hasAccountCounts returns true if store holds the number of transactions of
+// any account, i.e. if transactions were indexed by account. It returns true
+// if this cannot be determined.
+func hasAccountCounts(store dbm.DB) bool {
+	prefix, err := orderedcode.Append(nil, accountCountKeyPrefix)
+	if err != nil {
+		panic(err)
+	}
+	it, err := dbm.IteratePrefix(store, prefix)
+	if err != nil {
+		return true
+	}
+	defer it.Close()
+	return it.Valid()
+}
+
+// indexedAccounts records that result was indexed by account, if it has index
+// keys.
+func (txi *TxIndex) indexedAccounts(result *abci.TxResult) {
+	if len(result.Result.IndexKeys) > 0 {
+		txi.accounts.Store(true)
+	}
+}
+
 func (txi *TxIndex) SetLogger(l log.Logger) {
 	txi.log = l
 }
@@ -244,6 +294,7 @@ func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
 	storeBatch := txi.store.NewBatch()
 	defer storeBatch.Close()
 
+	counts := make(accountCounts)
 	for _, result := range b.Ops {
 		hash := types.Tx(result.Tx).Hash()
 
@@ -253,6 +304,19 @@ func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
 			return err
 		}
 
+		// index tx by accounts
+		var oldResult *abci.TxResult
+		if txi.accounts.Load() {
+			oldResult, err = txi.Get(hash)
+			if err != nil {
+				return err
+			}
+		}
+		err = txi.indexAccounts(result, oldResult, hash, storeBatch, counts)
+		if err != nil {
+			return err
+		}
+
 		// index by height (always)
 		err = storeBatch.Set(keyForHeight(result), hash)
 		if err != nil {
@@ -269,16 +333,28 @@ func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
 			return err
 		}
 	}
+	if err := txi.writeAccountCounts(counts, storeBatch); err != nil {
+		return err
+	}
 
-	return storeBatch.WriteSync()
+	if err := storeBatch.WriteSync(); err != nil {
+		return err
+	}
+	for _, result := range b.Ops {
+		txi.indexedAccounts(result)
+	}
+	return nil
 }
 
-func (txi *TxIndex) deleteResult(result *abci.TxResult, batch dbm.Batch) error {
+func (txi *TxIndex) deleteResult(result *abci.TxResult, batch dbm.Batch, counts accountCounts) error {
 	hash := types.Tx(result.Tx).Hash()
 	err := txi.deleteEvents(result, batch)
 	if err != nil {
 		return err
 	}
+	if err := txi.deleteAccounts(result, nil, batch, counts); err != nil {
+		return err
+	}
 	err = batch.Delete(keyForHeight(result))
 	if err != nil {
 		return err
@@ -305,17 +381,20 @@ func (txi *TxIndex) Index(result *abci.TxResult) error {
 
 	hash := types.Tx(result.Tx).Hash()
 
-	if !result.Result.IsOK() {
-		oldResult, err := txi.Get(hash)
+	// The previous result is only needed to skip a failed transaction, or to
+	// drop the stale account keys of a re-indexed one.
+	var oldResult *abci.TxResult
+	if !result.Result.IsOK() || txi.accounts.Load() {
+		var err error
+		oldResult, err = txi.Get(hash)
 		if err != nil {
 			return err
 		}
-
-		// if the new transaction failed and it's already indexed in an older block and was successful
-		// we skip it as we want users to get the older successful transaction when they query.
-		if oldResult != nil && oldResult.Result.Code == abci.CodeTypeOK {
-			return nil
-		}
+	}
+	// if the new transaction failed and it's already indexed in an older block and was successful
+	// we skip it as we want users to get the older successful transaction when they query.
+	if !result.Result.IsOK() && oldResult != nil && oldResult.Result.Code == abci.CodeTypeOK {
+		return nil
 	}
 
 	// index tx by events
@@ -324,6 +403,16 @@ func (txi *TxIndex) Index(result *abci.TxResult) error {
 		return err
 	}
 
+	// index tx by accounts
+	counts := make(accountCounts)
+	err = txi.indexAccounts(result, oldResult, hash, b, counts)
+	if err != nil {
+		return err
+	}
+	if err := txi.writeAccountCounts(counts, b); err != nil {
+		return err
+	}
+
 	// index by height (always)
 	err = b.Set(keyForHeight(result), hash)
 	if err != nil {
@@ -340,7 +429,11 @@ func (txi *TxIndex) Index(result *abci.TxResult) error {
 		return err
 	}
 
-	return b.WriteSync()
+	if err := b.WriteSync(); err != nil {
+		return err
+	}
+	txi.indexedAccounts(result)
+	return nil
 }
 
 func (txi *TxIndex) deleteEvents(result *abci.TxResult, batch dbm.Batch) error {
@@ -406,6 +499,177 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 	return nil
 }
 
+// accountCounts holds the changes of the numbers of transactions indexed by
+// account, to be written along with a batch.
+type accountCounts map[string]int64
+
+// indexAccounts indexes the transaction by the index keys of its result.
+// Empty keys are not indexed. The keys of oldResult, the result previously
+// indexed for the transaction if any, are deleted unless indexed again, e.g.
+// if the transaction is indexed again at another height.
+func (txi *TxIndex) indexAccounts(result, oldResult *abci.TxResult, hash []byte, batch dbm.Batch,
+	counts accountCounts,
+) error {
+	keys := make(map[string]struct{}, len(result.Result.IndexKeys))
+	for _, account := range result.Result.IndexKeys {
+		if len(account) == 0 {
+			continue
+		}
+		key := keyForAccount(account, result)
+		if _, ok := keys[string(key)]; ok {
+			continue
+		}
+		keys[string(key)] = struct{}{}
+		exists, err := txi.store.Has(key)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			counts[account]++
+		}
+		if err := batch.Set(key, hash); err != nil {
+			return err
+		}
+	}
+	if oldResult == nil {
+		return nil
+	}
+	return txi.deleteAccounts(oldResult, keys, batch, counts)
+}
+
+// deleteAccounts deletes the keys indexing the transaction of result by its
+// index keys, except those in kept.
+func (txi *TxIndex) deleteAccounts(result *abci.TxResult, kept map[string]struct{}, batch dbm.Batch,
+	counts accountCounts,
+) error {
+	for _, account := range result.Result.IndexKeys {
+		key := keyForAccount(account, result)
+		if _, ok := kept[string(key)]; ok || len(account) == 0 {
+			continue
+		}
+		exists, err := txi.store.Has(key)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+		counts[account]--
+		if kept == nil {
+			kept = make(map[string]struct{})
+		}
+		// The result may hold the same account twice.
+		kept[string(key)] = struct{}{}
+	}
+	return nil
+}
+
+// writeAccountCounts adds the changes of counts to the numbers of
+// transactions of the accounts, in batch.
+func (txi *TxIndex) writeAccountCounts(counts accountCounts, batch dbm.Batch) error {
+	for account, delta := range counts {
+		if delta == 0 {
+			continue
+		}
+		count, err := txi.accountCount(account)
+		if err != nil {
+			return err
+		}
+		key := keyForAccountCount(account)
+		if count += delta; count > 0 {
+			err = batch.Set(key, int64ToBytes(count))
+		} else {
+			err = batch.Delete(key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// accountCount returns the number of transactions indexed by account.
+func (txi *TxIndex) accountCount(account string) (int64, error) {
+	bz, err := txi.store.Get(keyForAccountCount(account))
+	if err != nil || len(bz) == 0 {
+		return 0, err
+	}
+	return int64FromBytes(bz), nil
+}
+
+var _ txindex.AccountTxIndexer = (*TxIndex)(nil)
+
+// AccountTxs returns the transactions indexed by the given account, one of the
+// index keys of their results, ordered by height and index, and their total
+// number. The total is kept along with the index, and only the keys of the
+// account up to the requested page are scanned, from the end for a
+// descending order.
+//
+// AccountTxs will exit early and return any result fetched so far,
+// when a message is received on the context chan.
+func (txi *TxIndex) AccountTxs(ctx context.Context, account string, pagSettings txindex.Pagination) ([]*abci.TxResult, int, error) {
+	count, err := txi.accountCount(account)
+	if err != nil {
+		return nil, 0, err
+	}
+	total := int(count)
+
+	// The range of positions of the requested transactions, in the requested
+	// order.
+	start, end := 0, total
+	if pagSettings.IsPaginated {
+		page, err := validatePage(&pagSettings.Page, pagSettings.PerPage, total)
+		if err != nil {
+			return nil, 0, err
+		}
+		start = (page - 1) * pagSettings.PerPage
+		end = min(start+pagSettings.PerPage, total)
+	}
+
+	prefix := accountPrefix(account)
+	var it dbm.Iterator
+	if pagSettings.OrderDesc {
+		it, err = txi.store.ReverseIterator(prefix, prefixEnd(prefix))
+	} else {
+		it, err = dbm.IteratePrefix(txi.store, prefix)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	defer it.Close()
+	hashes := make([][]byte, 0, max(end-start, 0))
+	for pos := 0; it.Valid() && pos < end; it.Next() {
+		if pos >= start {
+			hashes = append(hashes, it.Value())
+		}
+		pos++
+	}
+	if err := it.Error(); err != nil {
+		return nil, 0, err
+	}
+
+	results := make([]*abci.TxResult, 0, len(hashes))
+	for _, hash := range hashes {
+		res, err := txi.Get(hash)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get Tx{%X}: %w", hash, err)
+		}
+		if res != nil {
+			results = append(results, res)
+		}
+		// Potentially exit early.
+		select {
+		case <-ctx.Done():
+			return results, total, nil
+		default:
+		}
+	}
+	return results, total, nil
+}
+
 // Search performs a search using the given query.
 //
 // It breaks the query into conditions (like "tx.height > 5"). For each
@@ -1027,6 +1291,35 @@ func keyForHeight(result *abci.TxResult) []byte {
 	))
 }
 
+// keyForAccount returns the key indexing the transaction of result by the
+// given account. The keys of an account are ordered by height and index.
+func keyForAccount(account string, result *abci.TxResult) []byte {
+	key, err := orderedcode.Append(nil, accountTxKeyPrefix, account, result.Height, int64(result.Index))
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// keyForAccountCount returns the key holding the number of transactions
+// indexed by the given account.
+func keyForAccountCount(account string) []byte {
+	key, err := orderedcode.Append(nil, accountCountKeyPrefix, account)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// accountPrefix returns the prefix of the keys of the given account.
+func accountPrefix(account string) []byte {
+	key, err := orderedcode.Append(nil, accountTxKeyPrefix, account)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
 func startKeyForCondition(c syntax.Condition, height int64) []byte {
 	if height > 0 {
 		return startKey(c.Tag, c.Arg.Value(), height)
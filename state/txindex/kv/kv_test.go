@@ -739,6 +739,130 @@ func TestTxSearchMultipleTxs(t *testing.T) {
 	require.Len(t, results, 3)
 }
 
+func TestTxIndexAccountTxs(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB())
+
+	// 12 transactions over 4 heights: all are alice's, every other one is
+	// bob's, and the last one is alicex's.
+	var hashes []string
+	for i := 0; i < 12; i++ {
+		accounts := []string{"alice"}
+		if i%2 == 0 {
+			accounts = append(accounts, "bob")
+		}
+		if i == 11 {
+			accounts = append(accounts, "alicex", "")
+		}
+		tx := types.Tx(fmt.Sprintf("tx%d", i))
+		txResult := &abci.TxResult{
+			Height: int64(i/3 + 1),
+			Index:  uint32(i % 3),
+			Tx:     tx,
+			Result: abci.ExecTxResult{Code: abci.CodeTypeOK, IndexKeys: accounts},
+		}
+		require.NoError(t, indexer.Index(txResult))
+		hashes = append(hashes, string(tx.Hash()))
+	}
+
+	accountTxs := func(account string, page, perPage int, desc bool) ([]string, int) {
+		t.Helper()
+		results, total, err := indexer.AccountTxs(context.Background(), account, txindex.Pagination{
+			OrderDesc:   desc,
+			IsPaginated: true,
+			Page:        page,
+			PerPage:     perPage,
+		})
+		require.NoError(t, err)
+		res := make([]string, len(results))
+		for i, r := range results {
+			res[i] = string(types.Tx(r.Tx).Hash())
+		}
+		return res, total
+	}
+
+	res, total := accountTxs("alice", 1, 5, false)
+	assert.Equal(t, 12, total)
+	assert.Equal(t, hashes[:5], res)
+	res, _ = accountTxs("alice", 3, 5, false)
+	assert.Equal(t, hashes[10:], res)
+	res, _ = accountTxs("alice", 1, 5, true)
+	assert.Equal(t, []string{hashes[11], hashes[10], hashes[9], hashes[8], hashes[7]}, res)
+	res, _ = accountTxs("alice", 3, 5, true)
+	assert.Equal(t, []string{hashes[1], hashes[0]}, res)
+
+	res, total = accountTxs("bob", 1, 100, false)
+	assert.Equal(t, 6, total)
+	assert.Equal(t, []string{hashes[0], hashes[2], hashes[4], hashes[6], hashes[8], hashes[10]}, res)
+
+	res, total = accountTxs("alicex", 1, 100, false)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, []string{hashes[11]}, res)
+
+	res, total = accountTxs("carol", 1, 100, false)
+	assert.Zero(t, total)
+	assert.Empty(t, res)
+
+	_, _, err := indexer.AccountTxs(context.Background(), "alice", txindex.Pagination{IsPaginated: true, Page: 4, PerPage: 5})
+	require.Error(t, err)
+
+	// Pruning removes the transactions from the accounts.
+	_, _, err = indexer.Prune(3)
+	require.NoError(t, err)
+	res, total = accountTxs("bob", 1, 100, false)
+	assert.Equal(t, 3, total)
+	assert.Equal(t, []string{hashes[6], hashes[8], hashes[10]}, res)
+	_, total = accountTxs("alice", 1, 100, false)
+	assert.Equal(t, 6, total)
+
+	// Indexing a transaction again at another height moves it, and drops the
+	// accounts it no longer has.
+	reindexed := &abci.TxResult{
+		Height: 5,
+		Tx:     types.Tx("tx11"),
+		Result: abci.ExecTxResult{Code: abci.CodeTypeOK, IndexKeys: []string{"alice", "carol", "carol"}},
+	}
+	for i := 0; i < 2; i++ {
+		require.NoError(t, indexer.Index(reindexed))
+	}
+	res, total = accountTxs("alice", 1, 2, true)
+	assert.Equal(t, 6, total)
+	assert.Equal(t, []string{hashes[11], hashes[10]}, res)
+	res, total = accountTxs("carol", 1, 100, false)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, []string{hashes[11]}, res)
+	res, total = accountTxs("alicex", 1, 100, false)
+	assert.Zero(t, total)
+	assert.Empty(t, res)
+}
+
+func TestTxIndexAccountsDetected(t *testing.T) {
+	store := db.NewMemDB()
+	indexer := NewTxIndex(store)
+	assert.False(t, indexer.accounts.Load())
+
+	txResult := &abci.TxResult{
+		Height: 1,
+		Tx:     types.Tx("tx"),
+		Result: abci.ExecTxResult{Code: abci.CodeTypeOK},
+	}
+	require.NoError(t, indexer.Index(txResult))
+	assert.False(t, indexer.accounts.Load(), "no index keys")
+
+	txResult.Result.IndexKeys = []string{"alice"}
+	require.NoError(t, indexer.AddBatch(&txindex.Batch{Ops: []*abci.TxResult{txResult}}))
+	assert.True(t, indexer.accounts.Load())
+
+	// An indexer opened on the same store finds the accounts, and drops the
+	// stale account keys of re-indexed transactions.
+	indexer = NewTxIndex(store)
+	require.True(t, indexer.accounts.Load())
+	txResult.Height, txResult.Result.IndexKeys = 2, []string{"bob"}
+	require.NoError(t, indexer.Index(txResult))
+	_, total, err := indexer.AccountTxs(context.Background(), "alice", txindex.Pagination{})
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
+
 func txResultWithEvents(events []abci.Event) *abci.TxResult {
 	tx := types.Tx("HELLO WORLD")
 	return &abci.TxResult{
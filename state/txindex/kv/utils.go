@@ -1,6 +1,7 @@
 package kv
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"math/big"
@@ -109,6 +110,19 @@ func checkHeightConditions(heightInfo HeightInfo, keyHeight int64) (bool, error)
 	return true, nil
 }
 
+// prefixEnd returns the end of the range of the keys with the given prefix,
+// or nil if there is none.
+func prefixEnd(prefix []byte) []byte {
+	end := bytes.Clone(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
 func int64FromBytes(bz []byte) int64 {
 	v, _ := binary.Varint(bz)
 	return v
@@ -285,6 +285,14 @@ func (q *chunkQueue) RetryAll() {
 	q.chunkReturned = make(map[uint32]bool)
 }
 
+// Progress returns the number of chunks in the queue, and of the chunks among
+// them returned via Next().
+func (q *chunkQueue) Progress() (fetched, returned uint32) {
+	q.Lock()
+	defer q.Unlock()
+	return uint32(len(q.chunkFiles)), uint32(len(q.chunkReturned))
+}
+
 // Size returns the total number of chunks for the snapshot and queue, or 0 when closed.
 func (q *chunkQueue) Size() uint32 {
 	q.Lock()
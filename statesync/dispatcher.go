@@ -0,0 +1,174 @@
+package statesync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	ssproto "github.com/cometbft/cometbft/api/cometbft/statesync/v1"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	lightprovider "github.com/cometbft/cometbft/light/provider"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+)
+
+// errPeerDisconnected is returned for the requests pending on a peer when it
+// disconnects.
+var errPeerDisconnected = errors.New("peer disconnected")
+
+// dispatchKey identifies a request sent to a peer: its response is expected on
+// the same channel for the same height.
+type dispatchKey struct {
+	channelID byte
+	peerID    p2p.ID
+	height    uint64
+}
+
+// dispatcher sends requests to peers and routes their responses back to the
+// callers waiting for them.
+type dispatcher struct {
+	mtx   cmtsync.Mutex
+	calls map[dispatchKey]chan proto.Message
+}
+
+func newDispatcher() *dispatcher {
+	return &dispatcher{
+		calls: make(map[dispatchKey]chan proto.Message),
+	}
+}
+
+// call sends req to peer on channelID and waits for the response at height.
+// The response is nil if the peer disconnected meanwhile.
+func (d *dispatcher) call(ctx context.Context, peer p2p.Peer, channelID byte, height uint64, req proto.Message) (proto.Message, error) {
+	key := dispatchKey{channelID: channelID, peerID: peer.ID(), height: height}
+
+	d.mtx.Lock()
+	if _, ok := d.calls[key]; ok {
+		d.mtx.Unlock()
+		return nil, fmt.Errorf("a request for height %d is already pending on peer %v", height, peer.ID())
+	}
+	ch := make(chan proto.Message, 1)
+	d.calls[key] = ch
+	d.mtx.Unlock()
+
+	defer func() {
+		d.mtx.Lock()
+		delete(d.calls, key)
+		d.mtx.Unlock()
+	}()
+
+	if !peer.Send(p2p.Envelope{ChannelID: channelID, Message: req}) {
+		return nil, fmt.Errorf("failed to send the request to peer %v", peer.ID())
+	}
+
+	select {
+	case resp, ok := <-ch:
+		if !ok {
+			return nil, errPeerDisconnected
+		}
+		return resp, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// respond hands resp, received from peerID on channelID, to the caller waiting
+// for it. It returns false if no response was expected.
+func (d *dispatcher) respond(channelID byte, peerID p2p.ID, height uint64, resp proto.Message) bool {
+	key := dispatchKey{channelID: channelID, peerID: peerID, height: height}
+
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	ch, ok := d.calls[key]
+	if !ok {
+		return false
+	}
+	delete(d.calls, key)
+	ch <- resp
+	close(ch)
+	return true
+}
+
+// removePeer fails the requests pending on peerID.
+func (d *dispatcher) removePeer(peerID p2p.ID) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	for key, ch := range d.calls {
+		if key.peerID == peerID {
+			delete(d.calls, key)
+			close(ch)
+		}
+	}
+}
+
+// blockProvider is a light client provider fetching the light blocks from a
+// peer over the light block channel.
+type blockProvider struct {
+	peer       p2p.Peer
+	chainID    string
+	dispatcher *dispatcher
+}
+
+var _ lightprovider.Provider = (*blockProvider)(nil)
+
+func newBlockProvider(peer p2p.Peer, chainID string, dispatcher *dispatcher) *blockProvider {
+	return &blockProvider{
+		peer:       peer,
+		chainID:    chainID,
+		dispatcher: dispatcher,
+	}
+}
+
+// ChainID implements lightprovider.Provider.
+func (p *blockProvider) ChainID() string {
+	return p.chainID
+}
+
+// LightBlock implements lightprovider.Provider.
+func (p *blockProvider) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	if height < 0 {
+		return nil, lightprovider.ErrNegativeHeight{Height: height}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, lightBlockResponseTimeout)
+	defer cancel()
+	resp, err := p.dispatcher.call(ctx, p.peer, LightBlockChannel, uint64(height),
+		&ssproto.LightBlockRequest{Height: uint64(height)})
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return nil, lightprovider.ErrNoResponse
+	case err != nil:
+		return nil, err
+	}
+
+	pbLightBlock := resp.(*ssproto.LightBlockResponse).LightBlock
+	if pbLightBlock == nil {
+		return nil, lightprovider.ErrLightBlockNotFound
+	}
+	lightBlock, err := types.LightBlockFromProto(pbLightBlock)
+	if err != nil {
+		return nil, lightprovider.ErrBadLightBlock{Reason: err}
+	}
+	if err := lightBlock.ValidateBasic(p.chainID); err != nil {
+		return nil, lightprovider.ErrBadLightBlock{Reason: err}
+	}
+	if height != 0 && lightBlock.Height != height {
+		return nil, lightprovider.ErrBadLightBlock{
+			Reason: fmt.Errorf("height %d responded does not match height %d requested", lightBlock.Height, height),
+		}
+	}
+	return lightBlock, nil
+}
+
+// ReportEvidence implements lightprovider.Provider. The evidence is not
+// reported to the peer.
+func (*blockProvider) ReportEvidence(context.Context, types.Evidence) error {
+	return nil
+}
+
+// String implements fmt.Stringer.
+func (p *blockProvider) String() string {
+	return fmt.Sprintf("peer{%v}", p.peer.ID())
+}
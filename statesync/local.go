@@ -0,0 +1,316 @@
+package statesync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v1"
+	"github.com/cometbft/cometbft/config"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// LocalSnapshotFile is the name of the file describing a snapshot stored in a
+// directory, next to its chunks, each one stored in a file named after its
+// index.
+const LocalSnapshotFile = "snapshot.json"
+
+// LocalSnapshot describes a snapshot stored in a directory.
+type LocalSnapshot struct {
+	Height   uint64            `json:"height"`
+	Format   uint32            `json:"format"`
+	Chunks   uint32            `json:"chunks"`
+	Hash     cmtbytes.HexBytes `json:"hash"`
+	Metadata []byte            `json:"metadata,omitempty"`
+}
+
+// loadLocalSnapshot loads the snapshot stored in dir, checking that all its
+// chunks are present.
+func loadLocalSnapshot(dir string) (*snapshot, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, LocalSnapshotFile))
+	if err != nil {
+		return nil, fmt.Errorf("reading the snapshot description: %w", err)
+	}
+	var ls LocalSnapshot
+	if err := json.Unmarshal(bz, &ls); err != nil {
+		return nil, fmt.Errorf("decoding the snapshot description: %w", err)
+	}
+	switch {
+	case ls.Height == 0:
+		return nil, errors.New("snapshot height cannot be 0")
+	case ls.Chunks == 0:
+		return nil, errors.New("snapshot has no chunks")
+	case len(ls.Hash) == 0:
+		return nil, errors.New("snapshot has no hash")
+	}
+	for i := uint32(0); i < ls.Chunks; i++ {
+		if _, err := os.Stat(localChunkPath(dir, i)); err != nil {
+			return nil, fmt.Errorf("snapshot chunk %d: %w", i, err)
+		}
+	}
+	return &snapshot{
+		Height:   ls.Height,
+		Format:   ls.Format,
+		Chunks:   ls.Chunks,
+		Hash:     ls.Hash,
+		Metadata: ls.Metadata,
+	}, nil
+}
+
+func localChunkPath(dir string, index uint32) string {
+	return filepath.Join(dir, strconv.FormatUint(uint64(index), 10))
+}
+
+// RestoreLocalSnapshot restores the app from the snapshot stored in dir,
+// without any peer, verifying it with stateProvider as a snapshot fetched from
+// peers. It returns the state and commit at the height of the snapshot, which
+// the caller must use to bootstrap the node.
+func RestoreLocalSnapshot(
+	cfg config.StateSyncConfig,
+	logger log.Logger,
+	conn proxy.AppConnSnapshot,
+	connQuery proxy.AppConnQuery,
+	stateProvider StateProvider,
+	dir string,
+) (sm.State, *types.Commit, error) {
+	snapshot, err := loadLocalSnapshot(dir)
+	if err != nil {
+		return sm.State{}, nil, err
+	}
+	chunks, err := newChunkQueue(snapshot, cfg.TempDir)
+	if err != nil {
+		return sm.State{}, nil, err
+	}
+	defer chunks.Close()
+
+	s := newSyncer(cfg, logger, conn, connQuery, stateProvider, cfg.TempDir, NopMetrics())
+	s.localDir = dir
+	logger.Info("Restoring local snapshot", "height", snapshot.Height, "format", snapshot.Format,
+		"chunks", snapshot.Chunks, "hash", log.NewLazySprintf("%X", snapshot.Hash))
+	return s.Sync(snapshot, chunks)
+}
+
+// LocalLightBlocksFile is the name of the file holding the light blocks
+// verifying a snapshot stored in a directory offline, next to the snapshot
+// description.
+const LocalLightBlocksFile = "light_blocks.json"
+
+// LocalLightBlocks holds the light blocks at the height of a snapshot and the
+// two following heights, and the consensus parameters of the height following
+// the snapshot, from which the state at the height of the snapshot is built.
+type LocalLightBlocks struct {
+	LightBlocks     []*types.LightBlock   `json:"light_blocks"`
+	ConsensusParams types.ConsensusParams `json:"consensus_params"`
+}
+
+// HasLocalLightBlocks returns whether dir holds a LocalLightBlocksFile.
+func HasLocalLightBlocks(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, LocalLightBlocksFile))
+	return err == nil
+}
+
+// localStateProvider is a state provider serving the light blocks stored in a
+// LocalLightBlocksFile, verified offline from a trusted header.
+type localStateProvider struct {
+	chainID       string
+	version       cmtstate.Version
+	initialHeight int64
+	lightBlocks   map[int64]*types.LightBlock
+	params        types.ConsensusParams
+}
+
+// NewLocalStateProvider creates a StateProvider serving the light blocks and
+// the consensus parameters stored in the LocalLightBlocksFile of dir, without
+// any peer or RPC server. The light blocks must be consecutive, and one of
+// them must be the trusted header of trustOptions. The other light blocks are
+// verified from it: the lower ones by their hashes, the higher ones by their
+// commits, signed by the next validators of the light block below. The
+// trusting period is not checked, as the light blocks are not expected to be
+// recent.
+func NewLocalStateProvider(
+	chainID string,
+	version cmtstate.Version,
+	initialHeight int64,
+	trustOptions light.TrustOptions,
+	dir string,
+) (StateProvider, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, LocalLightBlocksFile))
+	if err != nil {
+		return nil, fmt.Errorf("reading the light blocks: %w", err)
+	}
+	var llb LocalLightBlocks
+	if err := cmtjson.Unmarshal(bz, &llb); err != nil {
+		return nil, fmt.Errorf("decoding the light blocks: %w", err)
+	}
+	if err := verifyLocalLightBlocks(chainID, llb.LightBlocks, trustOptions); err != nil {
+		return nil, err
+	}
+	if err := llb.ConsensusParams.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid consensus parameters: %w", err)
+	}
+
+	lightBlocks := make(map[int64]*types.LightBlock, len(llb.LightBlocks))
+	for _, lb := range llb.LightBlocks {
+		lightBlocks[lb.Height] = lb
+	}
+	return &localStateProvider{
+		chainID:       chainID,
+		version:       version,
+		initialHeight: initialHeight,
+		lightBlocks:   lightBlocks,
+		params:        llb.ConsensusParams,
+	}, nil
+}
+
+// verifyLocalLightBlocks verifies lightBlocks from the trusted header of
+// trustOptions, see NewLocalStateProvider.
+func verifyLocalLightBlocks(chainID string, lightBlocks []*types.LightBlock, trustOptions light.TrustOptions) error {
+	trusted := -1
+	for i, lb := range lightBlocks {
+		if lb == nil {
+			return fmt.Errorf("light block %d is empty", i)
+		}
+		if err := lb.ValidateBasic(chainID); err != nil {
+			return fmt.Errorf("invalid light block at height %d: %w", lb.Height, err)
+		}
+		if err := lb.ValidatorSet.VerifyCommitLight(chainID, lb.Commit.BlockID, lb.Height, lb.Commit); err != nil {
+			return fmt.Errorf("invalid commit at height %d: %w", lb.Height, err)
+		}
+		if i > 0 && lb.Height != lightBlocks[i-1].Height+1 {
+			return fmt.Errorf("light block at height %d does not follow the light block at height %d",
+				lb.Height, lightBlocks[i-1].Height)
+		}
+		if lb.Height == trustOptions.Height {
+			if !bytes.Equal(lb.Hash(), trustOptions.Hash) {
+				return fmt.Errorf("light block at the trusted height %d has hash %X, expected %X",
+					lb.Height, lb.Hash(), trustOptions.Hash)
+			}
+			trusted = i
+		}
+	}
+	if trusted < 0 {
+		return fmt.Errorf("no light block at the trusted height %d", trustOptions.Height)
+	}
+
+	for i := trusted; i > 0; i-- {
+		if !bytes.Equal(lightBlocks[i].LastBlockID.Hash, lightBlocks[i-1].Hash()) {
+			return fmt.Errorf("light block at height %d does not match the last block ID of the trusted light block above",
+				lightBlocks[i-1].Height)
+		}
+	}
+	for i := trusted + 1; i < len(lightBlocks); i++ {
+		if !bytes.Equal(lightBlocks[i].ValidatorsHash, lightBlocks[i-1].NextValidatorsHash) {
+			return fmt.Errorf("light block at height %d does not match the next validators hash of the trusted light block below",
+				lightBlocks[i].Height)
+		}
+		if !bytes.Equal(lightBlocks[i].LastBlockID.Hash, lightBlocks[i-1].Hash()) {
+			return fmt.Errorf("light block at height %d does not follow the trusted light block below",
+				lightBlocks[i].Height)
+		}
+	}
+	return nil
+}
+
+// lightBlock returns the light block at height.
+func (s *localStateProvider) lightBlock(_ context.Context, height int64) (*types.LightBlock, error) {
+	lb, ok := s.lightBlocks[height]
+	if !ok {
+		return nil, fmt.Errorf("no light block at height %d in %s", height, LocalLightBlocksFile)
+	}
+	return lb, nil
+}
+
+// AppHash implements StateProvider.
+func (s *localStateProvider) AppHash(ctx context.Context, height uint64) ([]byte, error) {
+	// The state is built from the light blocks up to height+2, which must be
+	// present as for the other state providers.
+	if _, err := s.lightBlock(ctx, int64(height+2)); err != nil {
+		return nil, err
+	}
+	lb, err := s.lightBlock(ctx, int64(height+1))
+	if err != nil {
+		return nil, err
+	}
+	return lb.AppHash, nil
+}
+
+// Commit implements StateProvider.
+func (s *localStateProvider) Commit(ctx context.Context, height uint64) (*types.Commit, error) {
+	lb, err := s.lightBlock(ctx, int64(height))
+	if err != nil {
+		return nil, err
+	}
+	return lb.Commit, nil
+}
+
+// State implements StateProvider.
+func (s *localStateProvider) State(ctx context.Context, height uint64) (sm.State, error) {
+	state, currentLightBlock, err := verifiedState(ctx, s.chainID, s.lightBlock, s.version, s.initialHeight, height)
+	if err != nil {
+		return sm.State{}, err
+	}
+	if !bytes.Equal(s.params.Hash(), currentLightBlock.ConsensusHash) {
+		return sm.State{}, fmt.Errorf("consensus parameters do not match the consensus hash of the light block at height %d",
+			currentLightBlock.Height)
+	}
+	state.ConsensusParams = s.params
+	state.LastHeightConsensusParamsChanged = currentLightBlock.Height
+	return state, nil
+}
+
+// loadChunks loads the chunks of the snapshot stored in s.localDir, receiving
+// allocations from the chunk queue as fetchChunks. If a chunk cannot be
+// loaded, it sends the error to errCh and closes the chunk queue, so that the
+// restore does not wait for the chunk.
+func (s *syncer) loadChunks(ctx context.Context, snapshot *snapshot, chunks *chunkQueue, errCh chan<- error) {
+	fail := func(err error) {
+		errCh <- err
+		if err := chunks.Close(); err != nil {
+			s.logger.Error("Failed to close chunk queue", "err", err)
+		}
+	}
+	for {
+		index, err := chunks.Allocate()
+		if errors.Is(err, errDone) {
+			// Keep checking until the context is canceled (restore is done), in case any
+			// chunks need to be reloaded.
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(100 * time.Millisecond):
+			}
+			continue
+		}
+		if err != nil {
+			fail(fmt.Errorf("failed to allocate chunk from queue: %w", err))
+			return
+		}
+
+		bz, err := os.ReadFile(localChunkPath(s.localDir, index))
+		if err != nil {
+			fail(fmt.Errorf("failed to load snapshot chunk %d: %w", index, err))
+			return
+		}
+		if _, err := s.AddChunk(&chunk{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Index:  index,
+			Chunk:  bz,
+		}); err != nil {
+			fail(fmt.Errorf("failed to add snapshot chunk %d: %w", index, err))
+			return
+		}
+	}
+}
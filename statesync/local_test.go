@@ -0,0 +1,245 @@
+package statesync
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v1"
+	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/test"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/proxy"
+	proxymocks "github.com/cometbft/cometbft/proxy/mocks"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/statesync/mocks"
+	"github.com/cometbft/cometbft/types"
+)
+
+func writeLocalSnapshot(t *testing.T, ls LocalSnapshot, chunks [][]byte) string {
+	t.Helper()
+	dir := t.TempDir()
+	bz, err := json.Marshal(ls)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, LocalSnapshotFile), bz, 0o600))
+	for i, chunk := range chunks {
+		require.NoError(t, os.WriteFile(localChunkPath(dir, uint32(i)), chunk, 0o600))
+	}
+	return dir
+}
+
+func TestRestoreLocalSnapshot(t *testing.T) {
+	state := sm.State{
+		ChainID: "chain",
+		Version: cmtstate.Version{
+			Consensus: cmtversion.Consensus{App: testAppVersion},
+		},
+		LastBlockHeight: 1,
+		AppHash:         []byte("app_hash"),
+	}
+	commit := &types.Commit{BlockID: types.BlockID{Hash: []byte("blockhash")}}
+	ls := LocalSnapshot{Height: 1, Format: 1, Chunks: 2, Hash: []byte{1, 2, 3}, Metadata: []byte{4}}
+	dir := writeLocalSnapshot(t, ls, [][]byte{{1, 1, 0}, {1, 1, 1}})
+
+	stateProvider := &mocks.StateProvider{}
+	stateProvider.On("AppHash", mock.Anything, uint64(1)).Return(state.AppHash, nil)
+	stateProvider.On("Commit", mock.Anything, uint64(1)).Return(commit, nil)
+	stateProvider.On("State", mock.Anything, uint64(1)).Return(state, nil)
+	connSnapshot := &proxymocks.AppConnSnapshot{}
+	connQuery := &proxymocks.AppConnQuery{}
+	connSnapshot.On("OfferSnapshot", mock.Anything, &abci.OfferSnapshotRequest{
+		Snapshot: &abci.Snapshot{Height: 1, Format: 1, Chunks: 2, Hash: []byte{1, 2, 3}, Metadata: []byte{4}},
+		AppHash:  []byte("app_hash"),
+	}).Return(&abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_ACCEPT}, nil)
+	// The app asks for the first chunk again, which is loaded again.
+	connSnapshot.On("ApplySnapshotChunk", mock.Anything, &abci.ApplySnapshotChunkRequest{
+		Index: 0, Chunk: []byte{1, 1, 0},
+	}).Times(2).Return(&abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT}, nil)
+	connSnapshot.On("ApplySnapshotChunk", mock.Anything, &abci.ApplySnapshotChunkRequest{
+		Index: 1, Chunk: []byte{1, 1, 1},
+	}).Once().Return(&abci.ApplySnapshotChunkResponse{
+		Result:        abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT,
+		RefetchChunks: []uint32{0},
+	}, nil)
+	connQuery.On("Info", mock.Anything, proxy.InfoRequest).Return(&abci.InfoResponse{
+		AppVersion:       testAppVersion,
+		LastBlockHeight:  1,
+		LastBlockAppHash: []byte("app_hash"),
+	}, nil)
+
+	cfg := config.DefaultStateSyncConfig()
+	newState, lastCommit, err := RestoreLocalSnapshot(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, dir)
+	require.NoError(t, err)
+	assert.Equal(t, state, newState)
+	assert.Equal(t, commit, lastCommit)
+	connSnapshot.AssertExpectations(t)
+	connQuery.AssertExpectations(t)
+}
+
+func TestRestoreLocalSnapshot_Invalid(t *testing.T) {
+	cfg := config.DefaultStateSyncConfig()
+	restore := func(dir string) error {
+		_, _, err := RestoreLocalSnapshot(*cfg, log.NewNopLogger(), &proxymocks.AppConnSnapshot{},
+			&proxymocks.AppConnQuery{}, &mocks.StateProvider{}, dir)
+		return err
+	}
+
+	// No snapshot description.
+	require.Error(t, restore(t.TempDir()))
+	// A chunk is missing.
+	require.ErrorContains(t, restore(writeLocalSnapshot(t, LocalSnapshot{Height: 1, Format: 1, Chunks: 2, Hash: []byte{1}},
+		[][]byte{{1}})), "snapshot chunk 1")
+	// The snapshot has no hash.
+	require.ErrorContains(t, restore(writeLocalSnapshot(t, LocalSnapshot{Height: 1, Format: 1, Chunks: 1},
+		[][]byte{{1}})), "no hash")
+}
+
+func TestRestoreLocalSnapshot_ChunkError(t *testing.T) {
+	ls := LocalSnapshot{Height: 1, Format: 1, Chunks: 2, Hash: []byte{1, 2, 3}}
+	dir := writeLocalSnapshot(t, ls, [][]byte{{1, 1, 0}})
+	// The second chunk cannot be read.
+	require.NoError(t, os.Mkdir(localChunkPath(dir, 1), 0o700))
+
+	stateProvider := &mocks.StateProvider{}
+	stateProvider.On("AppHash", mock.Anything, uint64(1)).Return([]byte("app_hash"), nil)
+	stateProvider.On("Commit", mock.Anything, uint64(1)).Return(&types.Commit{}, nil)
+	stateProvider.On("State", mock.Anything, uint64(1)).Return(sm.State{}, nil)
+	connSnapshot := &proxymocks.AppConnSnapshot{}
+	connSnapshot.On("OfferSnapshot", mock.Anything, mock.Anything).
+		Return(&abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_ACCEPT}, nil)
+	connSnapshot.On("ApplySnapshotChunk", mock.Anything, mock.Anything).Maybe().
+		Return(&abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT}, nil)
+
+	cfg := config.DefaultStateSyncConfig()
+	_, _, err := RestoreLocalSnapshot(*cfg, log.NewNopLogger(), connSnapshot, &proxymocks.AppConnQuery{}, stateProvider, dir)
+	require.ErrorContains(t, err, "failed to load snapshot chunk 1")
+}
+
+// makeLocalLightBlocks returns the consecutive light blocks from height 1 to n,
+// signed by a single validator, and their consensus parameters.
+func makeLocalLightBlocks(t *testing.T, n int64) ([]*types.LightBlock, types.ConsensusParams) {
+	t.Helper()
+	vals, privVals := test.ValidatorSet(context.Background(), t, 1, 10)
+	params := *types.DefaultConsensusParams()
+	lightBlocks := make([]*types.LightBlock, 0, n)
+	lastBlockID := types.BlockID{}
+	for h := int64(1); h <= n; h++ {
+		header := test.MakeHeader(t, &types.Header{
+			ChainID:            test.DefaultTestChainID,
+			Height:             h,
+			LastBlockID:        lastBlockID,
+			ValidatorsHash:     vals.Hash(),
+			NextValidatorsHash: vals.Hash(),
+			ConsensusHash:      params.Hash(),
+			ProposerAddress:    vals.Proposer.Address,
+		})
+		blockID := types.BlockID{Hash: header.Hash(), PartSetHeader: types.PartSetHeader{Total: 1, Hash: test.RandomHash()}}
+		commit, err := test.MakeCommit(blockID, h, 0, vals, privVals, header.ChainID, time.Now())
+		require.NoError(t, err)
+		lightBlocks = append(lightBlocks, &types.LightBlock{
+			SignedHeader: &types.SignedHeader{Header: header, Commit: commit},
+			ValidatorSet: vals,
+		})
+		lastBlockID = blockID
+	}
+	return lightBlocks, params
+}
+
+func writeLocalLightBlocks(t *testing.T, dir string, llb LocalLightBlocks) {
+	t.Helper()
+	bz, err := cmtjson.Marshal(llb)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, LocalLightBlocksFile), bz, 0o600))
+}
+
+func TestLocalStateProvider(t *testing.T) {
+	lightBlocks, params := makeLocalLightBlocks(t, 3)
+	dir := t.TempDir()
+	assert.False(t, HasLocalLightBlocks(dir))
+	writeLocalLightBlocks(t, dir, LocalLightBlocks{LightBlocks: lightBlocks, ConsensusParams: params})
+	assert.True(t, HasLocalLightBlocks(dir))
+
+	// Any of the light blocks can be the trusted header.
+	for _, trusted := range lightBlocks {
+		sp, err := NewLocalStateProvider(test.DefaultTestChainID, cmtstate.Version{}, 1,
+			light.TrustOptions{Height: trusted.Height, Hash: trusted.Hash()}, dir)
+		require.NoError(t, err, trusted.Height)
+
+		appHash, err := sp.AppHash(context.Background(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(lightBlocks[1].AppHash), appHash)
+		commit, err := sp.Commit(context.Background(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, lightBlocks[0].Commit.BlockID, commit.BlockID)
+		assert.Equal(t, lightBlocks[0].Commit.Hash(), commit.Hash())
+		state, err := sp.State(context.Background(), 1)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, state.LastBlockHeight)
+		assert.Equal(t, lightBlocks[0].Commit.BlockID, state.LastBlockID)
+		assert.Equal(t, params, state.ConsensusParams)
+
+		// The light blocks above height 3 are missing.
+		_, err = sp.AppHash(context.Background(), 2)
+		require.Error(t, err)
+	}
+
+	// The trusted header must be in the file, and match the trusted hash.
+	_, err := NewLocalStateProvider(test.DefaultTestChainID, cmtstate.Version{}, 1,
+		light.TrustOptions{Height: 4, Hash: test.RandomHash()}, dir)
+	require.ErrorContains(t, err, "no light block at the trusted height")
+	_, err = NewLocalStateProvider(test.DefaultTestChainID, cmtstate.Version{}, 1,
+		light.TrustOptions{Height: 2, Hash: test.RandomHash()}, dir)
+	require.ErrorContains(t, err, "expected")
+}
+
+func TestLocalStateProvider_Invalid(t *testing.T) {
+	lightBlocks, params := makeLocalLightBlocks(t, 3)
+	otherBlocks, _ := makeLocalLightBlocks(t, 3)
+	trustOptions := light.TrustOptions{Height: 2, Hash: lightBlocks[1].Hash()}
+	newProvider := func(llb LocalLightBlocks) error {
+		dir := t.TempDir()
+		writeLocalLightBlocks(t, dir, llb)
+		_, err := NewLocalStateProvider(test.DefaultTestChainID, cmtstate.Version{}, 1, trustOptions, dir)
+		return err
+	}
+
+	// A light block below the trusted one, not matching its last block ID.
+	err := newProvider(LocalLightBlocks{
+		LightBlocks:     []*types.LightBlock{otherBlocks[0], lightBlocks[1], lightBlocks[2]},
+		ConsensusParams: params,
+	})
+	require.ErrorContains(t, err, "last block ID")
+	// A light block above the trusted one, signed by other validators.
+	err = newProvider(LocalLightBlocks{
+		LightBlocks:     []*types.LightBlock{lightBlocks[0], lightBlocks[1], otherBlocks[2]},
+		ConsensusParams: params,
+	})
+	require.ErrorContains(t, err, "next validators hash")
+	// Light blocks that are not consecutive.
+	err = newProvider(LocalLightBlocks{
+		LightBlocks:     []*types.LightBlock{lightBlocks[1], lightBlocks[0]},
+		ConsensusParams: params,
+	})
+	require.ErrorContains(t, err, "does not follow")
+
+	// Consensus parameters not matching the consensus hash.
+	dir := t.TempDir()
+	otherParams := params
+	otherParams.Block.MaxGas = 100
+	writeLocalLightBlocks(t, dir, LocalLightBlocks{LightBlocks: lightBlocks, ConsensusParams: otherParams})
+	sp, err := NewLocalStateProvider(test.DefaultTestChainID, cmtstate.Version{}, 1, trustOptions, dir)
+	require.NoError(t, err)
+	_, err = sp.State(context.Background(), 1)
+	require.ErrorContains(t, err, "consensus parameters")
+}
@@ -20,11 +20,69 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "syncing",
 			Help:      "Whether or not a node is state syncing. 1 if yes, 0 if no.",
 		}, labels).With(labelsAndValues...),
+		TotalChunks: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "total_chunks",
+			Help:      "The number of chunks of the snapshot being restored.",
+		}, labels).With(labelsAndValues...),
+		FetchedChunks: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "fetched_chunks",
+			Help:      "The number of chunks of the snapshot being restored fetched from peers.",
+		}, labels).With(labelsAndValues...),
+		AppliedChunks: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "applied_chunks",
+			Help:      "The number of chunks of the snapshot being restored applied to the app.",
+		}, labels).With(labelsAndValues...),
+		ChunkBytesPerSecond: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "chunk_bytes_per_second",
+			Help:      "The rate at which the chunks are fetched, in bytes per second.",
+		}, labels).With(labelsAndValues...),
+		RemainingSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "remaining_seconds",
+			Help:      "The estimated time left to fetch the remaining chunks, in seconds.",
+		}, labels).With(labelsAndValues...),
+		ChunkFetchDurationSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "chunk_fetch_duration_seconds",
+			Help:      "The time between the request of a chunk to a peer and its reception.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.1, 100, 8),
+		}, labels).With(labelsAndValues...),
+		HedgedChunkRequests: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "hedged_chunk_requests",
+			Help:      "The number of chunk requests sent to a second peer because the first one was slow to respond.",
+		}, labels).With(labelsAndValues...),
+		FailedChunkRequests: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "failed_chunk_requests",
+			Help:      "The number of chunk requests that failed, the peer timing out or not having the chunk.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Syncing: discard.NewGauge(),
+		Syncing:                   discard.NewGauge(),
+		TotalChunks:               discard.NewGauge(),
+		FetchedChunks:             discard.NewGauge(),
+		AppliedChunks:             discard.NewGauge(),
+		ChunkBytesPerSecond:       discard.NewGauge(),
+		RemainingSeconds:          discard.NewGauge(),
+		ChunkFetchDurationSeconds: discard.NewHistogram(),
+		HedgedChunkRequests:       discard.NewCounter(),
+		FailedChunkRequests:       discard.NewCounter(),
 	}
 }
@@ -16,4 +16,22 @@ const (
 type Metrics struct {
 	// Whether or not a node is state syncing. 1 if yes, 0 if no.
 	Syncing metrics.Gauge
+	// The number of chunks of the snapshot being restored.
+	TotalChunks metrics.Gauge
+	// The number of chunks of the snapshot being restored fetched from peers.
+	FetchedChunks metrics.Gauge
+	// The number of chunks of the snapshot being restored applied to the app.
+	AppliedChunks metrics.Gauge
+	// The rate at which the chunks are fetched, in bytes per second.
+	ChunkBytesPerSecond metrics.Gauge
+	// The estimated time left to fetch the remaining chunks, in seconds.
+	RemainingSeconds metrics.Gauge
+	// The time between the request of a chunk to a peer and its reception.
+	ChunkFetchDurationSeconds metrics.Histogram `metrics_bucketsizes:"0.1, 100, 8" metrics_buckettype:"exprange"`
+	// The number of chunk requests sent to a second peer because the first
+	// one was slow to respond.
+	HedgedChunkRequests metrics.Counter
+	// The number of chunk requests that failed, the peer timing out or not
+	// having the chunk.
+	FailedChunkRequests metrics.Counter
 }
@@ -0,0 +1,202 @@
+package statesync
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"sort"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+const (
+	// latencySamples is the number of the latest chunk latencies, of all the
+	// peers, the hedging deadline is computed from.
+	latencySamples = 100
+	// minHedgeSamples is the number of chunk latencies to observe before
+	// hedging requests.
+	minHedgeSamples = 5
+	// hedgePercentile is the percentile of the observed chunk latencies after
+	// which a chunk is requested from a second peer.
+	hedgePercentile = 0.9
+	// minHedgeDelay is the minimum time to wait for a chunk before requesting
+	// it from a second peer.
+	minHedgeDelay = 500 * time.Millisecond
+	// latencyWeight is the weight of a new latency in the moving average of
+	// the chunk latencies of a peer.
+	latencyWeight = 0.2
+)
+
+// chunkRequestKey identifies a chunk requested from a peer.
+type chunkRequestKey struct {
+	peerID p2p.ID
+	index  uint32
+}
+
+// peerStat is the performance of a peer serving chunks.
+type peerStat struct {
+	latency   time.Duration // moving average of the chunk latencies
+	successes int
+	failures  int
+	pending   int
+}
+
+// score returns the expected time for the peer to serve a chunk, the lower
+// the better: its average latency, scaled up by its failure rate and the
+// requests pending on it. Peers not tried yet score about zero, so that they
+// are tried, while peers having only failed score last.
+func (s *peerStat) score() float64 {
+	switch {
+	case s.successes == 0 && s.failures == 0:
+		return float64(s.pending)
+	case s.successes == 0:
+		return math.Inf(1)
+	}
+	successRate := float64(s.successes+1) / float64(s.successes+s.failures+2)
+	return float64(s.latency) / successRate * float64(s.pending+1)
+}
+
+// peerStats tracks the latency and failure rate of the peers serving the
+// chunks of a snapshot, to rank them and to decide when to hedge a request.
+type peerStats struct {
+	mtx       cmtsync.Mutex
+	peers     map[p2p.ID]*peerStat
+	requests  map[chunkRequestKey]time.Time
+	latencies []time.Duration // ring of the latest chunk latencies
+	next      int
+}
+
+func newPeerStats() *peerStats {
+	return &peerStats{
+		peers:    make(map[p2p.ID]*peerStat),
+		requests: make(map[chunkRequestKey]time.Time),
+	}
+}
+
+func (ps *peerStats) peer(peerID p2p.ID) *peerStat {
+	stat, ok := ps.peers[peerID]
+	if !ok {
+		stat = &peerStat{}
+		ps.peers[peerID] = stat
+	}
+	return stat
+}
+
+// Requested records the request of chunk index to peerID.
+func (ps *peerStats) Requested(peerID p2p.ID, index uint32) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	key := chunkRequestKey{peerID: peerID, index: index}
+	if _, ok := ps.requests[key]; ok {
+		return
+	}
+	ps.requests[key] = time.Now()
+	ps.peer(peerID).pending++
+}
+
+// Received records the reception of chunk index from peerID, and returns the
+// time it took. It returns false if the chunk was not requested from the peer.
+func (ps *peerStats) Received(peerID p2p.ID, index uint32) (time.Duration, bool) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	key := chunkRequestKey{peerID: peerID, index: index}
+	requested, ok := ps.requests[key]
+	if !ok {
+		return 0, false
+	}
+	delete(ps.requests, key)
+
+	latency := time.Since(requested)
+	stat := ps.peer(peerID)
+	stat.pending--
+	if stat.successes == 0 {
+		stat.latency = latency
+	} else {
+		stat.latency = time.Duration(latencyWeight*float64(latency) + (1-latencyWeight)*float64(stat.latency))
+	}
+	stat.successes++
+
+	if len(ps.latencies) < latencySamples {
+		ps.latencies = append(ps.latencies, latency)
+	} else {
+		ps.latencies[ps.next] = latency
+		ps.next = (ps.next + 1) % latencySamples
+	}
+	return latency, true
+}
+
+// Failed records that peerID failed to serve chunk index, timing out or not
+// having it. It returns false if the chunk was not requested from the peer.
+func (ps *peerStats) Failed(peerID p2p.ID, index uint32) bool {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	key := chunkRequestKey{peerID: peerID, index: index}
+	if _, ok := ps.requests[key]; !ok {
+		return false
+	}
+	delete(ps.requests, key)
+	stat := ps.peer(peerID)
+	stat.pending--
+	stat.failures++
+	return true
+}
+
+// Cancel forgets the pending requests of chunk index, e.g. once it was
+// received from another peer, without counting them as failures.
+func (ps *peerStats) Cancel(index uint32) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	for key := range ps.requests {
+		if key.index == index {
+			delete(ps.requests, key)
+			ps.peer(key.peerID).pending--
+		}
+	}
+}
+
+// RemovePeer forgets peerID.
+func (ps *peerStats) RemovePeer(peerID p2p.ID) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	delete(ps.peers, peerID)
+	for key := range ps.requests {
+		if key.peerID == peerID {
+			delete(ps.requests, key)
+		}
+	}
+}
+
+// Rank returns peers ordered from the best to the worst. Peers of equal
+// scores are shuffled, to spread the requests among them.
+func (ps *peerStats) Rank(peers []p2p.Peer) []p2p.Peer {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	ranked := slices.Clone(peers)
+	rand.Shuffle(len(ranked), func(i, j int) { ranked[i], ranked[j] = ranked[j], ranked[i] }) //nolint:gosec // G404: Use of weak random number generator
+	scores := make(map[p2p.ID]float64, len(ranked))
+	for _, peer := range ranked {
+		scores[peer.ID()] = ps.peer(peer.ID()).score()
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[ranked[i].ID()] < scores[ranked[j].ID()]
+	})
+	return ranked
+}
+
+// HedgeDelay returns the time to wait for a chunk before requesting it from a
+// second peer: the hedgePercentile of the latest chunk latencies, within
+// minHedgeDelay and maxDelay. It returns maxDelay until enough latencies were
+// observed.
+func (ps *peerStats) HedgeDelay(maxDelay time.Duration) time.Duration {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	if len(ps.latencies) < minHedgeSamples {
+		return maxDelay
+	}
+	latencies := slices.Clone(ps.latencies)
+	slices.Sort(latencies)
+	delay := latencies[int(hedgePercentile*float64(len(latencies)-1))]
+	return min(max(delay, minHedgeDelay), maxDelay)
+}
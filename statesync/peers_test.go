@@ -0,0 +1,85 @@
+package statesync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/p2p"
+)
+
+func TestPeerStats_Rank(t *testing.T) {
+	stats := newPeerStats()
+	peers := []p2p.Peer{simplePeer("fast"), simplePeer("slow"), simplePeer("failing"), simplePeer("new")}
+
+	stats.Requested("fast", 0)
+	stats.Requested("slow", 1)
+	stats.Requested("failing", 2)
+	time.Sleep(10 * time.Millisecond)
+	_, ok := stats.Received("fast", 0)
+	require.True(t, ok)
+	time.Sleep(50 * time.Millisecond)
+	_, ok = stats.Received("slow", 1)
+	require.True(t, ok)
+	require.True(t, stats.Failed("failing", 2))
+
+	// The chunk was not requested from the peer.
+	_, ok = stats.Received("fast", 1)
+	assert.False(t, ok)
+	assert.False(t, stats.Failed("failing", 2))
+
+	ranked := stats.Rank(peers)
+	require.Len(t, ranked, 4)
+	// The new peer is tried first, the failing one last, as it did not serve
+	// any chunk.
+	assert.EqualValues(t, "new", ranked[0].ID())
+	assert.EqualValues(t, "fast", ranked[1].ID())
+	assert.EqualValues(t, "slow", ranked[2].ID())
+	assert.EqualValues(t, "failing", ranked[3].ID())
+
+	// The pending requests spread the load among the peers.
+	stats.Requested("new", 3)
+	stats.Requested("fast", 4)
+	stats.Requested("fast", 5)
+	stats.Requested("fast", 6)
+	stats.Requested("fast", 7)
+	stats.Requested("fast", 8)
+	stats.Requested("fast", 9)
+	ranked = stats.Rank(peers)
+	assert.EqualValues(t, "new", ranked[0].ID())
+	assert.EqualValues(t, "slow", ranked[1].ID())
+
+	stats.Cancel(4)
+	stats.RemovePeer("new")
+	ranked = stats.Rank(peers)
+	assert.EqualValues(t, "new", ranked[0].ID())
+	assert.Empty(t, stats.peers["new"].pending)
+}
+
+func TestPeerStats_HedgeDelay(t *testing.T) {
+	stats := newPeerStats()
+	maxDelay := 10 * time.Second
+
+	// Not enough samples.
+	for i := uint32(0); i < minHedgeSamples-1; i++ {
+		stats.Requested("a", i)
+		_, ok := stats.Received("a", i)
+		require.True(t, ok)
+	}
+	assert.Equal(t, maxDelay, stats.HedgeDelay(maxDelay))
+
+	// The latencies are below the minimum delay.
+	stats.Requested("a", minHedgeSamples)
+	_, ok := stats.Received("a", minHedgeSamples)
+	require.True(t, ok)
+	assert.Equal(t, minHedgeDelay, stats.HedgeDelay(maxDelay))
+
+	stats.latencies = nil
+	for i := 1; i <= 10; i++ {
+		stats.latencies = append(stats.latencies, time.Duration(i)*time.Second)
+	}
+	assert.Equal(t, 9*time.Second, stats.HedgeDelay(maxDelay))
+	assert.Equal(t, 5*time.Second, stats.HedgeDelay(5*time.Second))
+}
@@ -3,15 +3,20 @@
 package statesync
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v1"
 	ssproto "github.com/cometbft/cometbft/api/cometbft/statesync/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/cometbft/cometbft/config"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/light"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/proxy"
 	sm "github.com/cometbft/cometbft/state"
@@ -23,8 +28,21 @@ const (
 	SnapshotChannel = byte(0x60)
 	// ChunkChannel exchanges chunk contents.
 	ChunkChannel = byte(0x61)
+	// LightBlockChannel exchanges light blocks, for the p2p state provider.
+	LightBlockChannel = byte(0x62)
+	// ParamsChannel exchanges consensus parameters, for the p2p state provider.
+	ParamsChannel = byte(0x63)
 	// recentSnapshots is the number of recent snapshots to send and receive per peer.
 	recentSnapshots = 10
+	// lightBlockResponseTimeout is how long to wait for a peer to respond to
+	// a light block request.
+	lightBlockResponseTimeout = 10 * time.Second
+	// paramsResponseTimeout is how long to wait for a peer to respond to a
+	// consensus parameters request.
+	paramsResponseTimeout = 10 * time.Second
+	// minLightBlockPeers is the number of peers serving light blocks the p2p
+	// state provider needs: a primary and a witness.
+	minLightBlockPeers = 2
 )
 
 // Reactor handles state sync, both restoring snapshots for the local node and serving snapshots
@@ -38,26 +56,51 @@ type Reactor struct {
 	tempDir   string
 	metrics   *Metrics
 
+	// The stores serve the light blocks and consensus parameters requested
+	// by the p2p state providers of peers, if set.
+	stateStore sm.Store
+	blockStore sm.BlockStore
+	// dispatcher routes the responses to the requests of the p2p state
+	// provider.
+	dispatcher *dispatcher
+
 	// This will only be set when a state sync is in progress. It is used to feed received
 	// snapshots and chunks into the sync.
 	mtx    cmtsync.RWMutex
 	syncer *syncer
 }
 
+// ReactorOption sets an optional parameter on the Reactor.
+type ReactorOption func(*Reactor)
+
+// WithStores sets the stores the reactor serves the light blocks and the
+// consensus parameters from.
+func WithStores(stateStore sm.Store, blockStore sm.BlockStore) ReactorOption {
+	return func(r *Reactor) {
+		r.stateStore = stateStore
+		r.blockStore = blockStore
+	}
+}
+
 // NewReactor creates a new state sync reactor.
 func NewReactor(
 	cfg config.StateSyncConfig,
 	conn proxy.AppConnSnapshot,
 	connQuery proxy.AppConnQuery,
 	metrics *Metrics,
+	options ...ReactorOption,
 ) *Reactor {
 	r := &Reactor{
-		cfg:       cfg,
-		conn:      conn,
-		connQuery: connQuery,
-		metrics:   metrics,
+		cfg:        cfg,
+		conn:       conn,
+		connQuery:  connQuery,
+		metrics:    metrics,
+		dispatcher: newDispatcher(),
 	}
 	r.BaseReactor = *p2p.NewBaseReactor("StateSync", r)
+	for _, option := range options {
+		option(r)
+	}
 
 	return r
 }
@@ -79,6 +122,20 @@ func (*Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			RecvMessageCapacity: chunkMsgSize,
 			MessageType:         &ssproto.Message{},
 		},
+		{
+			ID:                  LightBlockChannel,
+			Priority:            5,
+			SendQueueCapacity:   10,
+			RecvMessageCapacity: lightBlockMsgSize,
+			MessageType:         &ssproto.Message{},
+		},
+		{
+			ID:                  ParamsChannel,
+			Priority:            2,
+			SendQueueCapacity:   10,
+			RecvMessageCapacity: paramsMsgSize,
+			MessageType:         &ssproto.Message{},
+		},
 	}
 }
 
@@ -98,6 +155,8 @@ func (r *Reactor) AddPeer(peer p2p.Peer) {
 
 // RemovePeer implements p2p.Reactor.
 func (r *Reactor) RemovePeer(peer p2p.Peer, _ any) {
+	r.dispatcher.removePeer(peer.ID())
+
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
 	if r.syncer != nil {
@@ -203,6 +262,12 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 				r.Logger.Debug("Received unexpected chunk, no state sync in progress", "peer", e.Src.ID())
 				return
 			}
+			if msg.Missing {
+				r.Logger.Debug("Peer does not have the requested chunk", "height", msg.Height, "format", msg.Format,
+					"chunk", msg.Index, "peer", e.Src.ID())
+				r.syncer.MissingChunk(e.Src.ID(), msg.Index)
+				return
+			}
 			r.Logger.Debug("Received chunk, adding to sync", "height", msg.Height, "format", msg.Format,
 				"chunk", msg.Index, "peer", e.Src.ID())
 			_, err := r.syncer.AddChunk(&chunk{
@@ -222,11 +287,104 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 			r.Logger.Error("Received unknown message %T", msg)
 		}
 
+	case LightBlockChannel:
+		switch msg := e.Message.(type) {
+		case *ssproto.LightBlockRequest:
+			r.Logger.Debug("Received light block request", "height", msg.Height, "peer", e.Src.ID())
+			lightBlock, err := r.fetchLightBlock(msg.Height)
+			if err != nil {
+				r.Logger.Error("Failed to fetch light block", "height", msg.Height, "err", err)
+				return
+			}
+			e.Src.Send(p2p.Envelope{
+				ChannelID: LightBlockChannel,
+				Message: &ssproto.LightBlockResponse{
+					Height:     msg.Height,
+					LightBlock: lightBlock,
+				},
+			})
+
+		case *ssproto.LightBlockResponse:
+			if !r.dispatcher.respond(LightBlockChannel, e.Src.ID(), msg.Height, msg) {
+				r.Logger.Debug("Received unexpected light block", "height", msg.Height, "peer", e.Src.ID())
+			}
+
+		default:
+			r.Logger.Error("Received unknown message %T", msg)
+		}
+
+	case ParamsChannel:
+		switch msg := e.Message.(type) {
+		case *ssproto.ParamsRequest:
+			r.Logger.Debug("Received consensus params request", "height", msg.Height, "peer", e.Src.ID())
+			if r.stateStore == nil {
+				return
+			}
+			params, err := r.stateStore.LoadConsensusParams(int64(msg.Height))
+			if err != nil {
+				r.Logger.Error("Failed to fetch consensus params", "height", msg.Height, "err", err)
+				return
+			}
+			e.Src.Send(p2p.Envelope{
+				ChannelID: ParamsChannel,
+				Message: &ssproto.ParamsResponse{
+					Height:          msg.Height,
+					ConsensusParams: params.ToProto(),
+				},
+			})
+
+		case *ssproto.ParamsResponse:
+			if !r.dispatcher.respond(ParamsChannel, e.Src.ID(), msg.Height, msg) {
+				r.Logger.Debug("Received unexpected consensus params", "height", msg.Height, "peer", e.Src.ID())
+			}
+
+		default:
+			r.Logger.Error("Received unknown message %T", msg)
+		}
+
 	default:
 		r.Logger.Error("Received message on invalid channel %x", e.ChannelID)
 	}
 }
 
+// fetchLightBlock loads the light block at height, or at the latest height if
+// height is 0, from the stores. It returns nil if the light block is not
+// available.
+func (r *Reactor) fetchLightBlock(height uint64) (*cmtproto.LightBlock, error) {
+	if r.stateStore == nil || r.blockStore == nil {
+		return nil, nil
+	}
+
+	h := int64(height)
+	if h == 0 {
+		h = r.blockStore.Height()
+	}
+	meta := r.blockStore.LoadBlockMeta(h)
+	if meta == nil {
+		return nil, nil
+	}
+	commit := r.blockStore.LoadBlockCommit(h)
+	if commit == nil {
+		commit = r.blockStore.LoadSeenCommit(h)
+	}
+	if commit == nil {
+		return nil, nil
+	}
+	vals, err := r.stateStore.LoadValidators(h)
+	if err != nil {
+		return nil, err
+	}
+
+	lightBlock := &types.LightBlock{
+		SignedHeader: &types.SignedHeader{
+			Header: &meta.Header,
+			Commit: commit,
+		},
+		ValidatorSet: vals,
+	}
+	return lightBlock.ToProto()
+}
+
 // recentSnapshots fetches the n most recent snapshots from the app.
 func (r *Reactor) recentSnapshots(n uint32) ([]*snapshot, error) {
 	resp, err := r.conn.ListSnapshots(context.TODO(), &abci.ListSnapshotsRequest{})
@@ -261,6 +419,87 @@ func (r *Reactor) recentSnapshots(n uint32) ([]*snapshot, error) {
 	return snapshots, nil
 }
 
+// NewP2PStateProvider creates a state provider fetching the light blocks and
+// the consensus parameters from the peers of the reactor, over the light block
+// and params channels. It waits for at least 2 such peers to be connected.
+func (r *Reactor) NewP2PStateProvider(
+	ctx context.Context,
+	chainID string,
+	version cmtstate.Version,
+	initialHeight int64,
+	trustOptions light.TrustOptions,
+	dbKeyLayoutVersion string,
+) (StateProvider, error) {
+	peers, err := r.waitForLightBlockPeers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newP2PStateProvider(ctx, chainID, version, initialHeight, peers, r.dispatcher,
+		trustOptions, r.Logger.With("module", "light"), dbKeyLayoutVersion)
+}
+
+// waitForLightBlockPeers waits for minLightBlockPeers peers serving light
+// blocks to be connected, and returns them.
+func (r *Reactor) waitForLightBlockPeers(ctx context.Context) ([]p2p.Peer, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		peers := r.lightBlockPeers()
+		if len(peers) >= minLightBlockPeers {
+			return peers, nil
+		}
+		r.Logger.Debug("Waiting for peers serving light blocks", "have", len(peers), "need", minLightBlockPeers)
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for %d peers serving light blocks: %w", minLightBlockPeers, ctx.Err())
+		case <-r.Quit():
+			return nil, errors.New("reactor stopped while waiting for peers serving light blocks")
+		}
+	}
+}
+
+// lightBlockPeers returns the connected peers serving light blocks.
+func (r *Reactor) lightBlockPeers() []p2p.Peer {
+	var peers []p2p.Peer
+	for _, peer := range r.Switch.Peers().Copy() {
+		if ni, ok := peer.NodeInfo().(interface{ HasChannel(chID byte) bool }); ok && ni.HasChannel(LightBlockChannel) {
+			peers = append(peers, peer)
+		}
+	}
+	return peers
+}
+
+// ValidatorSet asks the peers serving light blocks, one after the other, for
+// the light block of chainID at height, until one of them provides a light
+// block with a validator set of the given hash, and returns that set. It is
+// used to backfill the validator sets of the blocks preceding the snapshot.
+func (r *Reactor) ValidatorSet(ctx context.Context, chainID string, height int64, hash []byte) (*types.ValidatorSet, error) {
+	return r.fetchValidatorSet(ctx, r.lightBlockPeers(), chainID, height, hash)
+}
+
+// fetchValidatorSet asks peers for the validator set, see ValidatorSet.
+func (r *Reactor) fetchValidatorSet(ctx context.Context, peers []p2p.Peer, chainID string, height int64, hash []byte,
+) (*types.ValidatorSet, error) {
+	for _, peer := range peers {
+		lightBlock, err := newBlockProvider(peer, chainID, r.dispatcher).LightBlock(ctx, height)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if err != nil {
+			r.Logger.Debug("Failed to fetch light block", "peer", peer.ID(), "height", height, "err", err)
+			continue
+		}
+		if !bytes.Equal(lightBlock.ValidatorSet.Hash(), hash) {
+			r.Logger.Info("Peer sent a validator set not matching the trusted header", "peer", peer.ID(), "height", height)
+			continue
+		}
+		return lightBlock.ValidatorSet, nil
+	}
+	return nil, fmt.Errorf("no peer provided the validator set at height %d", height)
+}
+
 // Sync runs a state sync, returning the new state and last commit at the snapshot height.
 // The caller must store the state and commit in the state database and block store.
 func (r *Reactor) Sync(stateProvider StateProvider, discoveryTime time.Duration) (sm.State, *types.Commit, error) {
@@ -270,7 +509,7 @@ func (r *Reactor) Sync(stateProvider StateProvider, discoveryTime time.Duration)
 		return sm.State{}, nil, errors.New("a state sync is already in progress")
 	}
 	r.metrics.Syncing.Set(1)
-	r.syncer = newSyncer(r.cfg, r.Logger, r.conn, r.connQuery, stateProvider, r.tempDir)
+	r.syncer = newSyncer(r.cfg, r.Logger, r.conn, r.connQuery, stateProvider, r.tempDir, r.metrics)
 	r.mtx.Unlock()
 
 	hook := func() {
@@ -293,3 +532,14 @@ func (r *Reactor) Sync(stateProvider StateProvider, discoveryTime time.Duration)
 	r.mtx.Unlock()
 	return state, commit, err
 }
+
+// SyncProgress returns the progress of the restoration of a snapshot, or false
+// if no snapshot is being restored.
+func (r *Reactor) SyncProgress() (Progress, bool) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	if r.syncer == nil {
+		return Progress{}, false
+	}
+	return r.syncer.Progress()
+}
@@ -1,6 +1,7 @@
 package statesync
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -12,9 +13,13 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	ssproto "github.com/cometbft/cometbft/api/cometbft/statesync/v1"
 	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/test"
+	lightprovider "github.com/cometbft/cometbft/light/provider"
 	"github.com/cometbft/cometbft/p2p"
 	p2pmocks "github.com/cometbft/cometbft/p2p/mocks"
 	proxymocks "github.com/cometbft/cometbft/proxy/mocks"
+	smmocks "github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
 )
 
 func TestReactor_Receive_ChunkRequest(t *testing.T) {
@@ -183,3 +188,74 @@ func TestReactor_Receive_SnapshotsRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestReactor_LightBlockProvider(t *testing.T) {
+	vals, privVals := test.ValidatorSet(context.Background(), t, 1, 10)
+	header := test.MakeHeader(t, &types.Header{Height: 1, ValidatorsHash: vals.Hash()})
+	blockID := types.BlockID{Hash: header.Hash(), PartSetHeader: types.PartSetHeader{Total: 1, Hash: test.RandomHash()}}
+	commit, err := test.MakeCommit(blockID, 1, 0, vals, privVals, header.ChainID, time.Now())
+	require.NoError(t, err)
+	params := types.DefaultConsensusParams()
+
+	// The serving reactor has the light block and params at height 1.
+	blockStore := &smmocks.BlockStore{}
+	blockStore.On("LoadBlockMeta", int64(1)).Return(&types.BlockMeta{BlockID: blockID, Header: *header})
+	blockStore.On("LoadBlockMeta", mock.Anything).Return(nil)
+	blockStore.On("LoadBlockCommit", int64(1)).Return(commit)
+	stateStore := &smmocks.Store{}
+	stateStore.On("LoadValidators", int64(1)).Return(vals, nil)
+	stateStore.On("LoadConsensusParams", int64(1)).Return(*params, nil)
+
+	cfg := config.DefaultStateSyncConfig()
+	server := NewReactor(*cfg, nil, nil, NopMetrics(), WithStores(stateStore, blockStore))
+	client := NewReactor(*cfg, nil, nil, NopMetrics())
+	for _, r := range []*Reactor{server, client} {
+		require.NoError(t, r.Start())
+		t.Cleanup(func() {
+			if err := r.Stop(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+
+	// Each mock peer delivers the messages sent to it to the other reactor,
+	// after a wire roundtrip.
+	serverPeer, clientPeer := &p2pmocks.Peer{}, &p2pmocks.Peer{}
+	serverPeer.On("ID").Return(p2p.ID("server"))
+	clientPeer.On("ID").Return(p2p.ID("client"))
+	deliver := func(to *Reactor, from p2p.Peer) func(mock.Arguments) {
+		return func(args mock.Arguments) {
+			e := args[0].(p2p.Envelope)
+			bz, err := proto.Marshal(e.Message.(types.Wrapper).Wrap())
+			require.NoError(t, err)
+			msg := new(ssproto.Message)
+			require.NoError(t, proto.Unmarshal(bz, msg))
+			e.Message, err = msg.Unwrap()
+			require.NoError(t, err)
+			e.Src = from
+			go to.Receive(e)
+		}
+	}
+	serverPeer.On("Send", mock.Anything).Run(deliver(server, clientPeer)).Return(true)
+	clientPeer.On("Send", mock.Anything).Run(deliver(client, serverPeer)).Return(true)
+
+	provider := newBlockProvider(serverPeer, header.ChainID, client.dispatcher)
+	lightBlock, err := provider.LightBlock(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, header.Hash(), lightBlock.Hash())
+	assert.Equal(t, vals.Hash(), lightBlock.ValidatorSet.Hash())
+
+	_, err = provider.LightBlock(context.Background(), 2)
+	require.ErrorIs(t, err, lightprovider.ErrLightBlockNotFound)
+
+	valSet, err := client.fetchValidatorSet(context.Background(), []p2p.Peer{serverPeer}, header.ChainID, 1, vals.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, vals.Hash(), valSet.Hash())
+	_, err = client.fetchValidatorSet(context.Background(), []p2p.Peer{serverPeer}, header.ChainID, 1, test.RandomHash())
+	require.Error(t, err)
+
+	sp := &p2pStateProvider{dispatcher: client.dispatcher}
+	got, err := sp.consensusParams(context.Background(), provider, 1)
+	require.NoError(t, err)
+	assert.Equal(t, params.Hash(), got.Hash())
+}
@@ -1,6 +1,7 @@
 package statesync
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -8,14 +9,18 @@ import (
 
 	dbm "github.com/cometbft/cometbft-db"
 	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v1"
+	ssproto "github.com/cometbft/cometbft/api/cometbft/statesync/v1"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/light"
 	lightprovider "github.com/cometbft/cometbft/light/provider"
+	lightgrpc "github.com/cometbft/cometbft/light/provider/grpc"
 	lighthttp "github.com/cometbft/cometbft/light/provider/http"
 	lightrpc "github.com/cometbft/cometbft/light/rpc"
 	lightdb "github.com/cometbft/cometbft/light/store/db"
+	"github.com/cometbft/cometbft/p2p"
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	grpcclient "github.com/cometbft/cometbft/rpc/grpc/client"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
@@ -60,11 +65,20 @@ func NewLightClientStateProviderWithDBKeyVersion(ctx context.Context,
 	providers := make([]lightprovider.Provider, 0, len(servers))
 	providerRemotes := make(map[lightprovider.Provider]string)
 	for _, server := range servers {
-		client, err := rpcClient(server)
-		if err != nil {
-			return nil, fmt.Errorf("failed to set up RPC client: %w", err)
+		var provider lightprovider.Provider
+		if isGRPCServer(server) {
+			var err error
+			provider, err = lightgrpc.New(chainID, server)
+			if err != nil {
+				return nil, fmt.Errorf("failed to set up gRPC client: %w", err)
+			}
+		} else {
+			client, err := rpcClient(server)
+			if err != nil {
+				return nil, fmt.Errorf("failed to set up RPC client: %w", err)
+			}
+			provider = lighthttp.NewWithClient(chainID, client)
 		}
-		provider := lighthttp.NewWithClient(chainID, client)
 		providers = append(providers, provider)
 		// We store the RPC addresses keyed by provider, so we can find the address of the primary
 		// provider used by the light client and use it to fetch consensus parameters.
@@ -140,10 +154,185 @@ func (s *lightClientStateProvider) State(ctx context.Context, height uint64) (sm
 	s.Lock()
 	defer s.Unlock()
 
+	state, currentLightBlock, err := verifiedState(ctx, s.lc.ChainID(), verifiedLightBlocks(s.lc),
+		s.version, s.initialHeight, height)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	// We'll also need to fetch consensus params via RPC, using light client verification.
+	primaryURL, ok := s.providers[s.lc.Primary()]
+	if !ok || primaryURL == "" {
+		return sm.State{}, errors.New("could not find address for primary light client provider")
+	}
+	var params types.ConsensusParams
+	if isGRPCServer(primaryURL) {
+		params, err = grpcConsensusParams(ctx, primaryURL, currentLightBlock.Height)
+	} else {
+		params, err = rpcConsensusParams(ctx, primaryURL, s.lc, currentLightBlock.Height)
+	}
+	if err != nil {
+		return sm.State{}, fmt.Errorf("unable to fetch consensus parameters for height %v: %w",
+			currentLightBlock.Height, err)
+	}
+	if !bytes.Equal(params.Hash(), currentLightBlock.ConsensusHash) {
+		return sm.State{}, fmt.Errorf("consensus parameters for height %v do not match the header consensus hash %X",
+			currentLightBlock.Height, currentLightBlock.ConsensusHash)
+	}
+	state.ConsensusParams = params
+	state.LastHeightConsensusParamsChanged = currentLightBlock.Height
+
+	return state, nil
+}
+
+// p2pStateProvider is a state provider using the light client, fetching the
+// light blocks and the consensus parameters from peers over the light block
+// and params channels.
+type p2pStateProvider struct {
+	cmtsync.Mutex // light.Client is not concurrency-safe
+	lc            *light.Client
+	version       cmtstate.Version
+	initialHeight int64
+	dispatcher    *dispatcher
+	logger        log.Logger
+}
+
+// newP2PStateProvider creates a new StateProvider using a light client with a
+// provider per peer, the first one being the primary. At least 2 peers are
+// required.
+func newP2PStateProvider(
+	ctx context.Context,
+	chainID string,
+	version cmtstate.Version,
+	initialHeight int64,
+	peers []p2p.Peer,
+	dispatcher *dispatcher,
+	trustOptions light.TrustOptions,
+	logger log.Logger,
+	dbKeyLayoutVersion string,
+) (StateProvider, error) {
+	if len(peers) < 2 {
+		return nil, fmt.Errorf("at least 2 peers are required, got %v", len(peers))
+	}
+
+	providers := make([]lightprovider.Provider, 0, len(peers))
+	for _, peer := range peers {
+		providers = append(providers, newBlockProvider(peer, chainID, dispatcher))
+	}
+
+	lc, err := light.NewClient(ctx, chainID, trustOptions, providers[0], providers[1:],
+		lightdb.NewWithDBVersion(dbm.NewMemDB(), "", dbKeyLayoutVersion), light.Logger(logger), light.MaxRetryAttempts(5))
+	if err != nil {
+		return nil, err
+	}
+	return &p2pStateProvider{
+		lc:            lc,
+		version:       version,
+		initialHeight: initialHeight,
+		dispatcher:    dispatcher,
+		logger:        logger,
+	}, nil
+}
+
+// AppHash implements StateProvider.
+func (s *p2pStateProvider) AppHash(ctx context.Context, height uint64) ([]byte, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	// We have to fetch the next height, which contains the app hash for the previous height.
+	header, err := s.lc.VerifyLightBlockAtHeight(ctx, int64(height+1), cmttime.Now())
+	if err != nil {
+		return nil, err
+	}
+	// As with the lightClientStateProvider, we also fetch the block at H+2,
+	// needed when building the state while restoring the snapshot.
+	_, err = s.lc.VerifyLightBlockAtHeight(ctx, int64(height+2), cmttime.Now())
+	if err != nil {
+		return nil, err
+	}
+	return header.AppHash, nil
+}
+
+// Commit implements StateProvider.
+func (s *p2pStateProvider) Commit(ctx context.Context, height uint64) (*types.Commit, error) {
+	s.Lock()
+	defer s.Unlock()
+	header, err := s.lc.VerifyLightBlockAtHeight(ctx, int64(height), cmttime.Now())
+	if err != nil {
+		return nil, err
+	}
+	return header.Commit, nil
+}
+
+// State implements StateProvider.
+func (s *p2pStateProvider) State(ctx context.Context, height uint64) (sm.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	state, currentLightBlock, err := verifiedState(ctx, s.lc.ChainID(), verifiedLightBlocks(s.lc),
+		s.version, s.initialHeight, height)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	// The consensus parameters are fetched from the primary, then from the
+	// witnesses, and verified against the consensus hash of the header.
+	providers := append([]lightprovider.Provider{s.lc.Primary()}, s.lc.Witnesses()...)
+	for _, provider := range providers {
+		bp, ok := provider.(*blockProvider)
+		if !ok {
+			continue
+		}
+		params, err := s.consensusParams(ctx, bp, currentLightBlock.Height)
+		if err != nil {
+			s.logger.Info("Failed to fetch consensus parameters", "peer", bp.peer.ID(), "err", err)
+			continue
+		}
+		if !bytes.Equal(params.Hash(), currentLightBlock.ConsensusHash) {
+			s.logger.Info("Consensus parameters do not match the header consensus hash",
+				"peer", bp.peer.ID(), "height", currentLightBlock.Height)
+			continue
+		}
+		state.ConsensusParams = params
+		state.LastHeightConsensusParamsChanged = currentLightBlock.Height
+		return state, nil
+	}
+	return sm.State{}, fmt.Errorf("unable to fetch consensus parameters for height %v from any peer",
+		currentLightBlock.Height)
+}
+
+// consensusParams requests the consensus parameters at height from the peer
+// of provider.
+func (s *p2pStateProvider) consensusParams(ctx context.Context, provider *blockProvider, height int64) (types.ConsensusParams, error) {
+	ctx, cancel := context.WithTimeout(ctx, paramsResponseTimeout)
+	defer cancel()
+	resp, err := s.dispatcher.call(ctx, provider.peer, ParamsChannel, uint64(height),
+		&ssproto.ParamsRequest{Height: uint64(height)})
+	if err != nil {
+		return types.ConsensusParams{}, err
+	}
+	params := types.ConsensusParamsFromProto(resp.(*ssproto.ParamsResponse).ConsensusParams)
+	if err := params.ValidateBasic(); err != nil {
+		return types.ConsensusParams{}, err
+	}
+	return params, nil
+}
+
+// verifiedState returns the state at the snapshot height, verified by the
+// light client, but for its consensus parameters. It also returns the light
+// block at height+1, the consensus parameters of which are the state's.
+func verifiedState(
+	ctx context.Context,
+	chainID string,
+	lightBlock func(ctx context.Context, height int64) (*types.LightBlock, error),
+	stateVersion cmtstate.Version,
+	initialHeight int64,
+	height uint64,
+) (sm.State, *types.LightBlock, error) {
 	state := sm.State{
-		ChainID:       s.lc.ChainID(),
-		Version:       s.version,
-		InitialHeight: s.initialHeight,
+		ChainID:       chainID,
+		Version:       stateVersion,
+		InitialHeight: initialHeight,
 	}
 	if state.InitialHeight == 0 {
 		state.InitialHeight = 1
@@ -157,17 +346,17 @@ func (s *lightClientStateProvider) State(ctx context.Context, height uint64) (sm
 	//
 	// We need to fetch the NextValidators from height+2 because if the application changed
 	// the validator set at the snapshot height then this only takes effect at height+2.
-	lastLightBlock, err := s.lc.VerifyLightBlockAtHeight(ctx, int64(height), cmttime.Now())
+	lastLightBlock, err := lightBlock(ctx, int64(height))
 	if err != nil {
-		return sm.State{}, err
+		return sm.State{}, nil, err
 	}
-	currentLightBlock, err := s.lc.VerifyLightBlockAtHeight(ctx, int64(height+1), cmttime.Now())
+	currentLightBlock, err := lightBlock(ctx, int64(height+1))
 	if err != nil {
-		return sm.State{}, err
+		return sm.State{}, nil, err
 	}
-	nextLightBlock, err := s.lc.VerifyLightBlockAtHeight(ctx, int64(height+2), cmttime.Now())
+	nextLightBlock, err := lightBlock(ctx, int64(height+2))
 	if err != nil {
-		return sm.State{}, err
+		return sm.State{}, nil, err
 	}
 
 	state.Version = cmtstate.Version{
@@ -179,30 +368,60 @@ func (s *lightClientStateProvider) State(ctx context.Context, height uint64) (sm
 	state.LastBlockID = lastLightBlock.Commit.BlockID
 	state.AppHash = currentLightBlock.AppHash
 	state.LastResultsHash = currentLightBlock.LastResultsHash
+	state.ProposerSeed = lastLightBlock.LastCommitHash
 	state.LastValidators = lastLightBlock.ValidatorSet
 	state.Validators = currentLightBlock.ValidatorSet
 	state.NextValidators = nextLightBlock.ValidatorSet
 	state.LastHeightValidatorsChanged = nextLightBlock.Height
 
-	// We'll also need to fetch consensus params via RPC, using light client verification.
-	primaryURL, ok := s.providers[s.lc.Primary()]
-	if !ok || primaryURL == "" {
-		return sm.State{}, errors.New("could not find address for primary light client provider")
+	return state, currentLightBlock, nil
+}
+
+// verifiedLightBlocks returns a function returning the light blocks verified
+// by lc.
+func verifiedLightBlocks(lc *light.Client) func(context.Context, int64) (*types.LightBlock, error) {
+	return func(ctx context.Context, height int64) (*types.LightBlock, error) {
+		return lc.VerifyLightBlockAtHeight(ctx, height, cmttime.Now())
 	}
-	primaryRPC, err := rpcClient(primaryURL)
+}
+
+// rpcConsensusParams fetches the consensus parameters at height from the
+// JSON-RPC server, verified by lc.
+func rpcConsensusParams(ctx context.Context, server string, lc *light.Client, height int64) (types.ConsensusParams, error) {
+	client, err := rpcClient(server)
 	if err != nil {
-		return sm.State{}, fmt.Errorf("unable to create RPC client: %w", err)
+		return types.ConsensusParams{}, fmt.Errorf("unable to create RPC client: %w", err)
 	}
-	rpcclient := lightrpc.NewClient(primaryRPC, s.lc)
-	result, err := rpcclient.ConsensusParams(ctx, &currentLightBlock.Height)
+	result, err := lightrpc.NewClient(client, lc).ConsensusParams(ctx, &height)
 	if err != nil {
-		return sm.State{}, fmt.Errorf("unable to fetch consensus parameters for height %v: %w",
-			nextLightBlock.Height, err)
+		return types.ConsensusParams{}, err
 	}
-	state.ConsensusParams = result.ConsensusParams
-	state.LastHeightConsensusParamsChanged = currentLightBlock.Height
+	return result.ConsensusParams, nil
+}
 
-	return state, nil
+// grpcConsensusParams fetches the consensus parameters at height from the
+// consensus params service of the gRPC server. They are left to be verified by
+// the caller.
+func grpcConsensusParams(ctx context.Context, server string, height int64) (types.ConsensusParams, error) {
+	client, err := grpcclient.New(ctx, strings.TrimPrefix(server, lightgrpc.Scheme+"://"), grpcclient.WithInsecure())
+	if err != nil {
+		return types.ConsensusParams{}, fmt.Errorf("unable to create gRPC client: %w", err)
+	}
+	defer client.Close()
+	result, err := client.GetConsensusParamsByHeight(ctx, height)
+	if err != nil {
+		return types.ConsensusParams{}, err
+	}
+	if result.Height != height {
+		return types.ConsensusParams{}, fmt.Errorf("consensus parameters height %d responded doesn't match height %d requested",
+			result.Height, height)
+	}
+	return result.ConsensusParams, nil
+}
+
+// isGRPCServer returns whether light blocks are fetched from server over gRPC.
+func isGRPCServer(server string) bool {
+	return strings.HasPrefix(server, lightgrpc.Scheme+"://")
 }
 
 // rpcClient sets up a new RPC client.
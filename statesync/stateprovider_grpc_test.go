@@ -0,0 +1,56 @@
+package statesync_test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v1"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/light"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	rpctest "github.com/cometbft/cometbft/rpc/test"
+	"github.com/cometbft/cometbft/statesync"
+	"github.com/cometbft/cometbft/types"
+)
+
+// TestLightClientStateProvider_GRPC checks the state is fetched and verified
+// when all the servers are gRPC servers, the consensus parameters included.
+func TestLightClientStateProvider_GRPC(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	node := rpctest.StartCometBFT(app, rpctest.RecreateConfig)
+
+	cfg := rpctest.GetConfig()
+	defer os.RemoveAll(cfg.RootDir)
+	defer rpctest.StopCometBFT(node)
+	genDoc, err := types.GenesisDocFromFile(cfg.GenesisFile())
+	require.NoError(t, err)
+
+	c, err := rpchttp.New(cfg.RPC.ListenAddress)
+	require.NoError(t, err)
+	require.NoError(t, rpcclient.WaitForHeight(c, 6, nil))
+	trusted := int64(1)
+	commit, err := c.Commit(context.Background(), &trusted)
+	require.NoError(t, err)
+
+	grpcAddr := "grpc://" + strings.TrimPrefix(cfg.GRPC.ListenAddress, "tcp://")
+	sp, err := statesync.NewLightClientStateProvider(context.Background(), genDoc.ChainID,
+		cmtstate.Version{}, genDoc.InitialHeight, []string{grpcAddr, grpcAddr},
+		light.TrustOptions{Period: time.Hour, Height: trusted, Hash: commit.Hash()}, log.TestingLogger())
+	require.NoError(t, err)
+
+	state, err := sp.State(context.Background(), 3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, state.LastBlockHeight)
+	params, err := c.ConsensusParams(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, params.ConsensusParams, state.ConsensusParams)
+	assert.EqualValues(t, 4, state.LastHeightConsensusParamsChanged)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -59,9 +60,28 @@ type syncer struct {
 	tempDir       string
 	chunkFetchers int32
 	retryTimeout  time.Duration
+	metrics       *Metrics
+	localDir      string // if set, the chunks are loaded from it instead of fetched from peers
+
+	mtx          cmtsync.RWMutex
+	chunks       *chunkQueue
+	snapshot     *snapshot  // the snapshot being restored
+	peerStats    *peerStats // of the peers serving the chunks of snapshot
+	syncStart    time.Time  // when the chunks of snapshot started being fetched
+	fetchedBytes atomic.Uint64
+}
 
-	mtx    cmtsync.RWMutex
-	chunks *chunkQueue
+// Progress is the progress of the restoration of a snapshot.
+type Progress struct {
+	SnapshotHeight uint64
+	TotalChunks    uint32
+	FetchedChunks  uint32
+	AppliedChunks  uint32
+	// BytesPerSecond is the rate at which the chunks are fetched.
+	BytesPerSecond float64
+	// RemainingTime is the estimated time left to fetch the remaining chunks,
+	// at the current rate, or zero if no chunk was fetched yet.
+	RemainingTime time.Duration
 }
 
 // newSyncer creates a new syncer.
@@ -72,6 +92,7 @@ func newSyncer(
 	connQuery proxy.AppConnQuery,
 	stateProvider StateProvider,
 	tempDir string,
+	metrics *Metrics,
 ) *syncer {
 	return &syncer{
 		logger:        logger,
@@ -82,6 +103,8 @@ func newSyncer(
 		tempDir:       tempDir,
 		chunkFetchers: cfg.ChunkFetchers,
 		retryTimeout:  cfg.ChunkRequestTimeout,
+		metrics:       metrics,
+		peerStats:     newPeerStats(),
 	}
 }
 
@@ -93,6 +116,11 @@ func (s *syncer) AddChunk(chunk *chunk) (bool, error) {
 	if s.chunks == nil {
 		return false, errors.New("no state sync in progress")
 	}
+	// The latency is recorded before the chunk is added, which releases the
+	// fetcher waiting for it.
+	if latency, ok := s.peerStats.Received(chunk.Sender, chunk.Index); ok {
+		s.metrics.ChunkFetchDurationSeconds.Observe(latency.Seconds())
+	}
 	added, err := s.chunks.Add(chunk)
 	if err != nil {
 		return false, err
@@ -100,6 +128,8 @@ func (s *syncer) AddChunk(chunk *chunk) (bool, error) {
 	if added {
 		s.logger.Debug("Added chunk to queue", "height", chunk.Height, "format", chunk.Format,
 			"chunk", chunk.Index)
+		s.fetchedBytes.Add(uint64(len(chunk.Chunk)))
+		s.updateProgressMetrics(s.progress())
 	} else {
 		s.logger.Debug("Ignoring duplicate chunk in queue", "height", chunk.Height, "format", chunk.Format,
 			"chunk", chunk.Index)
@@ -107,6 +137,52 @@ func (s *syncer) AddChunk(chunk *chunk) (bool, error) {
 	return added, nil
 }
 
+// MissingChunk records that the peer did not have a chunk it was asked for.
+func (s *syncer) MissingChunk(peerID p2p.ID, index uint32) {
+	if s.peerStats.Failed(peerID, index) {
+		s.metrics.FailedChunkRequests.Add(1)
+	}
+}
+
+// Progress returns the progress of the restoration of a snapshot, or false if
+// no snapshot is being restored.
+func (s *syncer) Progress() (Progress, bool) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	if s.chunks == nil {
+		return Progress{}, false
+	}
+	return s.progress(), true
+}
+
+// progress returns the progress of the restoration of a snapshot. The caller
+// must hold s.mtx, with a snapshot being restored.
+func (s *syncer) progress() Progress {
+	fetched, applied := s.chunks.Progress()
+	p := Progress{
+		SnapshotHeight: s.snapshot.Height,
+		TotalChunks:    s.snapshot.Chunks,
+		FetchedChunks:  fetched,
+		AppliedChunks:  applied,
+	}
+	elapsed := time.Since(s.syncStart)
+	if elapsed > 0 {
+		p.BytesPerSecond = float64(s.fetchedBytes.Load()) / elapsed.Seconds()
+	}
+	if fetched > 0 && fetched <= p.TotalChunks {
+		p.RemainingTime = elapsed / time.Duration(fetched) * time.Duration(p.TotalChunks-fetched)
+	}
+	return p
+}
+
+func (s *syncer) updateProgressMetrics(p Progress) {
+	s.metrics.TotalChunks.Set(float64(p.TotalChunks))
+	s.metrics.FetchedChunks.Set(float64(p.FetchedChunks))
+	s.metrics.AppliedChunks.Set(float64(p.AppliedChunks))
+	s.metrics.ChunkBytesPerSecond.Set(p.BytesPerSecond)
+	s.metrics.RemainingSeconds.Set(p.RemainingTime.Seconds())
+}
+
 // AddSnapshot adds a snapshot to the snapshot pool. It returns true if a new, previously unseen
 // snapshot was accepted and added.
 func (s *syncer) AddSnapshot(peer p2p.Peer, snapshot *snapshot) (bool, error) {
@@ -136,6 +212,7 @@ func (s *syncer) AddPeer(peer p2p.Peer) {
 func (s *syncer) RemovePeer(peer p2p.Peer) {
 	s.logger.Debug("Removing peer from sync", "peer", peer.ID())
 	s.snapshots.RemovePeer(peer.ID())
+	s.peerStats.RemovePeer(peer.ID())
 }
 
 // SyncAny tries to sync any of the snapshots in the snapshot pool, waiting to discover further
@@ -244,10 +321,14 @@ func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.
 		return sm.State{}, nil, errors.New("a state sync is already in progress")
 	}
 	s.chunks = chunks
+	s.snapshot = snapshot
+	s.syncStart = time.Now()
+	s.fetchedBytes.Store(0)
 	s.mtx.Unlock()
 	defer func() {
 		s.mtx.Lock()
 		s.chunks = nil
+		s.snapshot = nil
 		s.mtx.Unlock()
 	}()
 
@@ -273,8 +354,14 @@ func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.
 	// Spawn chunk fetchers. They will terminate when the chunk queue is closed or context canceled.
 	fetchCtx, cancel := context.WithCancel(context.TODO())
 	defer cancel()
-	for i := int32(0); i < s.chunkFetchers; i++ {
-		go s.fetchChunks(fetchCtx, snapshot, chunks)
+	// loadErr receives the error failing to load the local chunks, if any.
+	loadErr := make(chan error, 1)
+	if s.localDir != "" {
+		go s.loadChunks(fetchCtx, snapshot, chunks, loadErr)
+	} else {
+		for i := int32(0); i < s.chunkFetchers; i++ {
+			go s.fetchChunks(fetchCtx, snapshot, chunks)
+		}
 	}
 
 	pctx, pcancel := context.WithTimeout(context.TODO(), 30*time.Second)
@@ -300,6 +387,11 @@ func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.
 
 	// Restore snapshot
 	err = s.applyChunks(chunks)
+	select {
+	case err := <-loadErr:
+		return sm.State{}, nil, err
+	default:
+	}
 	if err != nil {
 		return sm.State{}, nil, err
 	}
@@ -373,6 +465,9 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 		}
 		s.logger.Info("Applied snapshot chunk to ABCI app", "height", chunk.Height,
 			"format", chunk.Format, "chunk", chunk.Index, "total", chunks.Size())
+		if p, ok := s.Progress(); ok {
+			s.updateProgressMetrics(p)
+		}
 
 		// Discard and refetch any chunks as requested by the app
 		for _, index := range resp.RefetchChunks {
@@ -410,7 +505,9 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 }
 
 // fetchChunks requests chunks from peers, receiving allocations from the chunk queue. Chunks
-// will be received from the reactor via syncer.AddChunks() to chunkQueue.Add().
+// will be received from the reactor via syncer.AddChunks() to chunkQueue.Add(). A chunk is
+// requested from the best ranked peer, then from a second one if it is slower to respond than
+// most of the requests.
 func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *chunkQueue) {
 	var (
 		next  = true
@@ -440,31 +537,76 @@ func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *ch
 		s.logger.Info("Fetching snapshot chunk", "height", snapshot.Height,
 			"format", snapshot.Format, "chunk", index, "total", chunks.Size())
 
-		s.requestChunk(snapshot, index)
+		next = s.fetchChunk(ctx, snapshot, chunks, index)
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// fetchChunk requests a chunk and waits for it, hedging the request. It returns false if the
+// chunk was not received before the retry timeout.
+func (s *syncer) fetchChunk(ctx context.Context, snapshot *snapshot, chunks *chunkQueue, index uint32) bool {
+	received := chunks.WaitFor(index)
+	peers := []p2p.ID{s.requestChunk(snapshot, index, "")}
+	defer s.peerStats.Cancel(index)
+
+	retry := time.NewTimer(s.retryTimeout)
+	defer retry.Stop()
+	var hedgeCh <-chan time.Time
+	if delay := s.peerStats.HedgeDelay(s.retryTimeout); delay < s.retryTimeout && peers[0] != "" {
+		hedge := time.NewTimer(delay)
+		defer hedge.Stop()
+		hedgeCh = hedge.C
+	}
 
+	for {
 		select {
-		case <-chunks.WaitFor(index):
-			next = true
+		case <-received:
+			return true
+
+		case <-hedgeCh:
+			if peer := s.requestChunk(snapshot, index, peers[0]); peer != "" {
+				s.logger.Debug("Hedging snapshot chunk request", "height", snapshot.Height,
+					"format", snapshot.Format, "chunk", index, "peer", peer)
+				s.metrics.HedgedChunkRequests.Add(1)
+				peers = append(peers, peer)
+			}
 
-		case <-time.After(s.retryTimeout):
-			next = false
+		case <-retry.C:
+			for _, peer := range peers {
+				if s.peerStats.Failed(peer, index) {
+					s.metrics.FailedChunkRequests.Add(1)
+				}
+			}
+			return false
 
 		case <-ctx.Done():
-			return
+			return false
 		}
 	}
 }
 
-// requestChunk requests a chunk from a peer.
-func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
-	peer := s.snapshots.GetPeer(snapshot)
+// requestChunk requests a chunk from the best ranked peer other than exclude, and returns the
+// peer, or an empty ID if there is no such peer.
+func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32, exclude p2p.ID) p2p.ID {
+	var peer p2p.Peer
+	for _, p := range s.peerStats.Rank(s.snapshots.GetPeers(snapshot)) {
+		if p.ID() != exclude {
+			peer = p
+			break
+		}
+	}
 	if peer == nil {
-		s.logger.Error("No valid peers found for snapshot", "height", snapshot.Height,
-			"format", snapshot.Format, "hash", log.NewLazySprintf("%X", snapshot.Hash))
-		return
+		if exclude == "" {
+			s.logger.Error("No valid peers found for snapshot", "height", snapshot.Height,
+				"format", snapshot.Format, "hash", log.NewLazySprintf("%X", snapshot.Hash))
+		}
+		return ""
 	}
 	s.logger.Debug("Requesting snapshot chunk", "height", snapshot.Height,
 		"format", snapshot.Format, "chunk", chunk, "peer", peer.ID())
+	s.peerStats.Requested(peer.ID(), chunk)
 	peer.Send(p2p.Envelope{
 		ChannelID: ChunkChannel,
 		Message: &ssproto.ChunkRequest{
@@ -473,6 +615,7 @@ func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
 			Index:  chunk,
 		},
 	})
+	return peer.ID()
 }
 
 // verifyApp verifies the sync, checking the app hash, last block height and app version.
@@ -1,6 +1,7 @@
 package statesync
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -36,7 +37,7 @@ func setupOfferSyncer() (*syncer, *proxymocks.AppConnSnapshot) {
 	stateProvider := &mocks.StateProvider{}
 	stateProvider.On("AppHash", mock.Anything, mock.Anything).Return([]byte("app_hash"), nil)
 	cfg := config.DefaultStateSyncConfig()
-	syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+	syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "", NopMetrics())
 
 	return syncer, connSnapshot
 }
@@ -90,7 +91,7 @@ func TestSyncer_SyncAny(t *testing.T) {
 	connQuery := &proxymocks.AppConnQuery{}
 
 	cfg := config.DefaultStateSyncConfig()
-	syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+	syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "", NopMetrics())
 
 	// Adding a chunk should error when no sync is in progress
 	_, err := syncer.AddChunk(&chunk{Height: 1, Format: 1, Index: 0, Chunk: []byte{1}})
@@ -431,7 +432,7 @@ func TestSyncer_applyChunks_Results(t *testing.T) {
 			stateProvider.On("AppHash", mock.Anything, mock.Anything).Return([]byte("app_hash"), nil)
 
 			cfg := config.DefaultStateSyncConfig()
-			syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+			syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "", NopMetrics())
 
 			body := []byte{1, 2, 3}
 			chunks, err := newChunkQueue(&snapshot{Height: 1, Format: 1, Chunks: 1}, "")
@@ -484,7 +485,7 @@ func TestSyncer_applyChunks_RefetchChunks(t *testing.T) {
 			stateProvider.On("AppHash", mock.Anything, mock.Anything).Return([]byte("app_hash"), nil)
 
 			cfg := config.DefaultStateSyncConfig()
-			syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+			syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "", NopMetrics())
 
 			chunks, err := newChunkQueue(&snapshot{Height: 1, Format: 1, Chunks: 3}, "")
 			require.NoError(t, err)
@@ -548,7 +549,7 @@ func TestSyncer_applyChunks_RejectSenders(t *testing.T) {
 			stateProvider.On("AppHash", mock.Anything, mock.Anything).Return([]byte("app_hash"), nil)
 
 			cfg := config.DefaultStateSyncConfig()
-			syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+			syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "", NopMetrics())
 
 			// Set up three peers across two snapshots, and ask for one of them to be banned.
 			// It should be banned from all snapshots.
@@ -669,7 +670,7 @@ func TestSyncer_verifyApp(t *testing.T) {
 			stateProvider := &mocks.StateProvider{}
 
 			cfg := config.DefaultStateSyncConfig()
-			syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "")
+			syncer := newSyncer(*cfg, log.NewNopLogger(), connSnapshot, connQuery, stateProvider, "", NopMetrics())
 
 			connQuery.On("Info", mock.Anything, proxy.InfoRequest).Return(tc.response, tc.err)
 			err := syncer.verifyApp(s, appVersion)
@@ -691,3 +692,67 @@ func toABCI(s *snapshot) *abci.Snapshot {
 		Metadata: s.Metadata,
 	}
 }
+
+func TestSyncer_fetchChunk_Hedge(t *testing.T) {
+	syncer, _ := setupOfferSyncer()
+	syncer.retryTimeout = 5 * time.Second
+
+	s := &snapshot{Height: 1, Format: 1, Chunks: 2, Hash: []byte{1}}
+	chunks, err := newChunkQueue(s, "")
+	require.NoError(t, err)
+	defer chunks.Close()
+	syncer.chunks = chunks
+	syncer.snapshot = s
+	syncer.syncStart = time.Now()
+
+	requested := make(chan p2p.ID, 2)
+	for _, id := range []p2p.ID{"a", "b"} {
+		peer := simplePeer(string(id))
+		peer.On("Send", mock.MatchedBy(func(e p2p.Envelope) bool {
+			req, ok := e.Message.(*ssproto.ChunkRequest)
+			return ok && req.Index == 0
+		})).Run(func(mock.Arguments) { requested <- id }).Return(true)
+		_, err := syncer.AddSnapshot(peer, s)
+		require.NoError(t, err)
+	}
+
+	// Observe enough fast chunks, from another peer, to hedge after the
+	// minimum delay.
+	for i := uint32(0); i < minHedgeSamples; i++ {
+		syncer.peerStats.Requested("c", 100+i)
+		_, ok := syncer.peerStats.Received("c", 100+i)
+		require.True(t, ok)
+	}
+
+	fetched := make(chan bool)
+	go func() {
+		fetched <- syncer.fetchChunk(context.Background(), s, chunks, 0)
+	}()
+
+	// The first peer does not respond, the request is sent to the second one
+	// after the hedge delay.
+	first := <-requested
+	start := time.Now()
+	second := <-requested
+	assert.NotEqual(t, first, second)
+	assert.GreaterOrEqual(t, time.Since(start), minHedgeDelay/2)
+
+	added, err := syncer.AddChunk(&chunk{Height: 1, Format: 1, Index: 0, Chunk: []byte{1, 2, 3}, Sender: second})
+	require.NoError(t, err)
+	require.True(t, added)
+	assert.True(t, <-fetched)
+
+	progress, ok := syncer.Progress()
+	require.True(t, ok)
+	assert.EqualValues(t, 1, progress.SnapshotHeight)
+	assert.EqualValues(t, 2, progress.TotalChunks)
+	assert.EqualValues(t, 1, progress.FetchedChunks)
+	assert.EqualValues(t, 0, progress.AppliedChunks)
+	assert.Positive(t, progress.BytesPerSecond)
+	assert.Positive(t, progress.RemainingTime)
+
+	// The second peer served the chunk, the first one is not penalized.
+	assert.Equal(t, 1, syncer.peerStats.peers[second].successes)
+	assert.Zero(t, syncer.peerStats.peers[first].failures)
+	assert.Zero(t, syncer.peerStats.peers[first].pending)
+}
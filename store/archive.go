@@ -0,0 +1,591 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtstore "github.com/cometbft/cometbft/api/cometbft/store/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/libs/protoio"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// ArchiveVersion is the version of the format of the archives written by
+// ExportArchive.
+const ArchiveVersion = 1
+
+// maxArchiveRecordSize bounds the size of a record of an archive, which holds
+// a block, its commits and the FinalizeBlock response of the application.
+const maxArchiveRecordSize = 4 * types.MaxBlockSizeBytes
+
+// ErrArchiveChecksum is returned when the checksum of an archive does not
+// match its contents.
+var ErrArchiveChecksum = errors.New("archive checksum mismatch")
+
+// ExportArchive writes the blocks of bs from height from to height to, along
+// with their commits and the FinalizeBlock responses retained in ss, to w.
+//
+// The archive is a sequence of length-delimited cmtstore.ArchiveRecord
+// messages: a header, a record per block, and a footer holding the SHA-256
+// checksum of the previous records. The footer also holds the state after the
+// block at height to, if it can be loaded from the stores; ExportArchive
+// reports whether it does.
+func ExportArchive(w io.Writer, bs *BlockStore, ss sm.Store, from, to int64) (bool, error) {
+	if from < bs.Base() || to > bs.Height() || from > to {
+		return false, fmt.Errorf("height range [%d, %d] is not within the stored blocks [%d, %d]",
+			from, to, bs.Base(), bs.Height())
+	}
+	firstMeta := bs.LoadBlockMeta(from)
+	if firstMeta == nil {
+		return false, fmt.Errorf("block at height %d not found", from)
+	}
+
+	checksum := sha256.New()
+	aw := protoio.NewDelimitedWriter(io.MultiWriter(w, checksum))
+	if _, err := aw.WriteMsg(&cmtstore.ArchiveRecord{Sum: &cmtstore.ArchiveRecord_Header{
+		Header: &cmtstore.ArchiveHeader{
+			Version:    ArchiveVersion,
+			ChainId:    firstMeta.Header.ChainID,
+			FromHeight: from,
+			ToHeight:   to,
+		},
+	}}); err != nil {
+		return false, fmt.Errorf("writing archive header: %w", err)
+	}
+
+	for height := from; height <= to; height++ {
+		block, err := exportBlock(bs, ss, height)
+		if err != nil {
+			return false, err
+		}
+		if _, err := aw.WriteMsg(&cmtstore.ArchiveRecord{Sum: &cmtstore.ArchiveRecord_Block{Block: block}}); err != nil {
+			return false, fmt.Errorf("writing block at height %d: %w", height, err)
+		}
+	}
+
+	footer := &cmtstore.ArchiveFooter{
+		NumBlocks: to - from + 1,
+		Checksum:  checksum.Sum(nil),
+	}
+	if state, err := sm.LoadStateAtHeight(bs, ss, to); err == nil {
+		if footer.State, err = state.ToProto(); err != nil {
+			return false, fmt.Errorf("encoding state: %w", err)
+		}
+	}
+	if _, err := protoio.NewDelimitedWriter(w).WriteMsg(&cmtstore.ArchiveRecord{
+		Sum: &cmtstore.ArchiveRecord_Footer{Footer: footer},
+	}); err != nil {
+		return false, fmt.Errorf("writing archive footer: %w", err)
+	}
+	return footer.State != nil, nil
+}
+
+// exportBlock returns the archive record of the block at height.
+func exportBlock(bs *BlockStore, ss sm.Store, height int64) (*cmtstore.ArchiveBlock, error) {
+	meta := bs.LoadBlockMeta(height)
+	if meta == nil {
+		return nil, fmt.Errorf("block at height %d not found", height)
+	}
+	psh := meta.BlockID.PartSetHeader.ToProto()
+	block := &cmtstore.ArchiveBlock{
+		Height:        height,
+		PartSetHeader: &psh,
+		Parts:         make([]*cmtproto.Part, 0, meta.BlockID.PartSetHeader.Total),
+	}
+	for i := 0; i < int(meta.BlockID.PartSetHeader.Total); i++ {
+		part := bs.LoadBlockPart(height, i)
+		if part == nil {
+			return nil, fmt.Errorf("part %d of block at height %d not found", i, height)
+		}
+		pp, err := part.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		block.Parts = append(block.Parts, pp)
+	}
+
+	// Fall back to the commit included in the next block if the seen commit
+	// is not stored, e.g. for blocks saved by a previous version.
+	commit := bs.LoadSeenCommit(height)
+	if commit == nil {
+		commit = bs.LoadBlockCommit(height)
+	}
+	if commit == nil {
+		return nil, fmt.Errorf("commit for block at height %d not found", height)
+	}
+	block.SeenCommit = commit.ToProto()
+	if extCommit := bs.LoadBlockExtendedCommit(height); extCommit != nil {
+		block.ExtendedCommit = extCommit.ToProto()
+	}
+
+	if vals, err := ss.LoadValidators(height); err == nil {
+		if block.ValidatorSet, err = vals.ToProto(); err != nil {
+			return nil, fmt.Errorf("encoding validator set at height %d: %w", height, err)
+		}
+	}
+
+	resp, err := ss.LoadFinalizeBlockResponse(height)
+	switch {
+	case err == nil:
+		block.FinalizeBlockResponse = resp
+	case errors.Is(err, sm.ErrFinalizeBlockResponsesNotPersisted) || errors.As(err, &sm.ErrNoABCIResponsesForHeight{}):
+		// The response is not retained; the block is exported without it.
+	default:
+		return nil, fmt.Errorf("loading FinalizeBlock response at height %d: %w", height, err)
+	}
+	return block, nil
+}
+
+// ArchiveReader reads the records of an archive written by ExportArchive,
+// verifying its checksum.
+type ArchiveReader struct {
+	r        protoio.ReadCloser
+	raw      bytes.Buffer // the encoding of the last record read
+	checksum hash.Hash
+
+	header    *cmtstore.ArchiveHeader
+	numBlocks int64
+}
+
+// NewArchiveReader returns a reader of the archive read from r, after reading
+// and checking its header.
+func NewArchiveReader(r io.Reader) (*ArchiveReader, error) {
+	ar := &ArchiveReader{checksum: sha256.New()}
+	ar.r = protoio.NewDelimitedReader(io.TeeReader(bufio.NewReader(r), &ar.raw), maxArchiveRecordSize)
+
+	rec, err := ar.next()
+	if err != nil {
+		return nil, fmt.Errorf("reading archive header: %w", err)
+	}
+	ar.header = rec.GetHeader()
+	if ar.header == nil {
+		return nil, errors.New("archive does not start with a header")
+	}
+	if ar.header.Version != ArchiveVersion {
+		return nil, fmt.Errorf("unsupported archive version %d (expected %d)", ar.header.Version, ArchiveVersion)
+	}
+	if ar.header.FromHeight <= 0 || ar.header.FromHeight > ar.header.ToHeight {
+		return nil, fmt.Errorf("invalid archive height range [%d, %d]", ar.header.FromHeight, ar.header.ToHeight)
+	}
+	return ar, nil
+}
+
+// Header returns the header of the archive.
+func (ar *ArchiveReader) Header() *cmtstore.ArchiveHeader { return ar.header }
+
+// next reads the next record, and adds its encoding to the checksum of the
+// archive unless it is the footer.
+func (ar *ArchiveReader) next() (*cmtstore.ArchiveRecord, error) {
+	ar.raw.Reset()
+	rec := new(cmtstore.ArchiveRecord)
+	if _, err := ar.r.ReadMsg(rec); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	if rec.GetFooter() == nil {
+		ar.checksum.Write(ar.raw.Bytes())
+	}
+	return rec, nil
+}
+
+// Next returns the next block of the archive. Once all the blocks are read, it
+// verifies the footer and returns it along with a nil block.
+func (ar *ArchiveReader) Next() (*cmtstore.ArchiveBlock, *cmtstore.ArchiveFooter, error) {
+	rec, err := ar.next()
+	if err != nil {
+		return nil, nil, err
+	}
+	switch sum := rec.Sum.(type) {
+	case *cmtstore.ArchiveRecord_Block:
+		if want := ar.header.FromHeight + ar.numBlocks; sum.Block.Height != want {
+			return nil, nil, fmt.Errorf("unexpected block at height %d (expected %d)", sum.Block.Height, want)
+		}
+		ar.numBlocks++
+		return sum.Block, nil, nil
+
+	case *cmtstore.ArchiveRecord_Footer:
+		footer := sum.Footer
+		if !bytes.Equal(footer.Checksum, ar.checksum.Sum(nil)) {
+			return nil, nil, ErrArchiveChecksum
+		}
+		if want := ar.header.ToHeight - ar.header.FromHeight + 1; footer.NumBlocks != want || ar.numBlocks != want {
+			return nil, nil, fmt.Errorf("archive has %d blocks (footer: %d), expected %d", ar.numBlocks, footer.NumBlocks, want)
+		}
+		return nil, footer, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unexpected archive record %T", rec.Sum)
+	}
+}
+
+// VerifyArchive reads the whole archive from r and checks its consistency,
+// without decoding the blocks. It returns the header and the footer of the
+// archive.
+func VerifyArchive(r io.Reader) (*cmtstore.ArchiveHeader, *cmtstore.ArchiveFooter, error) {
+	ar, err := NewArchiveReader(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	for {
+		block, footer, err := ar.Next()
+		if err != nil {
+			return nil, nil, err
+		}
+		if block == nil {
+			return ar.header, footer, nil
+		}
+	}
+}
+
+// ImportArchive saves the blocks of the archive read from r into bs, and their
+// FinalizeBlock responses into ss. The blocks must follow the blocks of bs, if
+// any; blocks bs already has are skipped, so that an interrupted import can be
+// resumed. Each block is checked against its part set header, its commit and
+// the previous block before being saved.
+//
+// The commit of each block is verified against its validator set, which must
+// be trusted: either the set stored in ss, or the set of the archive, once its
+// hash matches the NextValidatorsHash of the previous block. trustedVals,
+// which may be nil, is trusted as the validator set of the first block of the
+// archive if ss has none, e.g. the validators of the genesis file for an
+// archive starting at the initial height. The import stops with
+// ErrArchiveBlockNotVerified at the first block without a trusted validator
+// set.
+//
+// Once all the blocks are saved, if the archive holds the state after its
+// last block and ss has no state at that height or later, ss is bootstrapped
+// with it, provided the state matches the last block, its FinalizeBlock
+// response and initialHeight, the initial height of the genesis file. As the
+// checksum of the archive is only verified at its end, callers should verify
+// the archive first, see VerifyArchive.
+//
+// ImportArchive returns the number of blocks saved, and whether ss was
+// bootstrapped.
+func ImportArchive(r io.Reader, bs *BlockStore, ss sm.Store, trustedVals *types.ValidatorSet,
+	initialHeight int64,
+) (int64, bool, error) {
+	ar, err := NewArchiveReader(r)
+	if err != nil {
+		return 0, false, err
+	}
+	header := ar.Header()
+	if header.FromHeight < initialHeight {
+		return 0, false, fmt.Errorf("archive starts at height %d, before the initial height %d",
+			header.FromHeight, initialHeight)
+	}
+	if !bs.IsEmpty() && header.FromHeight > bs.Height()+1 {
+		return 0, false, fmt.Errorf("archive starts at height %d, but the block store ends at height %d",
+			header.FromHeight, bs.Height())
+	}
+
+	var (
+		imported    int64
+		lastBlockID *types.BlockID
+		last        *verifiedBlock
+		verifier    = &commitVerifier{ss: ss, chainID: header.ChainId, trustedVals: trustedVals}
+	)
+	for {
+		ab, footer, err := ar.Next()
+		if err != nil {
+			return imported, false, err
+		}
+		if footer != nil {
+			bootstrapped, err := bootstrapState(ss, footer, last, initialHeight)
+			return imported, bootstrapped, err
+		}
+
+		block, parts, err := decodeArchiveBlock(ab, header.ChainId)
+		if err != nil {
+			return imported, false, fmt.Errorf("block at height %d: %w", ab.Height, err)
+		}
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+
+		if lastBlockID == nil {
+			if meta := bs.LoadBlockMeta(ab.Height - 1); meta != nil {
+				lastBlockID = &meta.BlockID
+			}
+		}
+		if lastBlockID != nil && !block.LastBlockID.Equals(*lastBlockID) {
+			return imported, false, fmt.Errorf("block at height %d does not follow the previous block: last block ID %v, expected %v",
+				ab.Height, block.LastBlockID, lastBlockID)
+		}
+		lastBlockID = &blockID
+
+		var (
+			commit    *types.Commit
+			extCommit *types.ExtendedCommit
+		)
+		if ab.ExtendedCommit != nil {
+			if extCommit, err = types.ExtendedCommitFromProto(ab.ExtendedCommit); err != nil {
+				return imported, false, fmt.Errorf("block at height %d: %w", ab.Height, err)
+			}
+			commit = extCommit.ToCommit()
+		} else if commit, err = types.CommitFromProto(ab.SeenCommit); err != nil {
+			return imported, false, fmt.Errorf("block at height %d: %w", ab.Height, err)
+		}
+		if !commit.BlockID.Equals(blockID) {
+			return imported, false, fmt.Errorf("commit at height %d is for block %v, expected %v",
+				ab.Height, commit.BlockID, blockID)
+		}
+		last = &verifiedBlock{block: block, blockID: blockID, resp: ab.FinalizeBlockResponse}
+
+		// Blocks already stored are trusted, and not verified again.
+		if ab.Height >= bs.Base() && ab.Height <= bs.Height() {
+			if meta := bs.LoadBlockMeta(ab.Height); meta == nil || !meta.BlockID.Equals(blockID) {
+				return imported, false, fmt.Errorf("block at height %d conflicts with the stored block", ab.Height)
+			}
+			verifier.trust(&block.Header)
+			continue
+		}
+		if err := verifier.verify(ab, block, blockID, commit); err != nil {
+			return imported, false, fmt.Errorf("block at height %d: %w", ab.Height, err)
+		}
+		if !bs.IsEmpty() && ab.Height != bs.Height()+1 {
+			return imported, false, fmt.Errorf("block at height %d does not follow the block store, which ends at height %d",
+				ab.Height, bs.Height())
+		}
+
+		if extCommit != nil {
+			bs.SaveBlockWithExtendedCommit(block, parts, extCommit)
+		} else {
+			bs.SaveBlock(block, parts, commit)
+		}
+		if ab.FinalizeBlockResponse != nil {
+			if err := ss.SaveFinalizeBlockResponse(ab.Height, ab.FinalizeBlockResponse); err != nil {
+				return imported, false, fmt.Errorf("saving FinalizeBlock response at height %d: %w", ab.Height, err)
+			}
+		}
+		imported++
+	}
+}
+
+// ErrArchiveBlockNotVerified is returned when the commit of a block of an
+// archive cannot be verified, as no trusted validator set is available for
+// the block.
+var ErrArchiveBlockNotVerified = errors.New("no trusted validator set to verify the commit of the block")
+
+// verifiedBlock is the last block read from an archive, whose commit was
+// verified.
+type verifiedBlock struct {
+	block   *types.Block
+	blockID types.BlockID
+	resp    *abci.FinalizeBlockResponse
+}
+
+// commitVerifier verifies the commits of the consecutive blocks of an archive.
+type commitVerifier struct {
+	ss          sm.Store
+	chainID     string
+	trustedVals *types.ValidatorSet
+
+	// lastHeader is the header of the previous block, trusted, so that its
+	// NextValidatorsHash is trusted too.
+	lastHeader *types.Header
+	started    bool
+}
+
+// trust marks header, the header of a stored block, as trusted.
+func (v *commitVerifier) trust(header *types.Header) {
+	v.started = true
+	v.lastHeader = header
+}
+
+// verify verifies commit against the trusted validator set of block. It
+// returns an error if there is no such set, the set does not match the block,
+// or the commit is not signed by more than 2/3 of it.
+func (v *commitVerifier) verify(ab *cmtstore.ArchiveBlock, block *types.Block, blockID types.BlockID,
+	commit *types.Commit,
+) error {
+	vals, err := v.validators(ab)
+	v.started = true
+	v.lastHeader = nil
+	if err != nil {
+		return err
+	}
+	if vals == nil {
+		return ErrArchiveBlockNotVerified
+	}
+	if !bytes.Equal(vals.Hash(), block.ValidatorsHash) {
+		return fmt.Errorf("trusted validator set %X does not match the validators hash %X",
+			vals.Hash(), block.ValidatorsHash)
+	}
+	if err := vals.VerifyCommitLight(v.chainID, blockID, block.Height, commit); err != nil {
+		return fmt.Errorf("invalid commit: %w", err)
+	}
+	v.lastHeader = &block.Header
+	return nil
+}
+
+// validators returns the trusted validator set of the block of ab, or nil if
+// there is none.
+func (v *commitVerifier) validators(ab *cmtstore.ArchiveBlock) (*types.ValidatorSet, error) {
+	if vals, err := v.ss.LoadValidators(ab.Height); err == nil {
+		return vals, nil
+	}
+	if !v.started {
+		return v.trustedVals, nil
+	}
+	if v.lastHeader == nil || ab.ValidatorSet == nil {
+		return nil, nil
+	}
+	vals, err := types.ValidatorSetFromProto(ab.ValidatorSet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator set: %w", err)
+	}
+	if !bytes.Equal(vals.Hash(), v.lastHeader.NextValidatorsHash) {
+		return nil, fmt.Errorf("validator set %X does not match the next validators hash %X of the previous block",
+			vals.Hash(), v.lastHeader.NextValidatorsHash)
+	}
+	return vals, nil
+}
+
+// decodeArchiveBlock rebuilds the part set of ab, checking each part against
+// the part set header, and decodes the block.
+func decodeArchiveBlock(ab *cmtstore.ArchiveBlock, chainID string) (*types.Block, *types.PartSet, error) {
+	psh, err := types.PartSetHeaderFromProto(ab.PartSetHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+	parts := types.NewPartSetFromHeader(*psh)
+	for _, pp := range ab.Parts {
+		part, err := types.PartFromProto(pp)
+		if err != nil {
+			return nil, nil, err
+		}
+		if added, err := parts.AddPart(part); err != nil {
+			return nil, nil, err
+		} else if !added {
+			return nil, nil, fmt.Errorf("duplicate part %d", part.Index)
+		}
+	}
+	if !parts.IsComplete() {
+		return nil, nil, fmt.Errorf("incomplete part set (%d/%d parts)", parts.Count(), parts.Total())
+	}
+
+	bz, err := io.ReadAll(parts.GetReader())
+	if err != nil {
+		return nil, nil, err
+	}
+	pbb := new(cmtproto.Block)
+	if err := proto.Unmarshal(bz, pbb); err != nil {
+		return nil, nil, err
+	}
+	block, err := types.BlockFromProto(pbb)
+	if err != nil {
+		return nil, nil, err
+	}
+	if block.Height != ab.Height {
+		return nil, nil, fmt.Errorf("block has height %d", block.Height)
+	}
+	if block.ChainID != chainID {
+		return nil, nil, fmt.Errorf("block has chain ID %q, expected %q", block.ChainID, chainID)
+	}
+	if err := block.ValidateBasic(); err != nil {
+		return nil, nil, err
+	}
+	return block, parts, nil
+}
+
+// ErrArchiveStateNotVerified is returned when the state of an archive does not
+// match its last block, or cannot be verified against it.
+var ErrArchiveStateNotVerified = errors.New("the state of the archive cannot be verified")
+
+// bootstrapState bootstraps ss with the state held in footer, if any, unless
+// ss already has a state at that height or later.
+func bootstrapState(ss sm.Store, footer *cmtstore.ArchiveFooter, last *verifiedBlock, initialHeight int64) (bool, error) {
+	if footer.State == nil || last == nil {
+		return false, nil
+	}
+	state, err := sm.FromProto(footer.State)
+	if err != nil {
+		return false, fmt.Errorf("decoding state: %w", err)
+	}
+	current, err := ss.Load()
+	if err != nil {
+		return false, err
+	}
+	if !current.IsEmpty() && current.LastBlockHeight >= state.LastBlockHeight {
+		return false, nil
+	}
+	if err := verifyArchiveState(state, last, initialHeight); err != nil {
+		return false, fmt.Errorf("%w: %w", ErrArchiveStateNotVerified, err)
+	}
+
+	// The validator sets and consensus parameters are only stored from the
+	// height of the state onwards, so they must not refer to earlier heights.
+	if state.LastHeightValidatorsChanged < state.LastBlockHeight+1 {
+		state.LastHeightValidatorsChanged = state.LastBlockHeight + 1
+	}
+	if state.LastHeightConsensusParamsChanged < state.LastBlockHeight+1 {
+		state.LastHeightConsensusParamsChanged = state.LastBlockHeight + 1
+	}
+	if err := ss.Bootstrap(*state); err != nil {
+		return false, fmt.Errorf("bootstrapping state: %w", err)
+	}
+	return true, nil
+}
+
+// verifyArchiveState checks state against the last block of the archive,
+// whose commit was verified, the FinalizeBlock response of that block and the
+// initial height of the chain. The consensus parameters can only be verified
+// if the block did not update them, as the archive does not hold the
+// parameters of the block.
+func verifyArchiveState(state *sm.State, last *verifiedBlock, initialHeight int64) error {
+	header := last.block.Header
+	switch {
+	case state.ChainID != header.ChainID:
+		return fmt.Errorf("chain ID %q, expected %q", state.ChainID, header.ChainID)
+	case state.InitialHeight != initialHeight:
+		return fmt.Errorf("initial height %d, expected %d", state.InitialHeight, initialHeight)
+	case state.Version.Consensus != header.Version:
+		return fmt.Errorf("consensus version %v, expected %v", state.Version.Consensus, header.Version)
+	case state.LastBlockHeight != header.Height:
+		return fmt.Errorf("last block height %d, expected %d", state.LastBlockHeight, header.Height)
+	case !state.LastBlockID.Equals(last.blockID):
+		return fmt.Errorf("last block ID %v, expected %v", state.LastBlockID, last.blockID)
+	case !state.LastBlockTime.Equal(header.Time):
+		return fmt.Errorf("last block time %v, expected %v", state.LastBlockTime, header.Time)
+	case !bytes.Equal(state.ProposerSeed, header.LastCommitHash):
+		return fmt.Errorf("proposer seed %X, expected the last commit hash %X", state.ProposerSeed, header.LastCommitHash)
+	case state.LastValidators == nil || !bytes.Equal(state.LastValidators.Hash(), header.ValidatorsHash):
+		return errors.New("last validators do not match the validators hash of the last block")
+	case state.Validators == nil || !bytes.Equal(state.Validators.Hash(), header.NextValidatorsHash):
+		return errors.New("validators do not match the next validators hash of the last block")
+	case last.resp == nil:
+		return fmt.Errorf("the FinalizeBlock response of the block at height %d is not in the archive", header.Height)
+	case !bytes.Equal(state.AppHash, last.resp.AppHash):
+		return fmt.Errorf("app hash %X, expected %X", state.AppHash, last.resp.AppHash)
+	case !bytes.Equal(state.LastResultsHash, sm.TxResultsHash(last.resp.TxResults)):
+		return errors.New("last results hash does not match the FinalizeBlock response of the last block")
+	case state.NextBlockDelay != last.resp.NextBlockDelay:
+		return fmt.Errorf("next block delay %v, expected %v", state.NextBlockDelay, last.resp.NextBlockDelay)
+	case last.resp.ConsensusParamUpdates != nil:
+		return fmt.Errorf("the consensus parameters were updated by the block at height %d", header.Height)
+	case !bytes.Equal(state.ConsensusParams.Hash(), header.ConsensusHash):
+		return errors.New("consensus parameters do not match the consensus hash of the last block")
+	}
+
+	updates, err := types.PB2TM.ValidatorUpdates(last.resp.ValidatorUpdates)
+	if err != nil {
+		return err
+	}
+	nextVals := state.Validators.Copy()
+	if err := nextVals.UpdateWithChangeSet(updates); err != nil {
+		return fmt.Errorf("applying the validator updates of the last block: %w", err)
+	}
+	if state.NextValidators == nil || !bytes.Equal(state.NextValidators.Hash(), nextVals.Hash()) {
+		return errors.New("next validators do not match the validator updates of the last block")
+	}
+	return nil
+}
@@ -0,0 +1,319 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v1"
+	cmtstore "github.com/cometbft/cometbft/api/cometbft/store/v1"
+	cmtrand "github.com/cometbft/cometbft/internal/rand"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/protoio"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// makeArchiveTestChain returns a block store, created with options, and a state
+// store holding a chain of numBlocks blocks, with their states and
+// FinalizeBlock responses. The blocks are committed by a single validator.
+func makeArchiveTestChain(t *testing.T, numBlocks int64, options ...BlockStoreOption) (*BlockStore, sm.Store) {
+	t.Helper()
+	config := test.ResetTestRoot("store_archive_test")
+	t.Cleanup(func() { os.RemoveAll(config.RootDir) })
+
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{DiscardABCIResponses: false})
+	state, err := stateStore.LoadFromDBOrGenesisFile(config.GenesisFile())
+	require.NoError(t, err)
+	valSet, privVals := test.ValidatorSet(context.Background(), t, 1, 10)
+	state.Validators, state.NextValidators = valSet, valSet.Copy()
+	require.NoError(t, stateStore.Save(state))
+	bs := NewBlockStore(dbm.NewMemDB(), options...)
+
+	lastCommit := new(types.Commit)
+	for h := int64(1); h <= numBlocks; h++ {
+		proposer := state.Validators.GetProposer().Address
+		block := state.MakeBlock(h, test.MakeNTxs(h, 5), lastCommit, nil, proposer)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+
+		voteSet := types.NewExtendedVoteSet(state.ChainID, h, 0, types.PrecommitType, state.Validators)
+		seenCommit, err := types.MakeExtCommit(blockID, h, 0, voteSet, privVals, cmttime.Now(), true)
+		require.NoError(t, err)
+		bs.SaveBlockWithExtendedCommit(block, partSet, seenCommit)
+		resp := &abci.FinalizeBlockResponse{
+			Events:  []abci.Event{{Type: "block", Attributes: []abci.EventAttribute{{Key: "height", Value: "h"}}}},
+			AppHash: []byte{byte(h)},
+		}
+		require.NoError(t, stateStore.SaveFinalizeBlockResponse(h, resp))
+
+		state.LastBlockHeight = h
+		state.LastBlockID = blockID
+		state.LastBlockTime = block.Time
+		state.LastValidators = state.Validators.Copy()
+		state.ProposerSeed = block.LastCommitHash
+		state.LastResultsHash = sm.TxResultsHash(resp.TxResults)
+		state.AppHash = resp.AppHash
+		require.NoError(t, stateStore.Save(state))
+		lastCommit = seenCommit.ToCommit()
+	}
+	return bs, stateStore
+}
+
+// validatorsAt returns the validator set stored in ss for height.
+func validatorsAt(t *testing.T, ss sm.Store, height int64) *types.ValidatorSet {
+	t.Helper()
+	vals, err := ss.LoadValidators(height)
+	require.NoError(t, err)
+	return vals
+}
+
+func TestArchiveExportImport(t *testing.T) {
+	bs, ss := makeArchiveTestChain(t, 10)
+
+	var archive bytes.Buffer
+	hasState, err := ExportArchive(&archive, bs, ss, 1, 10)
+	require.NoError(t, err)
+	assert.True(t, hasState)
+
+	header, footer, err := VerifyArchive(bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+	assert.EqualValues(t, ArchiveVersion, header.Version)
+	assert.EqualValues(t, 1, header.FromHeight)
+	assert.EqualValues(t, 10, header.ToHeight)
+	assert.EqualValues(t, 10, footer.NumBlocks)
+	require.NotNil(t, footer.State)
+
+	newBS := NewBlockStore(dbm.NewMemDB())
+	newSS := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{DiscardABCIResponses: false})
+	imported, bootstrapped, err := ImportArchive(bytes.NewReader(archive.Bytes()), newBS, newSS, validatorsAt(t, ss, 1), 1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, imported)
+	assert.True(t, bootstrapped)
+
+	assert.EqualValues(t, 1, newBS.Base())
+	assert.EqualValues(t, 10, newBS.Height())
+	for h := int64(1); h <= 10; h++ {
+		assert.Equal(t, bs.LoadBlockMeta(h), newBS.LoadBlockMeta(h), h)
+		assert.Equal(t, bs.LoadBlockCommit(h), newBS.LoadBlockCommit(h), h)
+		assert.Equal(t, bs.LoadSeenCommit(h), newBS.LoadSeenCommit(h), h)
+		assert.Equal(t, bs.LoadBlockExtendedCommit(h), newBS.LoadBlockExtendedCommit(h), h)
+
+		want, err := ss.LoadFinalizeBlockResponse(h)
+		require.NoError(t, err)
+		got, err := newSS.LoadFinalizeBlockResponse(h)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, h)
+	}
+
+	state, err := ss.Load()
+	require.NoError(t, err)
+	newState, err := newSS.Load()
+	require.NoError(t, err)
+	assert.Equal(t, state.LastBlockID, newState.LastBlockID)
+	assert.Equal(t, state.AppHash, newState.AppHash)
+	assert.Equal(t, state.Validators.Hash(), newState.Validators.Hash())
+	vals, err := newSS.LoadValidators(12)
+	require.NoError(t, err)
+	assert.Equal(t, state.NextValidators.Hash(), vals.Hash())
+	params, err := newSS.LoadConsensusParams(11)
+	require.NoError(t, err)
+	assert.Equal(t, state.ConsensusParams, params)
+
+	// Importing the same archive again is a no-op.
+	imported, bootstrapped, err = ImportArchive(bytes.NewReader(archive.Bytes()), newBS, newSS, nil, 1)
+	require.NoError(t, err)
+	assert.Zero(t, imported)
+	assert.False(t, bootstrapped)
+}
+
+func TestArchiveExportRange(t *testing.T) {
+	bs, ss := makeArchiveTestChain(t, 10)
+
+	var archive bytes.Buffer
+	_, err := ExportArchive(&archive, bs, ss, 0, 5)
+	require.Error(t, err)
+	_, err = ExportArchive(&archive, bs, ss, 5, 11)
+	require.Error(t, err)
+
+	archive.Reset()
+	hasState, err := ExportArchive(&archive, bs, ss, 3, 5)
+	require.NoError(t, err)
+	assert.True(t, hasState)
+
+	newBS := NewBlockStore(dbm.NewMemDB())
+	newSS := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{DiscardABCIResponses: false})
+	imported, bootstrapped, err := ImportArchive(bytes.NewReader(archive.Bytes()), newBS, newSS, validatorsAt(t, ss, 3), 1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, imported)
+	assert.True(t, bootstrapped)
+	assert.EqualValues(t, 3, newBS.Base())
+	assert.EqualValues(t, 5, newBS.Height())
+
+	// The state after block 5 is rebuilt from the stores.
+	state, err := newSS.Load()
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, state.LastBlockHeight)
+	assert.Equal(t, bs.LoadBlockMeta(5).BlockID, state.LastBlockID)
+	assert.Equal(t, []byte(bs.LoadBlockMeta(6).Header.AppHash), state.AppHash)
+
+	// The following blocks can be imported on top.
+	archive.Reset()
+	_, err = ExportArchive(&archive, bs, ss, 6, 10)
+	require.NoError(t, err)
+	imported, _, err = ImportArchive(bytes.NewReader(archive.Bytes()), newBS, newSS, nil, 1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, imported)
+	assert.EqualValues(t, 10, newBS.Height())
+
+	// But not blocks leaving a gap.
+	archive.Reset()
+	_, err = ExportArchive(&archive, bs, ss, 1, 2)
+	require.NoError(t, err)
+	gapBS := NewBlockStore(dbm.NewMemDB())
+	gapSS := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{DiscardABCIResponses: false})
+	archive2 := new(bytes.Buffer)
+	_, err = ExportArchive(archive2, bs, ss, 4, 4)
+	require.NoError(t, err)
+	_, _, err = ImportArchive(bytes.NewReader(archive.Bytes()), gapBS, gapSS, validatorsAt(t, ss, 1), 1)
+	require.NoError(t, err)
+	_, _, err = ImportArchive(archive2, gapBS, gapSS, nil, 1)
+	require.Error(t, err)
+}
+
+func TestArchiveCorruption(t *testing.T) {
+	bs, ss := makeArchiveTestChain(t, 3)
+
+	var archive bytes.Buffer
+	_, err := ExportArchive(&archive, bs, ss, 1, 3)
+	require.NoError(t, err)
+	bz := archive.Bytes()
+
+	// Truncated archive.
+	_, _, err = VerifyArchive(bytes.NewReader(bz[:len(bz)-10]))
+	require.Error(t, err)
+
+	// Altered transaction data, which leaves the encoding valid.
+	tx := test.MakeNTxs(2, 5)[0]
+	i := bytes.Index(bz, tx)
+	require.Positive(t, i)
+	corrupted := bytes.Clone(bz)
+	corrupted[i] ^= 0xff
+	_, _, err = VerifyArchive(bytes.NewReader(corrupted))
+	require.ErrorIs(t, err, ErrArchiveChecksum)
+
+	// The altered block does not match its part set header either.
+	newBS := NewBlockStore(dbm.NewMemDB())
+	newSS := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{DiscardABCIResponses: false})
+	imported, _, err := ImportArchive(bytes.NewReader(corrupted), newBS, newSS, validatorsAt(t, ss, 1), 1)
+	require.Error(t, err)
+	assert.EqualValues(t, 1, imported)
+}
+
+// rewriteArchive decodes the records of archive, calls modify on each of them
+// and encodes them again, leaving the checksum unchanged.
+func rewriteArchive(t *testing.T, archive []byte, modify func(*cmtstore.ArchiveRecord)) []byte {
+	t.Helper()
+	r := protoio.NewDelimitedReader(bytes.NewReader(archive), maxArchiveRecordSize)
+	var out bytes.Buffer
+	w := protoio.NewDelimitedWriter(&out)
+	for {
+		rec := new(cmtstore.ArchiveRecord)
+		_, err := r.ReadMsg(rec)
+		if errors.Is(err, io.EOF) {
+			return out.Bytes()
+		}
+		require.NoError(t, err)
+		modify(rec)
+		_, err = w.WriteMsg(rec)
+		require.NoError(t, err)
+	}
+}
+
+func TestArchiveImportVerification(t *testing.T) {
+	bs, ss := makeArchiveTestChain(t, 10)
+
+	var archive bytes.Buffer
+	_, err := ExportArchive(&archive, bs, ss, 1, 10)
+	require.NoError(t, err)
+	newStores := func() (*BlockStore, sm.Store) {
+		return NewBlockStore(dbm.NewMemDB()), sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{DiscardABCIResponses: false})
+	}
+
+	// Without a trusted validator set, no block is imported.
+	newBS, newSS := newStores()
+	imported, bootstrapped, err := ImportArchive(bytes.NewReader(archive.Bytes()), newBS, newSS, nil, 1)
+	require.ErrorIs(t, err, ErrArchiveBlockNotVerified)
+	assert.Zero(t, imported)
+	assert.False(t, bootstrapped)
+	assert.True(t, newBS.IsEmpty())
+
+	// Nor is an archive starting before the initial height.
+	_, _, err = ImportArchive(bytes.NewReader(archive.Bytes()), newBS, newSS, validatorsAt(t, ss, 1), 2)
+	require.ErrorContains(t, err, "before the initial height")
+
+	// The validator set trusted must be the one of the first block.
+	otherVals, _ := test.ValidatorSet(context.Background(), t, 1, 10)
+	newBS, newSS = newStores()
+	_, _, err = ImportArchive(bytes.NewReader(archive.Bytes()), newBS, newSS, otherVals, 1)
+	require.ErrorContains(t, err, "does not match the validators hash")
+
+	// A forged commit is rejected.
+	forged := rewriteArchive(t, archive.Bytes(), func(rec *cmtstore.ArchiveRecord) {
+		if block := rec.GetBlock(); block != nil && block.Height == 5 {
+			block.ExtendedCommit.ExtendedSignatures[0].Signature = cmtrand.Bytes(64)
+		}
+	})
+	newBS, newSS = newStores()
+	imported, _, err = ImportArchive(bytes.NewReader(forged), newBS, newSS, validatorsAt(t, ss, 1), 1)
+	require.ErrorContains(t, err, "invalid commit")
+	assert.EqualValues(t, 4, imported)
+
+	// So is an archived validator set not matching the previous block.
+	forged = rewriteArchive(t, archive.Bytes(), func(rec *cmtstore.ArchiveRecord) {
+		if block := rec.GetBlock(); block != nil && block.Height == 5 {
+			block.ValidatorSet, err = otherVals.ToProto()
+			require.NoError(t, err)
+		}
+	})
+	newBS, newSS = newStores()
+	_, _, err = ImportArchive(bytes.NewReader(forged), newBS, newSS, validatorsAt(t, ss, 1), 1)
+	require.ErrorContains(t, err, "next validators hash")
+
+	// And a state not matching the last block or the genesis file, which the
+	// checksum does not cover.
+	for name, forge := range map[string]func(*cmtstate.State){
+		"app hash":         func(s *cmtstate.State) { s.AppHash = []byte("forged") },
+		"proposer seed":    func(s *cmtstate.State) { s.ProposerSeed = []byte("forged") },
+		"next block delay": func(s *cmtstate.State) { s.NextBlockDelay = time.Second },
+		"initial height":   func(s *cmtstate.State) { s.InitialHeight = 2 },
+		"version":          func(s *cmtstate.State) { s.Version.Consensus.App++ },
+	} {
+		forged = rewriteArchive(t, archive.Bytes(), func(rec *cmtstore.ArchiveRecord) {
+			if footer := rec.GetFooter(); footer != nil {
+				forge(footer.State)
+			}
+		})
+		_, _, err = VerifyArchive(bytes.NewReader(forged))
+		require.NoError(t, err, name)
+		newBS, newSS = newStores()
+		imported, bootstrapped, err = ImportArchive(bytes.NewReader(forged), newBS, newSS, validatorsAt(t, ss, 1), 1)
+		require.ErrorIs(t, err, ErrArchiveStateNotVerified, name)
+		assert.EqualValues(t, 10, imported, name)
+		assert.False(t, bootstrapped, name)
+		state, err := newSS.Load()
+		require.NoError(t, err)
+		assert.True(t, state.IsEmpty(), name)
+	}
+}
@@ -0,0 +1,526 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/cometbft/cometbft/internal/compress"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+const (
+	// segmentMagic starts and ends every segment file.
+	segmentMagic = "CMTSEG01"
+	// segmentTrailerSize is the size of the trailer of a segment file: the
+	// offset of its index, the CRC-32 of the index, and segmentMagic.
+	segmentTrailerSize = 8 + 4 + len(segmentMagic)
+	// segmentNameFormat is the format of the names of the segment files, from
+	// the first and the last height of their blocks.
+	segmentNameFormat = "blocks-%020d-%020d.seg"
+
+	// segmentIndexCacheSize is the number of segment indexes kept in memory.
+	segmentIndexCacheSize = 16
+	// segmentFileCacheSize is the number of segment files kept open.
+	segmentFileCacheSize = 16
+)
+
+// errSegmentCorrupted is returned when a segment file cannot be decoded.
+var errSegmentCorrupted = errors.New("corrupted block segment")
+
+// segmentRange is the range of heights of the blocks of a segment file.
+type segmentRange struct {
+	from, to int64
+}
+
+// segmentEntry locates the compressed value of a key in a segment file.
+type segmentEntry struct {
+	offset, length int64
+}
+
+// coldStoreConfig is the configuration of a cold store.
+type coldStoreConfig struct {
+	dir         string
+	depth       int64
+	segmentSize int64
+}
+
+// coldStore is the cold storage tier of a block store: the blocks older than
+// depth are moved out of the database, into immutable segment files of
+// segmentSize blocks each.
+//
+// A segment file holds the entries of the database for its blocks, that is
+// their metas, parts and commits, each compressed with zstd, followed by an
+// index of their keys. The hashes of the blocks are kept in the database.
+// Values already compressed in the database, such as compressed block parts,
+// are written as is.
+type coldStore struct {
+	dir         string
+	depth       int64
+	segmentSize int64
+
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+
+	mtx      cmtsync.RWMutex
+	segments []segmentRange // sorted by height
+	indexes  *lru.Cache[segmentRange, map[string]segmentEntry]
+	files    *lru.Cache[segmentRange, *os.File] // closed once evicted
+
+	archiving atomic.Bool
+	wg        sync.WaitGroup
+}
+
+// WithColdStorage moves the blocks older than depth into segment files of
+// segmentSize blocks in dir, from which they are transparently loaded. The
+// cold storage is opened along with the block store, see OpenBlockStore.
+func WithColdStorage(dir string, depth, segmentSize int64) BlockStoreOption {
+	return func(bs *BlockStore) {
+		bs.coldConfig = &coldStoreConfig{dir: dir, depth: depth, segmentSize: segmentSize}
+	}
+}
+
+func newColdStore(cfg coldStoreConfig) (*coldStore, error) {
+	dir, depth, segmentSize := cfg.dir, cfg.depth, cfg.segmentSize
+	if depth <= 0 || segmentSize <= 0 {
+		return nil, fmt.Errorf("invalid cold storage depth %d or segment size %d", depth, segmentSize)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	encoder, err := zstd.NewWriter(nil)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := lru.New[segmentRange, map[string]segmentEntry](segmentIndexCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	files, err := lru.NewWithEvict(segmentFileCacheSize, func(_ segmentRange, f *os.File) { _ = f.Close() })
+	if err != nil {
+		return nil, err
+	}
+	cs := &coldStore{
+		dir:         dir,
+		depth:       depth,
+		segmentSize: segmentSize,
+		encoder:     encoder,
+		decoder:     decoder,
+		indexes:     indexes,
+		files:       files,
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		var r segmentRange
+		if _, err := fmt.Sscanf(entry.Name(), segmentNameFormat, &r.from, &r.to); err != nil {
+			continue
+		}
+		if entry.Name() != fmt.Sprintf(segmentNameFormat, r.from, r.to) {
+			// A segment file being written when the node stopped.
+			if err := os.Remove(filepath.Join(dir, entry.Name())); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		cs.segments = append(cs.segments, r)
+	}
+	sort.Slice(cs.segments, func(i, j int) bool { return cs.segments[i].from < cs.segments[j].from })
+	return cs, nil
+}
+
+// segmentPath returns the path of the segment file of r.
+func (cs *coldStore) segmentPath(r segmentRange) string {
+	return filepath.Join(cs.dir, fmt.Sprintf(segmentNameFormat, r.from, r.to))
+}
+
+// segment returns the segment holding the block at height, if any.
+func (cs *coldStore) segment(height int64) (segmentRange, bool) {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	i := sort.Search(len(cs.segments), func(i int) bool { return cs.segments[i].to >= height })
+	if i == len(cs.segments) || cs.segments[i].from > height {
+		return segmentRange{}, false
+	}
+	return cs.segments[i], true
+}
+
+// lastSegment returns the segment of the highest blocks, if any.
+func (cs *coldStore) lastSegment() (segmentRange, bool) {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	if len(cs.segments) == 0 {
+		return segmentRange{}, false
+	}
+	return cs.segments[len(cs.segments)-1], true
+}
+
+// nextSegment returns the range of the next segment to write, given the base
+// of the block store. Segments are aligned on multiples of segmentSize.
+func (cs *coldStore) nextSegment(base int64) segmentRange {
+	from := base
+	if last, ok := cs.lastSegment(); ok && last.to >= from {
+		from = last.to + 1
+	}
+	return segmentRange{from: from, to: ((from-1)/cs.segmentSize + 1) * cs.segmentSize}
+}
+
+// get returns the value of key, from the segment holding the block at height.
+// It returns nil if there is no such segment or if it does not hold key.
+func (cs *coldStore) get(height int64, key []byte) ([]byte, error) {
+	r, ok := cs.segment(height)
+	if !ok {
+		return nil, nil
+	}
+	index, err := cs.index(r)
+	if err != nil {
+		return nil, err
+	}
+	entry, ok := index[string(key)]
+	if !ok {
+		return nil, nil
+	}
+	bz := make([]byte, entry.length)
+	if err := cs.readAt(r, bz, entry.offset); err != nil {
+		return nil, err
+	}
+	if compress.IsCompressed(bz) {
+		// Written as is, see writeSegment.
+		return bz, nil
+	}
+	return cs.decoder.DecodeAll(bz, nil)
+}
+
+// readAt reads len(bz) bytes of the segment file of r, from offset.
+func (cs *coldStore) readAt(r segmentRange, bz []byte, offset int64) error {
+	for {
+		f, err := cs.file(r)
+		if err != nil {
+			return err
+		}
+		_, err = f.ReadAt(bz, offset)
+		if errors.Is(err, os.ErrClosed) {
+			// The file was evicted from the cache meanwhile: open it again.
+			continue
+		}
+		return err
+	}
+}
+
+// file returns the open segment file of r.
+func (cs *coldStore) file(r segmentRange) (*os.File, error) {
+	if f, ok := cs.files.Get(r); ok {
+		return f, nil
+	}
+	f, err := os.Open(cs.segmentPath(r))
+	if err != nil {
+		return nil, err
+	}
+	if previous, ok, _ := cs.files.PeekOrAdd(r, f); ok {
+		// Opened concurrently.
+		_ = f.Close()
+		return previous, nil
+	}
+	return f, nil
+}
+
+// index returns the index of the segment file of r.
+func (cs *coldStore) index(r segmentRange) (map[string]segmentEntry, error) {
+	if index, ok := cs.indexes.Get(r); ok {
+		return index, nil
+	}
+	index, err := readSegmentIndex(cs.segmentPath(r))
+	if err != nil {
+		return nil, fmt.Errorf("reading segment %d-%d: %w", r.from, r.to, err)
+	}
+	cs.indexes.Add(r, index)
+	return index, nil
+}
+
+// readSegmentIndex reads the index of the segment file at path.
+func readSegmentIndex(path string) (map[string]segmentEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size()
+	if size < int64(len(segmentMagic)+segmentTrailerSize) {
+		return nil, errSegmentCorrupted
+	}
+	trailer := make([]byte, segmentTrailerSize)
+	if _, err := f.ReadAt(trailer, size-int64(segmentTrailerSize)); err != nil {
+		return nil, err
+	}
+	if string(trailer[12:]) != segmentMagic {
+		return nil, errSegmentCorrupted
+	}
+	offset := int64(binary.BigEndian.Uint64(trailer[:8]))
+	if offset < int64(len(segmentMagic)) || offset > size-int64(segmentTrailerSize) {
+		return nil, errSegmentCorrupted
+	}
+	bz := make([]byte, size-int64(segmentTrailerSize)-offset)
+	if _, err := f.ReadAt(bz, offset); err != nil {
+		return nil, err
+	}
+	if crc32.ChecksumIEEE(bz) != binary.BigEndian.Uint32(trailer[8:12]) {
+		return nil, errSegmentCorrupted
+	}
+
+	r := bytes.NewReader(bz)
+	n, err := binary.ReadUvarint(r)
+	if err != nil || n > uint64(len(bz)) {
+		return nil, errSegmentCorrupted
+	}
+	index := make(map[string]segmentEntry, n)
+	for i := uint64(0); i < n; i++ {
+		keyLen, err := binary.ReadUvarint(r)
+		if err != nil || keyLen > uint64(r.Len()) {
+			return nil, errSegmentCorrupted
+		}
+		key := make([]byte, keyLen)
+		if _, err := io.ReadFull(r, key); err != nil {
+			return nil, errSegmentCorrupted
+		}
+		var entry segmentEntry
+		off, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, errSegmentCorrupted
+		}
+		length, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, errSegmentCorrupted
+		}
+		entry.offset, entry.length = int64(off), int64(length)
+		if entry.offset < int64(len(segmentMagic)) || entry.offset+entry.length > offset {
+			return nil, errSegmentCorrupted
+		}
+		index[string(key)] = entry
+	}
+	return index, nil
+}
+
+// writeSegment writes the entries of kvs, pairs of keys and values, to the
+// segment file of r, and adds it to the segments of the cold store.
+func (cs *coldStore) writeSegment(r segmentRange, kvs [][2][]byte) error {
+	tmp, err := os.CreateTemp(cs.dir, fmt.Sprintf(segmentNameFormat, r.from, r.to)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	w := bufio.NewWriter(tmp)
+	if _, err := w.WriteString(segmentMagic); err != nil {
+		return err
+	}
+	offset := int64(len(segmentMagic))
+	var index []byte
+	index = binary.AppendUvarint(index, uint64(len(kvs)))
+	for _, kv := range kvs {
+		// Values compressed in the database are prefixed with a marker byte,
+		// which a zstd frame never starts with, and are loaded as they are.
+		compressed := kv[1]
+		if !compress.IsCompressed(compressed) {
+			compressed = cs.encoder.EncodeAll(kv[1], nil)
+		}
+		if _, err := w.Write(compressed); err != nil {
+			return err
+		}
+		index = binary.AppendUvarint(index, uint64(len(kv[0])))
+		index = append(index, kv[0]...)
+		index = binary.AppendUvarint(index, uint64(offset))
+		index = binary.AppendUvarint(index, uint64(len(compressed)))
+		offset += int64(len(compressed))
+	}
+	if _, err := w.Write(index); err != nil {
+		return err
+	}
+	trailer := binary.BigEndian.AppendUint64(nil, uint64(offset))
+	trailer = binary.BigEndian.AppendUint32(trailer, crc32.ChecksumIEEE(index))
+	trailer = append(trailer, segmentMagic...)
+	if _, err := w.Write(trailer); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), cs.segmentPath(r)); err != nil {
+		return err
+	}
+
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+	cs.segments = append(cs.segments, r)
+	return nil
+}
+
+// prune removes the segment files of the blocks below height.
+func (cs *coldStore) prune(height int64) error {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+	for len(cs.segments) > 0 && cs.segments[0].to < height {
+		cs.files.Remove(cs.segments[0])
+		if err := os.Remove(cs.segmentPath(cs.segments[0])); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		cs.indexes.Remove(cs.segments[0])
+		cs.segments = cs.segments[1:]
+	}
+	return nil
+}
+
+func (cs *coldStore) close() {
+	cs.wg.Wait()
+	cs.files.Purge()
+	cs.decoder.Close()
+	_ = cs.encoder.Close()
+}
+
+// -----------------------------------------------------------------------------
+
+// segmentKeyLayout is the key layout of the entries of the segment files,
+// whatever the key layout of the database.
+var segmentKeyLayout = &v2Layout{}
+
+// get returns the value of the entry k of a block from the database or, if
+// the block was moved to the cold storage, from its segment file.
+func (bs *BlockStore) get(k blockKey) ([]byte, error) {
+	bz, err := bs.db.Get(calcKey(bs.dbKeyLayout, k))
+	if err != nil || len(bz) != 0 || bs.cold == nil {
+		return bz, err
+	}
+	return bs.cold.get(k.height, calcKey(segmentKeyLayout, k))
+}
+
+// maybeArchive moves the blocks older than the cold storage depth to segment
+// files, in the background, if a whole segment of them is available.
+func (bs *BlockStore) maybeArchive() {
+	if bs.cold == nil || bs.cold.nextSegment(bs.Base()).to > bs.Height()-bs.cold.depth {
+		return
+	}
+	if !bs.cold.archiving.CompareAndSwap(false, true) {
+		return
+	}
+	bs.cold.wg.Add(1)
+	go func() {
+		defer bs.cold.wg.Done()
+		defer bs.cold.archiving.Store(false)
+		for {
+			r := bs.cold.nextSegment(bs.Base())
+			if r.to > bs.Height()-bs.cold.depth {
+				return
+			}
+			if err := bs.archiveSegment(r); err != nil {
+				// The blocks are kept in the database, and moved again once
+				// the next block is saved.
+				bs.logger.Error("Failed to move blocks to the cold storage",
+					"from", r.from, "to", r.to, "err", err)
+				return
+			}
+		}
+	}()
+}
+
+// archiveSegment writes the entries of the blocks of r to a segment file,
+// then deletes them from the database.
+func (bs *BlockStore) archiveSegment(r segmentRange) error {
+	var kvs [][2][]byte
+	for h := r.from; h <= r.to; h++ {
+		meta := bs.LoadBlockMeta(h)
+		if meta == nil {
+			// The block was pruned in the meantime.
+			continue
+		}
+		keys := []blockKey{
+			{prefix: prefixBlockMeta, height: h},
+			{prefix: prefixBlockCommit, height: h},
+			{prefix: prefixSeenCommit, height: h},
+			{prefix: prefixExtCommit, height: h},
+		}
+		for p := int64(0); p < int64(meta.BlockID.PartSetHeader.Total); p++ {
+			keys = append(keys, blockKey{prefix: prefixBlockPart, height: h, part: p})
+		}
+		for _, k := range keys {
+			bz, err := bs.db.Get(calcKey(bs.dbKeyLayout, k))
+			if err != nil {
+				return err
+			}
+			if len(bz) != 0 {
+				kvs = append(kvs, [2][]byte{calcKey(segmentKeyLayout, k), bz})
+			}
+		}
+	}
+	if err := bs.cold.writeSegment(r, kvs); err != nil {
+		return err
+	}
+	keys := make([][]byte, len(kvs))
+	for i, kv := range kvs {
+		keys[i] = kv[0]
+	}
+	return bs.deleteArchived(keys)
+}
+
+// deleteArchived deletes the entries of the given keys of the segment files
+// from the database.
+func (bs *BlockStore) deleteArchived(keys [][]byte) error {
+	batch := bs.db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		k, ok := segmentKeyLayout.parseKey(key)
+		if !ok {
+			return errSegmentCorrupted
+		}
+		if err := batch.Delete(calcKey(bs.dbKeyLayout, k)); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+// recoverColdStorage deletes from the database the entries of the last
+// segment file, in case the node stopped before deleting them once written.
+func (bs *BlockStore) recoverColdStorage() error {
+	r, ok := bs.cold.lastSegment()
+	if !ok {
+		return nil
+	}
+	index, err := bs.cold.index(r)
+	if err != nil {
+		return err
+	}
+	keys := make([][]byte, 0, len(index))
+	for key := range index {
+		keys = append(keys, []byte(key))
+	}
+	return bs.deleteArchived(keys)
+}
@@ -0,0 +1,219 @@
+package store
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/compress"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestColdStorage(t *testing.T) {
+	dir := t.TempDir()
+	hot, ss := makeArchiveTestChain(t, 23)
+	type blockEntries struct {
+		block              *types.Block
+		meta               *types.BlockMeta
+		commit, seenCommit *types.Commit
+		extendedCommit     *types.ExtendedCommit
+	}
+	want := make(map[int64]blockEntries)
+	for h := int64(1); h <= 23; h++ {
+		block, meta := hot.LoadBlock(h)
+		want[h] = blockEntries{
+			block:          block,
+			meta:           meta,
+			commit:         hot.LoadBlockCommit(h),
+			seenCommit:     hot.LoadSeenCommit(h),
+			extendedCommit: hot.LoadBlockExtendedCommit(h),
+		}
+	}
+
+	bs := NewBlockStore(hot.db, WithColdStorage(dir, 3, 5))
+	bs.maybeArchive()
+	bs.cold.wg.Wait()
+
+	// The blocks 1 to 20 are older than the depth, and fill whole segments.
+	for _, r := range []segmentRange{{1, 5}, {6, 10}, {11, 15}, {16, 20}} {
+		assert.FileExists(t, bs.cold.segmentPath(r))
+	}
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 4)
+
+	for h := int64(1); h <= 23; h++ {
+		inDB, err := bs.db.Get(bs.dbKeyLayout.CalcBlockMetaKey(h))
+		require.NoError(t, err)
+		assert.Equal(t, h > 20, len(inDB) != 0, h)
+
+		block, meta := bs.LoadBlock(h)
+		require.NotNil(t, block, h)
+		assert.Equal(t, want[h].block.Hash(), block.Hash(), h)
+		assert.Equal(t, want[h].meta, meta, h)
+		byHash, _ := bs.LoadBlockByHash(block.Hash())
+		assert.Equal(t, block.Hash(), byHash.Hash(), h)
+		assert.Equal(t, want[h].commit, bs.LoadBlockCommit(h), h)
+		assert.Equal(t, want[h].seenCommit, bs.LoadSeenCommit(h), h)
+		assert.Equal(t, want[h].extendedCommit, bs.LoadBlockExtendedCommit(h), h)
+	}
+
+	// The segments are found again when reopening the block store.
+	reopened := NewBlockStore(bs.db, WithColdStorage(dir, 3, 5))
+	block, _ := reopened.LoadBlock(7)
+	require.NotNil(t, block)
+	assert.EqualValues(t, 7, block.Height)
+
+	// The blocks in the cold storage cannot be deleted.
+	assert.EqualValues(t, 20, reopened.ColdHeight())
+	for h := int64(23); h > 20; h-- {
+		require.NoError(t, reopened.DeleteLatestBlock())
+	}
+	require.Error(t, reopened.DeleteLatestBlock())
+	assert.EqualValues(t, 20, reopened.Height())
+
+	// Pruning removes the segments of the pruned blocks.
+	state, err := ss.Load()
+	require.NoError(t, err)
+	state.ConsensusParams.Evidence.MaxAgeNumBlocks = 1
+	state.ConsensusParams.Evidence.MaxAgeDuration = 0
+	pruned, _, err := bs.PruneBlocks(12, state)
+	require.NoError(t, err)
+	assert.EqualValues(t, 11, pruned)
+	assert.NoFileExists(t, bs.cold.segmentPath(segmentRange{1, 5}))
+	assert.NoFileExists(t, bs.cold.segmentPath(segmentRange{6, 10}))
+	assert.FileExists(t, bs.cold.segmentPath(segmentRange{11, 15}))
+	block, _ = bs.LoadBlock(12)
+	require.NotNil(t, block)
+}
+
+func TestColdStorageRecovery(t *testing.T) {
+	dir := t.TempDir()
+	bs, _ := makeArchiveTestChain(t, 12)
+
+	// The node stopped after writing a segment, before deleting its blocks
+	// from the database, and while writing the next one.
+	cold, err := newColdStore(coldStoreConfig{dir: dir, depth: 2, segmentSize: 5})
+	require.NoError(t, err)
+	bs.cold = cold
+	var kvs [][2][]byte
+	for h := int64(1); h <= 5; h++ {
+		k := blockKey{prefix: prefixBlockMeta, height: h}
+		bz, err := bs.db.Get(calcKey(bs.dbKeyLayout, k))
+		require.NoError(t, err)
+		kvs = append(kvs, [2][]byte{calcKey(segmentKeyLayout, k), bz})
+	}
+	require.NoError(t, cold.writeSegment(segmentRange{1, 5}, kvs))
+	partial := filepath.Join(dir, "blocks-00000000000000000006-00000000000000000010.seg.123")
+	require.NoError(t, os.WriteFile(partial, []byte(segmentMagic), 0o600))
+
+	bs = NewBlockStore(bs.db, WithColdStorage(dir, 2, 5))
+	assert.NoFileExists(t, partial)
+	for h := int64(1); h <= 5; h++ {
+		inDB, err := bs.db.Get(bs.dbKeyLayout.CalcBlockMetaKey(h))
+		require.NoError(t, err)
+		assert.Empty(t, inDB, h)
+		meta := bs.LoadBlockMeta(h)
+		require.NotNil(t, meta, h)
+		assert.EqualValues(t, h, meta.Header.Height)
+	}
+
+	// Saving a block moves the following segment.
+	block, _ := bs.LoadBlock(12)
+	partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	extCommit := bs.LoadBlockExtendedCommit(12)
+	require.NoError(t, bs.DeleteLatestBlock())
+	bs.SaveBlockWithExtendedCommit(block, partSet, extCommit)
+	bs.cold.wg.Wait()
+	assert.FileExists(t, bs.cold.segmentPath(segmentRange{6, 10}))
+}
+
+func TestSegmentCorruption(t *testing.T) {
+	dir := t.TempDir()
+	cold, err := newColdStore(coldStoreConfig{dir: dir, depth: 1, segmentSize: 10})
+	require.NoError(t, err)
+	r := segmentRange{1, 10}
+	compressed := compress.Compress(bytes.Repeat([]byte("part"), 100))
+	require.NoError(t, cold.writeSegment(r, [][2][]byte{
+		{[]byte("key"), []byte("value")},
+		{[]byte("compressed"), compressed},
+	}))
+
+	bz, err := cold.get(1, []byte("key"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), bz)
+	// Compressed values are written and loaded as they are.
+	content, err := os.ReadFile(cold.segmentPath(r))
+	require.NoError(t, err)
+	assert.True(t, bytes.Contains(content, compressed))
+	bz, err = cold.get(1, []byte("compressed"))
+	require.NoError(t, err)
+	assert.Equal(t, compressed, bz)
+	bz, err = cold.get(11, []byte("key"))
+	require.NoError(t, err)
+	assert.Nil(t, bz)
+
+	cold.close()
+	content[len(content)-segmentTrailerSize-2] ^= 0xff
+	require.NoError(t, os.WriteFile(cold.segmentPath(r), content, 0o600))
+	_, err = readSegmentIndex(cold.segmentPath(r))
+	require.ErrorIs(t, err, errSegmentCorrupted)
+}
+
+func TestColdStorageOpenError(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+
+	_, err := OpenBlockStore(dbm.NewMemDB(), WithColdStorage(file, 3, 5))
+	require.ErrorContains(t, err, "opening the cold storage")
+	_, err = OpenBlockStore(dbm.NewMemDB(), WithColdStorage(t.TempDir(), 0, 5))
+	require.ErrorContains(t, err, "invalid cold storage depth")
+}
+
+func TestColdStorageArchiveFailure(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cold")
+	bs, _ := makeArchiveTestChain(t, 12)
+	bs = NewBlockStore(bs.db, WithColdStorage(dir, 2, 5))
+
+	// The blocks are kept in the database if they cannot be moved.
+	require.NoError(t, os.RemoveAll(dir))
+	bs.maybeArchive()
+	bs.cold.wg.Wait()
+	assert.Zero(t, bs.ColdHeight())
+	assert.NotNil(t, bs.LoadBlockMeta(1))
+
+	// And moved once the next block is saved.
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	block, _ := bs.LoadBlock(12)
+	partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	extCommit := bs.LoadBlockExtendedCommit(12)
+	require.NoError(t, bs.DeleteLatestBlock())
+	bs.SaveBlockWithExtendedCommit(block, partSet, extCommit)
+	bs.cold.wg.Wait()
+	assert.EqualValues(t, 10, bs.ColdHeight())
+	assert.NotNil(t, bs.LoadBlockMeta(1))
+}
+
+func TestWithStorageConfig(t *testing.T) {
+	cfg := config.DefaultStorageConfig()
+	cfg.RootDir = t.TempDir()
+	cfg.Compression = "zstd"
+	cfg.ColdStorageDepth = 3
+	cfg.ColdStorageSegmentSize = 5
+
+	bs, err := OpenBlockStore(dbm.NewMemDB(), WithStorageConfig(cfg))
+	require.NoError(t, err)
+	defer bs.Close()
+	assert.True(t, bs.compress)
+	require.NotNil(t, bs.cold)
+	assert.Equal(t, coldStoreConfig{dir: cfg.ColdStorageDir(), depth: 3, segmentSize: 5}, *bs.coldConfig)
+	assert.DirExists(t, cfg.ColdStorageDir())
+}
@@ -1,7 +1,11 @@
 package store
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/google/orderedcode"
 )
@@ -122,3 +126,125 @@ func (*v2Layout) CalcSeenCommitKey(height int64) []byte {
 }
 
 var _ BlockKeyLayout = (*v2Layout)(nil)
+
+// blockKey identifies an entry of the block store independently of the key
+// layout. Its prefix is one of the prefixes of the v2 layout.
+type blockKey struct {
+	prefix int64
+	height int64
+	part   int64
+	hash   []byte
+}
+
+// blockKeyParser is implemented by the key layouts of the block store.
+type blockKeyParser interface {
+	parseKey(key []byte) (blockKey, bool)
+}
+
+// calcKey returns the key of k in layout l.
+func calcKey(l BlockKeyLayout, k blockKey) []byte {
+	switch k.prefix {
+	case prefixBlockMeta:
+		return l.CalcBlockMetaKey(k.height)
+	case prefixBlockPart:
+		return l.CalcBlockPartKey(k.height, int(k.part))
+	case prefixBlockCommit:
+		return l.CalcBlockCommitKey(k.height)
+	case prefixSeenCommit:
+		return l.CalcSeenCommitKey(k.height)
+	case prefixExtCommit:
+		return l.CalcExtCommitKey(k.height)
+	default:
+		return l.CalcBlockHashKey(k.hash)
+	}
+}
+
+// parseKey parses a key of the v1 layout.
+func (*v1LegacyLayout) parseKey(key []byte) (blockKey, bool) {
+	fields := strings.Split(string(key), ":")
+	if len(fields) < 2 {
+		return blockKey{}, false
+	}
+	prefixes := map[string]int64{
+		"H":  prefixBlockMeta,
+		"P":  prefixBlockPart,
+		"C":  prefixBlockCommit,
+		"SC": prefixSeenCommit,
+		"EC": prefixExtCommit,
+		"BH": prefixBlockHash,
+	}
+	prefix, ok := prefixes[fields[0]]
+	if !ok {
+		return blockKey{}, false
+	}
+	k := blockKey{prefix: prefix}
+	var err error
+	switch {
+	case prefix == prefixBlockHash && len(fields) == 2:
+		k.hash, err = hex.DecodeString(fields[1])
+	case prefix == prefixBlockPart && len(fields) == 3:
+		if k.height, err = strconv.ParseInt(fields[1], 10, 64); err == nil {
+			k.part, err = strconv.ParseInt(fields[2], 10, 64)
+		}
+	case prefix != prefixBlockHash && prefix != prefixBlockPart && len(fields) == 2:
+		k.height, err = strconv.ParseInt(fields[1], 10, 64)
+	default:
+		return blockKey{}, false
+	}
+	return k, err == nil
+}
+
+// parseKey parses a key of the v2 layout.
+func (*v2Layout) parseKey(key []byte) (blockKey, bool) {
+	var k blockKey
+	rest, err := orderedcode.Parse(string(key), &k.prefix)
+	if err != nil {
+		return blockKey{}, false
+	}
+	switch k.prefix {
+	case prefixBlockMeta, prefixBlockCommit, prefixSeenCommit, prefixExtCommit:
+		rest, err = orderedcode.Parse(rest, &k.height)
+	case prefixBlockPart:
+		rest, err = orderedcode.Parse(rest, &k.height, &k.part)
+	case prefixBlockHash:
+		var hash string
+		rest, err = orderedcode.Parse(rest, &hash)
+		k.hash = []byte(hash)
+	default:
+		return blockKey{}, false
+	}
+	return k, err == nil && rest == ""
+}
+
+// keyLayout returns the key layout of the given version.
+func keyLayout(version string) (BlockKeyLayout, error) {
+	switch version {
+	case "v1", "":
+		return &v1LegacyLayout{}, nil
+	case "v2":
+		return &v2Layout{}, nil
+	default:
+		return nil, fmt.Errorf("unknown key layout version %q", version)
+	}
+}
+
+// MigrateKey returns the key of the block store entry stored under key in the
+// from key layout, in the to key layout. Keys that do not depend on the key
+// layout, such as the key of the state of the block store, are returned
+// unchanged.
+func MigrateKey(key []byte, from, to string) ([]byte, error) {
+	fromLayout, err := keyLayout(from)
+	if err != nil {
+		return nil, err
+	}
+	toLayout, err := keyLayout(to)
+	if err != nil {
+		return nil, err
+	}
+	k, ok := fromLayout.(blockKeyParser).parseKey(key)
+	// Keys parsed by chance, e.g. a key of another layout, are not rewritten.
+	if !ok || !bytes.Equal(calcKey(fromLayout, k), key) {
+		return key, nil
+	}
+	return calcKey(toLayout, k), nil
+}
@@ -22,11 +22,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 
 			Buckets: stdprometheus.ExponentialBuckets(0.0002, 10, 5),
 		}, append(labels, "method")).With(labelsAndValues...),
+		BlockPartsBytesSaved: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "block_parts_bytes_saved",
+			Help:      "The number of bytes saved by compressing the block parts written to the block store.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
 		BlockStoreAccessDurationSeconds: discard.NewHistogram(),
+		BlockPartsBytesSaved:            discard.NewCounter(),
 	}
 }
@@ -13,7 +13,10 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 	cmtstore "github.com/cometbft/cometbft/api/cometbft/store/v1"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/compress"
 	"github.com/cometbft/cometbft/internal/evidence"
+	"github.com/cometbft/cometbft/libs/log"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/types"
@@ -62,10 +65,20 @@ type BlockStore struct {
 
 	dbKeyLayout BlockKeyLayout
 
+	// cold is the cold storage tier of the block store, if enabled, opened
+	// from coldConfig.
+	cold       *coldStore
+	coldConfig *coldStoreConfig
+
+	// compress is set if the block parts are compressed when saved.
+	compress bool
+
 	blocksDeleted      int64
 	compact            bool
 	compactionInterval int64
 
+	logger log.Logger
+
 	seenCommitCache          *lru.Cache[int64, *types.Commit]
 	blockCommitCache         *lru.Cache[int64, *types.Commit]
 	blockExtendedCommitCache *lru.Cache[int64, *types.ExtendedCommit]
@@ -86,11 +99,36 @@ func WithMetrics(metrics *Metrics) BlockStoreOption {
 	return func(bs *BlockStore) { bs.metrics = metrics }
 }
 
+// WithLogger sets the logger.
+func WithLogger(logger log.Logger) BlockStoreOption {
+	return func(bs *BlockStore) { bs.logger = logger }
+}
+
+// WithCompression sets whether the block parts are compressed when saved.
+// Block parts are decompressed when loaded whatever this option.
+func WithCompression(compress bool) BlockStoreOption {
+	return func(bs *BlockStore) { bs.compress = compress }
+}
+
 // WithDBKeyLayout the metrics.
 func WithDBKeyLayout(dbKeyLayout string) BlockStoreOption {
 	return func(bs *BlockStore) { setDBLayout(bs, dbKeyLayout) }
 }
 
+// WithStorageConfig sets the compaction, database key layout, compression and
+// cold storage of the block store as in the storage config of a node, so that
+// the tools opening the block store of a node read it as the node does.
+func WithStorageConfig(cfg *config.StorageConfig) BlockStoreOption {
+	return func(bs *BlockStore) {
+		WithCompaction(cfg.Compact, cfg.CompactionInterval)(bs)
+		WithDBKeyLayout(cfg.ExperimentalKeyLayout)(bs)
+		WithCompression(cfg.CompressionEnabled())(bs)
+		if cfg.ColdStorageDepth > 0 {
+			WithColdStorage(cfg.ColdStorageDir(), cfg.ColdStorageDepth, cfg.ColdStorageSegmentSize)(bs)
+		}
+	}
+}
+
 func setDBLayout(bStore *BlockStore, dbKeyLayoutVersion string) {
 	if !bStore.IsEmpty() {
 		var version []byte
@@ -119,8 +157,19 @@ func setDBLayout(bStore *BlockStore, dbKeyLayoutVersion string) {
 }
 
 // NewBlockStore returns a new BlockStore with the given DB,
-// initialized to the last height that was committed to the DB.
+// initialized to the last height that was committed to the DB. It panics if
+// the block store cannot be opened, see OpenBlockStore.
 func NewBlockStore(db dbm.DB, options ...BlockStoreOption) *BlockStore {
+	bs, err := OpenBlockStore(db, options...)
+	if err != nil {
+		panic(err)
+	}
+	return bs
+}
+
+// OpenBlockStore is as NewBlockStore, but returns an error if the cold storage
+// of the block store cannot be opened or recovered.
+func OpenBlockStore(db dbm.DB, options ...BlockStoreOption) (*BlockStore, error) {
 	start := time.Now()
 
 	bs := LoadBlockStoreState(db)
@@ -130,6 +179,7 @@ func NewBlockStore(db dbm.DB, options ...BlockStoreOption) *BlockStore {
 		height:  bs.Height,
 		db:      db,
 		metrics: NopMetrics(),
+		logger:  log.NewNopLogger(),
 	}
 	bStore.addCaches()
 
@@ -140,9 +190,19 @@ func NewBlockStore(db dbm.DB, options ...BlockStoreOption) *BlockStore {
 	if bStore.dbKeyLayout == nil {
 		setDBLayout(bStore, "v1")
 	}
+	if bStore.coldConfig != nil {
+		cold, err := newColdStore(*bStore.coldConfig)
+		if err != nil {
+			return nil, fmt.Errorf("opening the cold storage: %w", err)
+		}
+		bStore.cold = cold
+		if err := bStore.recoverColdStorage(); err != nil {
+			return nil, fmt.Errorf("recovering the cold storage: %w", err)
+		}
+	}
 
 	addTimeSample(bStore.metrics.BlockStoreAccessDurationSeconds.With("method", "new_block_store"), start)()
-	return bStore
+	return bStore, nil
 }
 
 func (bs *BlockStore) addCaches() {
@@ -276,7 +336,7 @@ func (bs *BlockStore) LoadBlockByHash(hash []byte) (*types.Block, *types.BlockMe
 func (bs *BlockStore) LoadBlockPart(height int64, index int) *types.Part {
 	pbpart := new(cmtproto.Part)
 	start := time.Now()
-	bz, err := bs.db.Get(bs.dbKeyLayout.CalcBlockPartKey(height, index))
+	bz, err := bs.get(blockKey{prefix: prefixBlockPart, height: height, part: int64(index)})
 	if err != nil {
 		panic(err)
 	}
@@ -286,6 +346,10 @@ func (bs *BlockStore) LoadBlockPart(height int64, index int) *types.Part {
 	if len(bz) == 0 {
 		return nil
 	}
+	bz, err = compress.Decompress(bz)
+	if err != nil {
+		panic(fmt.Errorf("loading block part: %w", err))
+	}
 	err = proto.Unmarshal(bz, pbpart)
 	if err != nil {
 		panic(fmt.Errorf("unmarshal to cmtproto.Part failed: %w", err))
@@ -303,7 +367,7 @@ func (bs *BlockStore) LoadBlockPart(height int64, index int) *types.Part {
 func (bs *BlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
 	pbbm := new(cmtproto.BlockMeta)
 	start := time.Now()
-	bz, err := bs.db.Get(bs.dbKeyLayout.CalcBlockMetaKey(height))
+	bz, err := bs.get(blockKey{prefix: prefixBlockMeta, height: height})
 	if err != nil {
 		panic(err)
 	}
@@ -359,7 +423,7 @@ func (bs *BlockStore) LoadBlockCommit(height int64) *types.Commit {
 	pbc := new(cmtproto.Commit)
 
 	start := time.Now()
-	bz, err := bs.db.Get(bs.dbKeyLayout.CalcBlockCommitKey(height))
+	bz, err := bs.get(blockKey{prefix: prefixBlockCommit, height: height})
 	if err != nil {
 		panic(err)
 	}
@@ -393,7 +457,7 @@ func (bs *BlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommi
 	pbec := new(cmtproto.ExtendedCommit)
 
 	start := time.Now()
-	bz, err := bs.db.Get(bs.dbKeyLayout.CalcExtCommitKey(height))
+	bz, err := bs.get(blockKey{prefix: prefixExtCommit, height: height})
 	if err != nil {
 		panic(fmt.Errorf("fetching extended commit: %w", err))
 	}
@@ -426,7 +490,7 @@ func (bs *BlockStore) LoadSeenCommit(height int64) *types.Commit {
 	}
 	pbc := new(cmtproto.Commit)
 	start := time.Now()
-	bz, err := bs.db.Get(bs.dbKeyLayout.CalcSeenCommitKey(height))
+	bz, err := bs.get(blockKey{prefix: prefixSeenCommit, height: height})
 	if err != nil {
 		panic(err)
 	}
@@ -540,6 +604,12 @@ func (bs *BlockStore) PruneBlocks(height int64, state sm.State) (uint64, int64,
 	}
 	bs.blocksDeleted += int64(pruned)
 
+	if bs.cold != nil {
+		if err := bs.cold.prune(min(height, evidencePoint)); err != nil {
+			return 0, -1, err
+		}
+	}
+
 	if bs.compact && bs.blocksDeleted >= bs.compactionInterval {
 		// When the range is nil,nil, the database will try to compact
 		// ALL levels. Another option is to set a predefined range of
@@ -575,6 +645,7 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 		panic(err)
 	}
 
+	defer bs.maybeArchive()
 	bs.mtx.Lock()
 	defer bs.mtx.Unlock()
 	bs.height = block.Height
@@ -625,6 +696,7 @@ func (bs *BlockStore) SaveBlockWithExtendedCommit(block *types.Block, blockParts
 		panic(err)
 	}
 
+	defer bs.maybeArchive()
 	bs.mtx.Lock()
 	defer bs.mtx.Unlock()
 	bs.height = height
@@ -651,12 +723,50 @@ func (bs *BlockStore) saveBlockToBatch(
 		panic("BlockStore can only save a non-nil block")
 	}
 
+	if g, w := block.Height, bs.Height()+1; bs.Base() > 0 && g != w {
+		return fmt.Errorf("BlockStore can only save contiguous blocks. Wanted %v, got %v", w, g)
+	}
+	return bs.writeBlockToBatch(block, blockParts, seenCommit, batch)
+}
+
+// BackfillBlock persists the given block, blockParts, and seenCommit right
+// below the base of the store, e.g. to fetch the history preceding the height
+// a node state synced to. The store must not be empty, and the block must be
+// at its base minus one; it becomes the new base.
+func (bs *BlockStore) BackfillBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) error {
+	defer addTimeSample(bs.metrics.BlockStoreAccessDurationSeconds.With("method", "backfill_block"), time.Now())()
+	if block == nil {
+		return errors.New("BlockStore can only backfill a non-nil block")
+	}
+
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+	if g, w := block.Height, bs.base-1; bs.base == 0 || g != w {
+		return fmt.Errorf("BlockStore can only backfill the block below its base %d, got %d", bs.base, g)
+	}
+
+	batch := bs.db.NewBatch()
+	defer batch.Close()
+	if err := bs.writeBlockToBatch(block, blockParts, seenCommit, batch); err != nil {
+		return err
+	}
+	bs.base = block.Height
+	if err := bs.saveStateAndWriteDB(batch, "failed to backfill block"); err != nil {
+		bs.base++
+		return err
+	}
+	return nil
+}
+
+func (bs *BlockStore) writeBlockToBatch(
+	block *types.Block,
+	blockParts *types.PartSet,
+	seenCommit *types.Commit,
+	batch dbm.Batch,
+) error {
 	height := block.Height
 	hash := block.Hash()
 
-	if g, w := height, bs.Height()+1; bs.Base() > 0 && g != w {
-		return fmt.Errorf("BlockStore can only save contiguous blocks. Wanted %v, got %v", w, g)
-	}
 	if !blockParts.IsComplete() {
 		return errors.New("BlockStore can only save complete block part sets")
 	}
@@ -730,7 +840,7 @@ func (bs *BlockStore) saveBlockPart(height int64, index int, part *types.Part, b
 		panic(cmterrors.ErrMsgToProto{MessageName: "Part", Err: err})
 	}
 
-	partBytes := mustEncode(pbp)
+	partBytes := bs.maybeCompress(mustEncode(pbp))
 
 	if saveBlockPartsToBatch {
 		err = batch.Set(bs.dbKeyLayout.CalcBlockPartKey(height, index), partBytes)
@@ -742,6 +852,78 @@ func (bs *BlockStore) saveBlockPart(height int64, index int, part *types.Part, b
 	}
 }
 
+// maybeCompress returns the compressed form of the block part bz if
+// compression is enabled and it is smaller, or bz otherwise.
+func (bs *BlockStore) maybeCompress(bz []byte) []byte {
+	if !bs.compress {
+		return bz
+	}
+	compressed := compress.Compress(bz)
+	if len(compressed) >= len(bz) {
+		return bz
+	}
+	bs.metrics.BlockPartsBytesSaved.Add(float64(len(bz) - len(compressed)))
+	return compressed
+}
+
+// RecompressBlockParts compresses the block parts of the given range of
+// heights stored uncompressed, e.g. saved before compression was enabled,
+// and returns the number of parts rewritten. It does nothing if compression
+// is disabled. Heights outside of the store, or whose blocks are to be moved
+// to the cold storage, which compresses them, are skipped.
+func (bs *BlockStore) RecompressBlockParts(fromHeight, toHeight int64) (int64, error) {
+	if !bs.compress {
+		return 0, nil
+	}
+	var recompressed int64
+	for h := fromHeight; h <= toHeight; h++ {
+		n, err := bs.recompressBlockParts(h)
+		if err != nil {
+			return recompressed, fmt.Errorf("recompressing block parts at height %d: %w", h, err)
+		}
+		recompressed += n
+	}
+	return recompressed, nil
+}
+
+func (bs *BlockStore) recompressBlockParts(height int64) (int64, error) {
+	// The read lock prevents the block from being pruned or deleted while its
+	// parts are rewritten, which would store them again.
+	bs.mtx.RLock()
+	defer bs.mtx.RUnlock()
+	if height < bs.base || height > bs.height ||
+		(bs.cold != nil && height <= bs.height-bs.cold.depth) {
+		return 0, nil
+	}
+	meta := bs.LoadBlockMeta(height)
+	if meta == nil {
+		return 0, nil
+	}
+	batch := bs.db.NewBatch()
+	defer batch.Close()
+	var recompressed int64
+	for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+		key := bs.dbKeyLayout.CalcBlockPartKey(height, p)
+		bz, err := bs.db.Get(key)
+		if err != nil {
+			return 0, err
+		}
+		if len(bz) == 0 || compress.IsCompressed(bz) {
+			continue
+		}
+		if compressed := bs.maybeCompress(bz); len(compressed) < len(bz) {
+			if err := batch.Set(key, compressed); err != nil {
+				return 0, err
+			}
+			recompressed++
+		}
+	}
+	if recompressed == 0 {
+		return 0, nil
+	}
+	return recompressed, batch.WriteSync()
+}
+
 // Contract: the caller MUST have, at least, a read lock on `bs`.
 func (bs *BlockStore) saveStateAndWriteDB(batch dbm.Batch, errMsg string) error {
 	bss := cmtstore.BlockStoreState{
@@ -776,6 +958,9 @@ func (bs *BlockStore) SaveSeenCommit(height int64, seenCommit *types.Commit) err
 }
 
 func (bs *BlockStore) Close() error {
+	if bs.cold != nil {
+		bs.cold.close()
+	}
 	return bs.db.Close()
 }
 
@@ -841,6 +1026,10 @@ func (bs *BlockStore) DeleteLatestBlock() error {
 	targetHeight := bs.height
 	bs.mtx.RUnlock()
 
+	if targetHeight <= bs.ColdHeight() {
+		return fmt.Errorf("block at height %d was moved to the cold storage", targetHeight)
+	}
+
 	batch := bs.db.NewBatch()
 	defer batch.Close()
 
@@ -873,6 +1062,20 @@ func (bs *BlockStore) DeleteLatestBlock() error {
 	return bs.saveStateAndWriteDB(batch, "failed to delete the latest block")
 }
 
+// ColdHeight returns the height of the highest block moved to the cold
+// storage, or 0 if the cold storage is disabled or empty. Such blocks cannot be
+// deleted.
+func (bs *BlockStore) ColdHeight() int64 {
+	if bs.cold == nil {
+		return 0
+	}
+	last, ok := bs.cold.lastSegment()
+	if !ok {
+		return 0
+	}
+	return last.to
+}
+
 // addTimeSample returns a function that, when called, adds an observation to m.
 // The observation added to m is the number of seconds elapsed since addTimeSample
 // was initially called. addTimeSample is meant to be called in a defer to calculate
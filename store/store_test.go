@@ -19,6 +19,7 @@ import (
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/internal/compress"
 	cmtrand "github.com/cometbft/cometbft/internal/rand"
 	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/libs/log"
@@ -497,6 +498,51 @@ func TestLoadBaseMeta(t *testing.T) {
 	require.EqualValues(t, 9, bs.Height())
 }
 
+func TestBackfillBlock(t *testing.T) {
+	config := test.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	state, err := stateStore.LoadFromDBOrGenesisFile(config.GenesisFile())
+	require.NoError(t, err)
+	bs := NewBlockStore(dbm.NewMemDB())
+
+	blocks := make(map[int64]*types.Block)
+	parts := make(map[int64]*types.PartSet)
+	for h := int64(1); h <= 5; h++ {
+		blocks[h] = state.MakeBlock(h, test.MakeNTxs(h, 2), makeTestExtCommit(h-1, cmttime.Now()).ToCommit(), nil, state.Validators.GetProposer().Address)
+		parts[h], err = blocks[h].MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+	}
+
+	// The store must not be empty.
+	err = bs.BackfillBlock(blocks[4], parts[4], makeTestExtCommit(4, cmttime.Now()).ToCommit())
+	require.Error(t, err)
+
+	bs.SaveBlock(blocks[5], parts[5], makeTestExtCommit(5, cmttime.Now()).ToCommit())
+	for h := int64(4); h >= 2; h-- {
+		require.NoError(t, bs.BackfillBlock(blocks[h], parts[h], blocks[h+1].LastCommit))
+		assert.EqualValues(t, h, bs.Base())
+		assert.EqualValues(t, 5, bs.Height())
+	}
+
+	// Only the block below the base can be backfilled.
+	require.Error(t, bs.BackfillBlock(blocks[5], parts[5], blocks[2].LastCommit))
+
+	for h := int64(2); h <= 5; h++ {
+		block, _ := bs.LoadBlock(h)
+		require.NotNil(t, block)
+		assert.Equal(t, blocks[h].Hash(), block.Hash())
+		assert.Equal(t, blocks[h].LastCommit.Hash(), bs.LoadBlockCommit(h-1).Hash())
+	}
+
+	// The new base is persisted.
+	bss := LoadBlockStoreState(bs.db)
+	assert.EqualValues(t, 2, bss.Base)
+	assert.EqualValues(t, 5, bss.Height)
+}
+
 func TestLoadBlockPart(t *testing.T) {
 	config := test.ResetTestRoot("blockchain_reactor_test")
 
@@ -546,6 +592,65 @@ func TestLoadBlockPart(t *testing.T) {
 		"expecting successful retrieval of previously saved block")
 }
 
+func TestBlockStoreCompression(t *testing.T) {
+	config := test.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	state, err := sm.MakeGenesisStateFromFile(config.GenesisFile())
+	require.NoError(t, err)
+
+	db := dbm.NewMemDB()
+	saveBlock := func(bs *BlockStore) *types.Block {
+		txs := []types.Tx{make([]byte, types.BlockPartSizeBytes)}
+		block := state.MakeBlock(bs.Height()+1, txs, new(types.Commit), nil, state.Validators.GetProposer().Address)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		bs.SaveBlockWithExtendedCommit(block, partSet, makeTestExtCommit(block.Height, cmttime.Now()))
+		return block
+	}
+	partsCompressed := func(bs *BlockStore, height int64) bool {
+		meta := bs.LoadBlockMeta(height)
+		require.NotNil(t, meta)
+		compressed := true
+		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+			bz, err := db.Get(bs.dbKeyLayout.CalcBlockPartKey(height, p))
+			require.NoError(t, err)
+			compressed = compressed && compress.IsCompressed(bz)
+		}
+		return compressed
+	}
+
+	// Blocks saved without compression.
+	bs := NewBlockStore(db)
+	blocks := []*types.Block{saveBlock(bs), saveBlock(bs)}
+	assert.False(t, partsCompressed(bs, 1))
+	// Recompressing does nothing if compression is disabled.
+	n, err := bs.RecompressBlockParts(1, 2)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	bs = NewBlockStore(db, WithCompression(true))
+	blocks = append(blocks, saveBlock(bs))
+	assert.False(t, partsCompressed(bs, 2))
+	assert.True(t, partsCompressed(bs, 3))
+
+	n, err = bs.RecompressBlockParts(1, 3)
+	require.NoError(t, err)
+	assert.EqualValues(t, bs.LoadBlockMeta(1).BlockID.PartSetHeader.Total+bs.LoadBlockMeta(2).BlockID.PartSetHeader.Total, n)
+	for i, block := range blocks {
+		h := int64(i + 1)
+		assert.True(t, partsCompressed(bs, h), h)
+		loaded, _ := bs.LoadBlock(h)
+		require.NotNil(t, loaded, h)
+		assert.Equal(t, block.Hash(), loaded.Hash(), h)
+	}
+
+	// Compressed parts are loaded whatever the option.
+	bs = NewBlockStore(db)
+	loaded, _ := bs.LoadBlock(3)
+	require.NotNil(t, loaded)
+	assert.Equal(t, blocks[2].Hash(), loaded.Hash())
+}
+
 type prunerObserver struct {
 	sm.NoopPrunerObserver
 	prunedABCIResInfoCh   chan *sm.ABCIResponsesPrunedInfo
@@ -989,3 +1094,33 @@ func initStateStoreRetainHeights(stateStore sm.Store, appBlockRH, dcBlockRH, dcB
 	}
 	return nil
 }
+
+func TestMigrateKey(t *testing.T) {
+	hash := []byte("0123456789abcdef0123456789abcdef")
+	layouts := map[string]BlockKeyLayout{"v1": &v1LegacyLayout{}, "v2": &v2Layout{}}
+	keys := func(l BlockKeyLayout) [][]byte {
+		return [][]byte{
+			l.CalcBlockMetaKey(1234),
+			l.CalcBlockPartKey(1234, 5),
+			l.CalcBlockCommitKey(1234),
+			l.CalcSeenCommitKey(1234),
+			l.CalcExtCommitKey(1234),
+			l.CalcBlockHashKey(hash),
+		}
+	}
+	for from, fromLayout := range layouts {
+		for to, toLayout := range layouts {
+			want := keys(toLayout)
+			for i, key := range keys(fromLayout) {
+				got, err := MigrateKey(key, from, to)
+				require.NoError(t, err)
+				assert.Equal(t, want[i], got, "%s to %s: %q", from, to, key)
+			}
+			got, err := MigrateKey(blockStoreKey, from, to)
+			require.NoError(t, err)
+			assert.Equal(t, blockStoreKey, got)
+		}
+	}
+	_, err := MigrateKey([]byte("H:1"), "v1", "v3")
+	require.Error(t, err)
+}
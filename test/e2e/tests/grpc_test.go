@@ -1,6 +1,7 @@
 package e2e_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"testing"
@@ -65,6 +66,125 @@ func TestGRPC_Block_GetByHeight(t *testing.T) {
 	})
 }
 
+func TestGRPC_Block_GetSignedHeader(t *testing.T) {
+	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
+		t.Helper()
+		client, err := node.Client()
+		require.NoError(t, err)
+		status, err := client.Status(ctx)
+		require.NoError(t, err)
+		// The latest block is not signed by a commit stored in a block yet.
+		height := status.SyncInfo.LatestBlockHeight - 1
+
+		ctx, ctxCancel := context.WithTimeout(context.Background(), time.Minute)
+		defer ctxCancel()
+		gRPCClient, err := node.GRPCClient(ctx)
+		require.NoError(t, err)
+		defer gRPCClient.Close()
+
+		sh, err := gRPCClient.GetSignedHeader(ctx, height)
+		require.NoError(t, err)
+		require.Equal(t, height, sh.Height)
+
+		// The signed header matches the header of the block and the last commit
+		// of the next one.
+		block, err := gRPCClient.GetBlockByHeight(ctx, height)
+		require.NoError(t, err)
+		require.Equal(t, block.Block.Hash(), sh.Hash())
+		next, err := gRPCClient.GetBlockByHeight(ctx, height+1)
+		require.NoError(t, err)
+		require.Equal(t, next.Block.LastCommit.Hash(), sh.Commit.Hash())
+	})
+}
+
+func TestGRPC_ValidatorSet_GetByHeight(t *testing.T) {
+	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
+		t.Helper()
+		client, err := node.Client()
+		require.NoError(t, err)
+		status, err := client.Status(ctx)
+		require.NoError(t, err)
+		last := status.SyncInfo.LatestBlockHeight
+
+		ctx, ctxCancel := context.WithTimeout(context.Background(), time.Minute)
+		defer ctxCancel()
+		gRPCClient, err := node.GRPCClient(ctx)
+		require.NoError(t, err)
+		defer gRPCClient.Close()
+
+		vals, err := gRPCClient.GetValidatorSetByHeight(ctx, last)
+		require.NoError(t, err)
+		require.Equal(t, last, vals.Height)
+
+		// The validator set matches the one of the block header.
+		block, err := gRPCClient.GetBlockByHeight(ctx, last)
+		require.NoError(t, err)
+		require.Equal(t, block.Block.ValidatorsHash, vals.ValidatorSet.Hash())
+	})
+}
+
+func TestGRPC_ConsensusParams_GetByHeight(t *testing.T) {
+	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
+		t.Helper()
+		client, err := node.Client()
+		require.NoError(t, err)
+		status, err := client.Status(ctx)
+		require.NoError(t, err)
+		last := status.SyncInfo.LatestBlockHeight
+
+		ctx, ctxCancel := context.WithTimeout(context.Background(), time.Minute)
+		defer ctxCancel()
+		gRPCClient, err := node.GRPCClient(ctx)
+		require.NoError(t, err)
+		defer gRPCClient.Close()
+
+		params, err := gRPCClient.GetConsensusParamsByHeight(ctx, last)
+		require.NoError(t, err)
+		require.Equal(t, last, params.Height)
+
+		// The consensus parameters match the ones of the block header.
+		block, err := gRPCClient.GetBlockByHeight(ctx, last)
+		require.NoError(t, err)
+		require.Equal(t, []byte(block.Block.ConsensusHash), params.ConsensusParams.Hash())
+	})
+}
+
+func TestGRPC_ValidatorSet_GetChanges(t *testing.T) {
+	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
+		t.Helper()
+		client, err := node.Client()
+		require.NoError(t, err)
+		status, err := client.Status(ctx)
+		require.NoError(t, err)
+		first := max(status.SyncInfo.EarliestBlockHeight+1, status.SyncInfo.LatestBlockHeight-20)
+		last := status.SyncInfo.LatestBlockHeight
+
+		ctx, ctxCancel := context.WithTimeout(context.Background(), time.Minute)
+		defer ctxCancel()
+		gRPCClient, err := node.GRPCClient(ctx)
+		require.NoError(t, err)
+		defer gRPCClient.Close()
+
+		changes, err := gRPCClient.GetValidatorSetChanges(ctx, first, last)
+		require.NoError(t, err)
+		require.Equal(t, last, changes.LastHeight)
+
+		// The changes match the validators hashes of the block headers.
+		prev, err := gRPCClient.GetBlockByHeight(ctx, first-1)
+		require.NoError(t, err)
+		expected := []int64{}
+		for h := first; h <= last; h++ {
+			block, err := gRPCClient.GetBlockByHeight(ctx, h)
+			require.NoError(t, err)
+			if !bytes.Equal(prev.Block.ValidatorsHash, block.Block.ValidatorsHash) {
+				expected = append(expected, h)
+			}
+			prev = block
+		}
+		require.Equal(t, expected, changes.Heights)
+	})
+}
+
 func TestGRPC_Block_GetLatestHeight(t *testing.T) {
 	t.Helper()
 	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
@@ -6,10 +6,14 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
+	"github.com/spf13/viper"
+
 	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/store"
 	"github.com/cometbft/cometbft/test/loadtime/report"
 )
@@ -19,6 +23,7 @@ var (
 	dir     = flag.String("data-dir", "", "path to the directory containing the CometBFT databases")
 	csvOut  = flag.String("csv", "", "dump the extracted latencies as raw csv for use in additional tooling")
 	oneline = flag.Bool("oneline", false, "display the results in one line of comma-separated values")
+	home    = flag.String("home", "", "path to the home directory of the node, to open the blockstore with the storage settings of its config, e.g. its cold storage")
 )
 
 func main() {
@@ -26,6 +31,17 @@ func main() {
 	if *db == "" {
 		log.Fatalf("must specify a database-type")
 	}
+	var options []store.BlockStoreOption
+	if *home != "" {
+		cfg, err := loadConfig(*home)
+		if err != nil {
+			log.Fatalf("loading the config of %s: %v", *home, err)
+		}
+		options = append(options, store.WithStorageConfig(cfg.Storage))
+		if *dir == "" {
+			*dir = cfg.DBDir()
+		}
+	}
 	if *dir == "" {
 		log.Fatalf("must specify a data-dir")
 	}
@@ -46,7 +62,10 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	s := store.NewBlockStore(db)
+	s, err := store.OpenBlockStore(db, options...)
+	if err != nil {
+		panic(err)
+	}
 	defer s.Close()
 	rs, err := report.GenerateFromBlockStore(s)
 	if err != nil {
@@ -108,3 +127,19 @@ func toCSVRecords(rs []report.Report) [][]string {
 	}
 	return res
 }
+
+// loadConfig loads the config of the node with the given home directory.
+func loadConfig(home string) (*config.Config, error) {
+	v := viper.New()
+	v.AddConfigPath(filepath.Join(home, "config"))
+	v.SetConfigName("config")
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	cfg := config.DefaultConfig()
+	if err := v.Unmarshal(cfg); err != nil {
+		return nil, err
+	}
+	cfg.SetRoot(home)
+	return cfg, cfg.ValidateBasic()
+}
@@ -65,10 +65,22 @@ type EvidenceParams struct {
 	MaxBytes        int64         `json:"max_bytes"`
 }
 
-// ValidatorParams restrict the public key types validators can use.
+// ValidatorParams restrict the public key types validators can use, and
+// select the algorithm choosing the proposer of each round.
 // NOTE: uses ABCI pubkey naming, not Amino names.
 type ValidatorParams struct {
-	PubKeyTypes []string `json:"pub_key_types"`
+	PubKeyTypes       []string `json:"pub_key_types"`
+	ProposerSelection string   `json:"proposer_selection"`
+}
+
+// ProposerSelector returns the ProposerSelector named by ProposerSelection.
+// It panics if the name is unknown, which ValidateBasic rules out.
+func (p ValidatorParams) ProposerSelector() ProposerSelector {
+	selector, ok := GetProposerSelector(p.ProposerSelection)
+	if !ok {
+		panic(fmt.Sprintf("unknown proposer selection %q", p.ProposerSelection))
+	}
+	return selector
 }
 
 // VersionParams contain the version of specific components of CometBFT.
@@ -169,10 +181,11 @@ func DefaultEvidenceParams() EvidenceParams {
 }
 
 // DefaultValidatorParams returns a default ValidatorParams, which allows
-// only ed25519 pubkeys.
+// only ed25519 pubkeys and selects proposers in a weighted round-robin fashion.
 func DefaultValidatorParams() ValidatorParams {
 	return ValidatorParams{
-		PubKeyTypes: []string{ABCIPubKeyTypeEd25519},
+		PubKeyTypes:       []string{ABCIPubKeyTypeEd25519},
+		ProposerSelection: ProposerSelectionWeightedRoundRobin,
 	}
 }
 
@@ -285,6 +298,11 @@ func (params ConsensusParams) ValidateBasic() error {
 		}
 	}
 
+	if _, ok := GetProposerSelector(params.Validator.ProposerSelection); !ok {
+		return fmt.Errorf("params.Validator.ProposerSelection, %s, is an unknown proposer selection",
+			params.Validator.ProposerSelection)
+	}
+
 	return nil
 }
 
@@ -423,6 +441,10 @@ func (params ConsensusParams) Update(params2 *cmtproto.ConsensusParams) Consensu
 		// Copy params2.Validator.PubkeyTypes, and set result's value to the copy.
 		// This avoids having to initialize the slice to 0 values, and then write to it again.
 		res.Validator.PubKeyTypes = append([]string{}, params2.Validator.PubKeyTypes...)
+		// An update leaving the proposer selection unset keeps it.
+		if params2.Validator.ProposerSelection != "" {
+			res.Validator.ProposerSelection = params2.Validator.ProposerSelection
+		}
 	}
 	if params2.Version != nil {
 		res.Version.App = params2.Version.App
@@ -460,7 +482,8 @@ func (params *ConsensusParams) ToProto() cmtproto.ConsensusParams {
 			MaxBytes:        params.Evidence.MaxBytes,
 		},
 		Validator: &cmtproto.ValidatorParams{
-			PubKeyTypes: params.Validator.PubKeyTypes,
+			PubKeyTypes:       params.Validator.PubKeyTypes,
+			ProposerSelection: params.Validator.ProposerSelection,
 		},
 		Version: &cmtproto.VersionParams{
 			App: params.Version.App,
@@ -488,7 +511,8 @@ func ConsensusParamsFromProto(pbParams cmtproto.ConsensusParams) ConsensusParams
 			MaxBytes:        pbParams.Evidence.MaxBytes,
 		},
 		Validator: ValidatorParams{
-			PubKeyTypes: pbParams.Validator.PubKeyTypes,
+			PubKeyTypes:       pbParams.Validator.PubKeyTypes,
+			ProposerSelection: pbParams.Validator.ProposerSelection,
 		},
 		Version: VersionParams{
 			App: pbParams.Version.App,
@@ -195,6 +195,25 @@ func TestConsensusParamsValidation(t *testing.T) {
 			}),
 			valid: false,
 		},
+		// proposer selection
+		{
+			name: "verifiable random proposer selection",
+			params: makeParams(makeParamsArgs{
+				blockBytes:        1,
+				evidenceAge:       2,
+				proposerSelection: ProposerSelectionVerifiableRandom,
+			}),
+			valid: true,
+		},
+		{
+			name: "bad proposer selection",
+			params: makeParams(makeParamsArgs{
+				blockBytes:        1,
+				evidenceAge:       2,
+				proposerSelection: "coin toss",
+			}),
+			valid: false,
+		},
 		// pbts enabled, invalid synchrony params
 		{
 			name: "messageDelay 0",
@@ -307,6 +326,7 @@ type makeParamsArgs struct {
 	evidenceAge         int64
 	maxEvidenceBytes    int64
 	pubkeyTypes         []string
+	proposerSelection   string
 	voteExtensionHeight int64
 	pbtsHeight          int64
 	precision           time.Duration
@@ -329,7 +349,8 @@ func makeParams(args makeParamsArgs) ConsensusParams {
 			MaxBytes:        args.maxEvidenceBytes,
 		},
 		Validator: ValidatorParams{
-			PubKeyTypes: args.pubkeyTypes,
+			PubKeyTypes:       args.pubkeyTypes,
+			ProposerSelection: args.proposerSelection,
 		},
 		Synchrony: SynchronyParams{
 			Precision:    args.precision,
@@ -487,6 +508,37 @@ func TestConsensusParamsUpdate(t *testing.T) {
 			},
 			updatedParams: makeParams(makeParamsArgs{blockBytes: 1, blockGas: 2, evidenceAge: 3, pubkeyTypes: valEd25519AndSecp256k1}),
 		},
+		// update proposer selection
+		{
+			intialParams: makeParams(makeParamsArgs{blockBytes: 1, blockGas: 2, evidenceAge: 3}),
+			updates: &cmtproto.ConsensusParams{
+				Validator: &cmtproto.ValidatorParams{
+					PubKeyTypes:       valEd25519,
+					ProposerSelection: ProposerSelectionVerifiableRandom,
+				},
+			},
+			updatedParams: makeParams(makeParamsArgs{
+				blockBytes: 1, blockGas: 2, evidenceAge: 3,
+				proposerSelection: ProposerSelectionVerifiableRandom,
+			}),
+		},
+		// update pubkey types only, keeping the proposer selection
+		{
+			intialParams: makeParams(makeParamsArgs{
+				blockBytes: 1, blockGas: 2, evidenceAge: 3,
+				proposerSelection: ProposerSelectionVerifiableRandom,
+			}),
+			updates: &cmtproto.ConsensusParams{
+				Validator: &cmtproto.ValidatorParams{
+					PubKeyTypes: valSecp256k1,
+				},
+			},
+			updatedParams: makeParams(makeParamsArgs{
+				blockBytes: 1, blockGas: 2, evidenceAge: 3,
+				pubkeyTypes:       valSecp256k1,
+				proposerSelection: ProposerSelectionVerifiableRandom,
+			}),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+)
+
+const (
+	// ProposerSelectionWeightedRoundRobin selects proposers in a weighted
+	// round-robin fashion, following the validators' proposer priorities.
+	ProposerSelectionWeightedRoundRobin = "weighted_round_robin"
+	// ProposerSelectionVerifiableRandom selects proposers randomly, weighted by
+	// voting power, from a seed known to all validators (the LastCommitHash of
+	// the previous block), so that the proposers of future heights are not
+	// known in advance.
+	ProposerSelectionVerifiableRandom = "verifiable_random"
+)
+
+// ProposerSelector selects the proposer of a round among a validator set.
+// Implementations must be deterministic: all validators need to agree on the
+// proposer.
+type ProposerSelector interface {
+	// SelectProposer returns the proposer of the given round. vals has its
+	// proposer priorities already incremented for that round, and seed is the
+	// same for all rounds of a height.
+	SelectProposer(vals *ValidatorSet, seed []byte, round int32) *Validator
+}
+
+var proposerSelectors = map[string]ProposerSelector{
+	ProposerSelectionWeightedRoundRobin: WeightedRoundRobinSelector{},
+	ProposerSelectionVerifiableRandom:   VerifiableRandomSelector{},
+}
+
+// RegisterProposerSelector makes selector available under name to the
+// Validator.ProposerSelection consensus parameter.
+//
+// NOTE: not goroutine-safe; it must be called before the node starts, e.g. in
+// an init function, and identically on all the nodes of a network.
+func RegisterProposerSelector(name string, selector ProposerSelector) {
+	if _, ok := proposerSelectors[name]; ok {
+		panic(fmt.Sprintf("proposer selector %q already registered", name))
+	}
+	proposerSelectors[name] = selector
+}
+
+// GetProposerSelector returns the ProposerSelector registered under name. The
+// empty name stands for ProposerSelectionWeightedRoundRobin.
+func GetProposerSelector(name string) (ProposerSelector, bool) {
+	if name == "" {
+		name = ProposerSelectionWeightedRoundRobin
+	}
+	selector, ok := proposerSelectors[name]
+	return selector, ok
+}
+
+// WeightedRoundRobinSelector selects the validator with the highest proposer
+// priority, i.e. the proposer of the ValidatorSet.
+type WeightedRoundRobinSelector struct{}
+
+// SelectProposer implements ProposerSelector.
+func (WeightedRoundRobinSelector) SelectProposer(vals *ValidatorSet, _ []byte, _ int32) *Validator {
+	return vals.GetProposer()
+}
+
+// VerifiableRandomSelector draws the proposer with a probability proportional
+// to its voting power, from the hash of the seed and the round. Anyone knowing
+// the seed can verify the proposer of a round.
+type VerifiableRandomSelector struct{}
+
+// SelectProposer implements ProposerSelector.
+func (VerifiableRandomSelector) SelectProposer(vals *ValidatorSet, seed []byte, round int32) *Validator {
+	if len(vals.Validators) == 0 {
+		return nil
+	}
+
+	bz := make([]byte, len(seed)+4)
+	copy(bz, seed)
+	binary.BigEndian.PutUint32(bz[len(seed):], uint32(round))
+	draw := new(big.Int).SetBytes(tmhash.Sum(bz))
+	draw.Mod(draw, big.NewInt(vals.TotalVotingPower()))
+
+	// walk the validators in their canonical order
+	remaining := draw.Int64()
+	for _, val := range vals.Validators {
+		if remaining < val.VotingPower {
+			return val.Copy()
+		}
+		remaining -= val.VotingPower
+	}
+	panic("draw exceeds the total voting power")
+}
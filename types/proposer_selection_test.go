@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProposerSelector(t *testing.T) {
+	selector, ok := GetProposerSelector("")
+	require.True(t, ok)
+	assert.Equal(t, WeightedRoundRobinSelector{}, selector)
+
+	selector, ok = GetProposerSelector(ProposerSelectionVerifiableRandom)
+	require.True(t, ok)
+	assert.Equal(t, VerifiableRandomSelector{}, selector)
+
+	_, ok = GetProposerSelector("coin toss")
+	assert.False(t, ok)
+
+	assert.Panics(t, func() {
+		RegisterProposerSelector(ProposerSelectionWeightedRoundRobin, VerifiableRandomSelector{})
+	})
+}
+
+func TestWeightedRoundRobinSelector(t *testing.T) {
+	vals, _ := RandValidatorSet(4, 10)
+	for round := int32(0); round < 8; round++ {
+		proposer := WeightedRoundRobinSelector{}.SelectProposer(vals, []byte("seed"), round)
+		assert.Equal(t, vals.GetProposer(), proposer)
+		vals.IncrementProposerPriority(1)
+	}
+}
+
+func TestVerifiableRandomSelector(t *testing.T) {
+	vals := NewValidatorSet([]*Validator{
+		newValidator([]byte("a"), 1),
+		newValidator([]byte("b"), 3),
+		newValidator([]byte("c"), 6),
+	})
+	selector := VerifiableRandomSelector{}
+
+	// the selection is deterministic
+	seed := []byte("seed")
+	first := selector.SelectProposer(vals, seed, 0)
+	assert.Equal(t, first, selector.SelectProposer(vals.Copy(), seed, 0))
+
+	// and proportional to the voting power
+	counts := make(map[string]int)
+	const draws = 10000
+	for i := 0; i < draws; i++ {
+		proposer := selector.SelectProposer(vals, []byte{byte(i), byte(i >> 8)}, int32(i%7))
+		counts[string(proposer.Address)]++
+	}
+	assert.InDelta(t, 0.1, float64(counts["a"])/draws, 0.02)
+	assert.InDelta(t, 0.3, float64(counts["b"])/draws, 0.02)
+	assert.InDelta(t, 0.6, float64(counts["c"])/draws, 0.02)
+
+	assert.Nil(t, selector.SelectProposer(NewValidatorSet(nil), seed, 0))
+}